@@ -7,10 +7,73 @@ package v1
 
 import (
 	internal_acorn_iov1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	internal_admin_acorn_iov1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAppPolicyRule) DeepCopyInto(out *ClusterAppPolicyRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]internal_admin_acorn_iov1.AppPolicyRuleExpression, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAppPolicyRule.
+func (in *ClusterAppPolicyRule) DeepCopy() *ClusterAppPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAppPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAppPolicyRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAppPolicyRuleList) DeepCopyInto(out *ClusterAppPolicyRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAppPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAppPolicyRuleList.
+func (in *ClusterAppPolicyRuleList) DeepCopy() *ClusterAppPolicyRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAppPolicyRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAppPolicyRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterComputeClass) DeepCopyInto(out *ClusterComputeClass) {
 	*out = *in
@@ -29,6 +92,7 @@ func (in *ClusterComputeClass) DeepCopyInto(out *ClusterComputeClass) {
 		}
 	}
 	in.Memory.DeepCopyInto(&out.Memory)
+	in.CPU.DeepCopyInto(&out.CPU)
 	if in.SupportedRegions != nil {
 		in, out := &in.SupportedRegions, &out.SupportedRegions
 		*out = make([]string, len(*in))
@@ -154,6 +218,68 @@ func (in *ClusterVolumeClassList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAppPolicyRule) DeepCopyInto(out *ProjectAppPolicyRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]internal_admin_acorn_iov1.AppPolicyRuleExpression, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAppPolicyRule.
+func (in *ProjectAppPolicyRule) DeepCopy() *ProjectAppPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAppPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAppPolicyRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAppPolicyRuleList) DeepCopyInto(out *ProjectAppPolicyRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectAppPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAppPolicyRuleList.
+func (in *ProjectAppPolicyRuleList) DeepCopy() *ProjectAppPolicyRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAppPolicyRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAppPolicyRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectComputeClass) DeepCopyInto(out *ProjectComputeClass) {
 	*out = *in
@@ -172,6 +298,7 @@ func (in *ProjectComputeClass) DeepCopyInto(out *ProjectComputeClass) {
 		}
 	}
 	in.Memory.DeepCopyInto(&out.Memory)
+	in.CPU.DeepCopyInto(&out.CPU)
 	if in.SupportedRegions != nil {
 		in, out := &in.SupportedRegions, &out.SupportedRegions
 		*out = make([]string, len(*in))
@@ -12,6 +12,7 @@ type ComputeClass struct {
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
 	Memory           v1.ComputeClassMemory `json:"memory,omitempty"`
+	CPU              v1.ComputeClassCPU    `json:"cpu,omitempty"`
 	Description      string                `json:"description,omitempty"`
 	Default          bool                  `json:"default"`
 	SupportedRegions []string              `json:"supportedRegions,omitempty"`
@@ -57,6 +57,29 @@ func convert_url_Values_To__LogOptions(in *url.Values, out *LogOptions, s conver
 			return err
 		}
 	}
+	if values, ok := map[string][]string(*in)["since"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Since, s); err != nil {
+			return err
+		}
+	}
+	if values, ok := map[string][]string(*in)["until"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Until, s); err != nil {
+			return err
+		}
+	}
+	if values, ok := map[string][]string(*in)["grep"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Grep, s); err != nil {
+			return err
+		}
+	}
+	if values, ok := map[string][]string(*in)["exclude"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Exclude, s); err != nil {
+			return err
+		}
+	}
+	if values, ok := map[string][]string(*in)["where"]; ok && len(values) > 0 {
+		out.Where = *(*[]string)(unsafe.Pointer(&values))
+	}
 	return nil
 }
 
@@ -78,3 +101,18 @@ func convert_url_Values_To__ContainerReplicaPortForwardOptions(in *url.Values, o
 func Convert_url_Values_To__ContainerReplicaPortForwardOptions(in, out interface{}, s conversion.Scope) error {
 	return convert_url_Values_To__ContainerReplicaPortForwardOptions(in.(*url.Values), out.(*ContainerReplicaPortForwardOptions), s)
 }
+
+func convert_url_Values_To__VolumeArchiveOptions(in *url.Values, out *VolumeArchiveOptions, s conversion.Scope) error {
+	if values, ok := map[string][]string(*in)["path"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Path, s); err != nil {
+			return err
+		}
+	} else {
+		out.Path = ""
+	}
+	return nil
+}
+
+func Convert_url_Values_To__VolumeArchiveOptions(in, out interface{}, s conversion.Scope) error {
+	return convert_url_Values_To__VolumeArchiveOptions(in.(*url.Values), out.(*VolumeArchiveOptions), s)
+}
@@ -36,11 +36,16 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ImageTag{},
 		&ImagePush{},
 		&ImagePull{},
+		&ImageSign{},
+		&ImageVerify{},
 		&Info{},
 		&InfoList{},
 		&LogOptions{},
 		&Volume{},
 		&VolumeList{},
+		&VolumeArchiveOptions{},
+		&VolumeSnapshot{},
+		&VolumeSnapshotList{},
 		&VolumeClass{},
 		&VolumeClassList{},
 		&Credential{},
@@ -65,9 +70,14 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ImageAllowRuleList{},
 		&Event{},
 		&EventList{},
+		&EventSubscription{},
+		&EventSubscriptionList{},
 		&DevSession{},
 		&DevSessionList{},
 		&IgnoreCleanup{},
+		&AppPromote{},
+		&AppRollback{},
+		&AppRunJob{},
 	)
 
 	// Add common types
@@ -86,11 +96,15 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		if err := scheme.AddConversionFunc((*url.Values)(nil), (*LogOptions)(nil), Convert_url_Values_To__LogOptions); err != nil {
 			return err
 		}
+		if err := scheme.AddConversionFunc((*url.Values)(nil), (*VolumeArchiveOptions)(nil), Convert_url_Values_To__VolumeArchiveOptions); err != nil {
+			return err
+		}
 
 		gvk := schemeGroupVersion.WithKind("Event")
 		flcf := func(label, value string) (string, string, error) {
 			switch label {
-			case "details", "metadata.name", "metadata.namespace":
+			case "details", "metadata.name", "metadata.namespace", "type", "severity", "source.kind", "source.name",
+				"actor", "since", "until", "collapse":
 				return label, value, nil
 			}
 			return "", "", fmt.Errorf("unsupported field selection [%s]", label)
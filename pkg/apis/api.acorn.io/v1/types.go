@@ -148,11 +148,14 @@ type ImagePull struct {
 }
 
 type LogMessage struct {
-	Line          string      `json:"line,omitempty"`
-	AppName       string      `json:"appName,omitempty"`
-	ContainerName string      `json:"containerName,omitempty"`
-	Time          metav1.Time `json:"time,omitempty"`
-	Error         string      `json:"error,omitempty"`
+	Line    string `json:"line,omitempty"`
+	AppName string `json:"appName,omitempty"`
+	// ContainerName is the name of the container replica that produced the line
+	ContainerName string `json:"containerName,omitempty"`
+	// Container is the name of the container, sidecar or job in the Acornfile
+	Container string      `json:"container,omitempty"`
+	Time      metav1.Time `json:"time,omitempty"`
+	Error     string      `json:"error,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -165,6 +168,13 @@ type LogOptions struct {
 	ContainerReplica string `json:"containerReplica,omitempty"`
 	Container        string `json:"container,omitempty"`
 	Since            string `json:"since,omitempty"`
+	Until            string `json:"until,omitempty"`
+	// Grep is a regular expression, only lines that match it are returned
+	Grep string `json:"grep,omitempty"`
+	// Exclude is a regular expression, lines that match it are dropped
+	Exclude string `json:"exclude,omitempty"`
+	// Where are conditions like level=error or level!=debug on the fields of lines that are JSON objects
+	Where []string `json:"where,omitempty"`
 }
 
 type PortForwardOptions struct {
@@ -199,6 +209,38 @@ type IgnoreCleanup struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type AppPromote struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Full promotes the new revision without going through the remaining steps of the rollout
+	Full bool `json:"full,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AppRollback struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Revision is the revision of the app history to go back to. If it is not set the rollout in progress is aborted.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AppRunJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// JobName is the name of the scheduled job of the app to run
+	JobName string `json:"jobName,omitempty"`
+	// RunName is the name of the run that was started, it is set in the response
+	RunName string `json:"runName,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type ImageDetails struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -208,12 +250,26 @@ type ImageDetails struct {
 	DeployArgs   v1.GenericMap `json:"deployArgs,omitempty"`
 	Profiles     []string      `json:"profiles,omitempty"`
 	Auth         *RegistryAuth `json:"auth,omitempty"`
+	IncludeSBOM  bool          `json:"includeSBOM,omitempty"`
 
 	// Output Params
 	AppImage   v1.AppImage   `json:"appImage,omitempty"`
 	AppSpec    *v1.AppSpec   `json:"appSpec,omitempty"`
 	Params     *v1.ParamSpec `json:"params,omitempty"`
 	ParseError string        `json:"parseError,omitempty"`
+	SBOMs      []ImageSBOM   `json:"sboms,omitempty"`
+}
+
+// ImageSBOM summarizes a SBOM that is stored next to an app image
+type ImageSBOM struct {
+	// Target is the part of the app image the SBOM describes, like acornfile or containers/web
+	Target string `json:"target,omitempty"`
+	Format string `json:"format,omitempty"`
+	Digest string `json:"digest,omitempty"`
+	// Packages is the number of packages in the SBOM
+	Packages int `json:"packages,omitempty"`
+	// PackageTypes is the number of packages of each type, like apk, deb or oci
+	PackageTypes map[string]int `json:"packageTypes,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -227,6 +283,47 @@ type ImageTag struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type ImageSign struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Input Params
+	Payload   []byte `json:"payload,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// Output Params
+	SignatureDigest string `json:"signatureDigest,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImageVerify struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Input Params
+	Auth        *RegistryAuth           `json:"auth,omitempty"`
+	Key         string                  `json:"key,omitempty"`
+	Annotations v1.SignatureAnnotations `json:"annotations,omitempty"`
+	// Rules are the names of the ImageAllowRules to verify the image against, all rules of the project if empty
+	Rules []string `json:"rules,omitempty"`
+
+	// Output Params
+	Digest   string                 `json:"digest,omitempty"`
+	Verified bool                   `json:"verified,omitempty"`
+	Message  string                 `json:"message,omitempty"`
+	Results  []ImageAllowRuleResult `json:"results,omitempty"`
+}
+
+type ImageAllowRuleResult struct {
+	Rule    string `json:"rule,omitempty"`
+	Allowed bool   `json:"allowed,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type ImageList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
@@ -301,6 +398,28 @@ func (in *Volume) GetRegion() string {
 // +k8s:conversion-gen:explicit-from=net/url.Values
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type VolumeArchiveOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Path is the directory inside the volume to archive, defaults to the root of the volume
+	Path string `json:"path,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshot v1.VolumeSnapshotInstance
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshot `json:"items"`
+}
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type ContainerReplicaExecOptions struct {
 	metav1.TypeMeta `json:",inline"`
 
@@ -422,12 +541,15 @@ type Config struct {
 	RecordBuilds                   *bool           `json:"recordBuilds" name:"record-builds" usage:"Keep a record of each acorn build that happens"`
 	PublishBuilders                *bool           `json:"publishBuilders" name:"publish-builders" usage:"Publish the builders through ingress to so build traffic does not traverse the api-server"`
 	BuilderPerProject              *bool           `json:"builderPerProject" name:"builder-per-project" usage:"Create a dedicated builder per project"`
+	BuilderCacheSize               *string         `json:"builderCacheSize" name:"builder-cache-size" usage:"Size of the persistent volume each builder stores local build caches (type=local) on, local build caches are not supported if empty (example 10Gi) (default '')"`
 	InternalRegistryPrefix         *string         `json:"internalRegistryPrefix" name:"internal-registry-prefix" usage:"The image prefix to use when pushing internal images (example ghcr.io/my-org/)"`
 	IgnoreUserLabelsAndAnnotations *bool           `json:"ignoreUserLabelsAndAnnotations" name:"ignore-user-labels-and-annotations" usage:"Don't propagate user-defined labels and annotations to dependent objects"`
 	AllowUserLabels                []string        `json:"allowUserLabels" name:"allow-user-label" usage:"Allow these labels to propagate to dependent objects, no effect if --ignore-user-labels-and-annotations not true"`
 	AllowUserAnnotations           []string        `json:"allowUserAnnotations" name:"allow-user-annotation" usage:"Allow these annotations to propagate to dependent objects, no effect if --ignore-user-labels-and-annotations not true"`
 	WorkloadMemoryDefault          *int64          `json:"workloadMemoryDefault" name:"workload-memory-default" quantity:"true" usage:"Set the default memory for acorn workloads. Accepts binary suffixes (Ki, Mi, Gi, etc) and \".\" and \"_\" seperators (default 0)" short:"m"`
 	WorkloadMemoryMaximum          *int64          `json:"workloadMemoryMaximum" name:"workload-memory-maximum" quantity:"true" usage:"Set the maximum memory for acorn workloads. Accepts binary suffixes (Ki, Mi, Gi, etc) and \".\" and \"_\" seperators (default 0)"`
+	WorkloadCPUDefault             *int64          `json:"workloadCPUDefault" name:"workload-cpu-default" quantity:"milli" usage:"Set the default cpu for acorn workloads. Accepts cores or millicores (ex 0.5, 500m) (default 0)"`
+	WorkloadCPUMaximum             *int64          `json:"workloadCPUMaximum" name:"workload-cpu-maximum" quantity:"milli" usage:"Set the maximum cpu for acorn workloads. Accepts cores or millicores (ex 0.5, 500m) (default 0)"`
 	UseCustomCABundle              *bool           `json:"useCustomCABundle" name:"use-custom-ca-bundle" usage:"Use CA bundle for admin supplied secret for all acorn control plane components. Defaults to false."`
 	PropagateProjectAnnotations    []string        `json:"propagateProjectAnnotations" name:"propagate-project-annotation" usage:"The list of keys of annotations to propagate from acorn project to app namespaces"`
 	PropagateProjectLabels         []string        `json:"propagateProjectLabels" name:"propagate-project-label" usage:"The list of keys of labels to propagate from acorn project to app namespaces"`
@@ -467,6 +589,8 @@ type Project struct {
 type ProjectSpec struct {
 	DefaultRegion    string   `json:"defaultRegion,omitempty"`
 	SupportedRegions []string `json:"supportedRegions,omitempty"`
+	// LogSink receives the logs of all apps in the project that do not define their own log sink
+	LogSink *v1.LogSink `json:"logSink,omitempty"`
 }
 
 type ProjectStatus struct {
@@ -585,6 +709,18 @@ type EventList struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type EventSubscription v1.EventSubscriptionInstance
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EventSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSubscription `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type DevSession v1.DevSessionInstance
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -142,6 +142,11 @@ func (in *Acornfile) DeepCopyInto(out *Acornfile) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(internal_acorn_iov1.Rollout)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Acornfile.
@@ -213,6 +218,31 @@ func (in *AppList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppPromote) DeepCopyInto(out *AppPromote) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppPromote.
+func (in *AppPromote) DeepCopy() *AppPromote {
+	if in == nil {
+		return nil
+	}
+	out := new(AppPromote)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppPromote) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppPullImage) DeepCopyInto(out *AppPullImage) {
 	*out = *in
@@ -238,6 +268,56 @@ func (in *AppPullImage) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRollback) DeepCopyInto(out *AppRollback) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRollback.
+func (in *AppRollback) DeepCopy() *AppRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRollback) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRunJob) DeepCopyInto(out *AppRunJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRunJob.
+func (in *AppRunJob) DeepCopy() *AppRunJob {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRunJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRunJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Builder) DeepCopyInto(out *Builder) {
 	*out = *in
@@ -326,6 +406,7 @@ func (in *ComputeClass) DeepCopyInto(out *ComputeClass) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Memory.DeepCopyInto(&out.Memory)
+	in.CPU.DeepCopyInto(&out.CPU)
 	if in.SupportedRegions != nil {
 		in, out := &in.SupportedRegions, &out.SupportedRegions
 		*out = make([]string, len(*in))
@@ -446,6 +527,11 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BuilderCacheSize != nil {
+		in, out := &in.BuilderCacheSize, &out.BuilderCacheSize
+		*out = new(string)
+		**out = **in
+	}
 	if in.InternalRegistryPrefix != nil {
 		in, out := &in.InternalRegistryPrefix, &out.InternalRegistryPrefix
 		*out = new(string)
@@ -476,6 +562,16 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.WorkloadCPUDefault != nil {
+		in, out := &in.WorkloadCPUDefault, &out.WorkloadCPUDefault
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WorkloadCPUMaximum != nil {
+		in, out := &in.WorkloadCPUMaximum, &out.WorkloadCPUMaximum
+		*out = new(int64)
+		**out = **in
+	}
 	if in.UseCustomCABundle != nil {
 		in, out := &in.UseCustomCABundle, &out.UseCustomCABundle
 		*out = new(bool)
@@ -945,17 +1041,47 @@ func (in *EmbeddedContainer) DeepCopyInto(out *EmbeddedContainer) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	out.Metrics = in.Metrics
 	if in.Scale != nil {
 		in, out := &in.Scale, &out.Scale
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Autoscale != nil {
+		in, out := &in.Autoscale, &out.Autoscale
+		*out = new(internal_acorn_iov1.Autoscale)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadlineSeconds != nil {
+		in, out := &in.DeadlineSeconds, &out.DeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessfulHistory != nil {
+		in, out := &in.SuccessfulHistory, &out.SuccessfulHistory
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedHistory != nil {
+		in, out := &in.FailedHistory, &out.FailedHistory
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Events != nil {
 		in, out := &in.Events, &out.Events
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Dev != nil {
+		in, out := &in.Dev, &out.Dev
+		*out = new(internal_acorn_iov1.DevConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Sidecars != nil {
 		in, out := &in.Sidecars, &out.Sidecars
 		*out = make(map[string]internal_acorn_iov1.Container, len(*in))
@@ -1057,6 +1183,65 @@ func (in *EventList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscription) DeepCopyInto(out *EventSubscription) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscription.
+func (in *EventSubscription) DeepCopy() *EventSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSubscription) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscriptionList) DeepCopyInto(out *EventSubscriptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EventSubscription, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionList.
+func (in *EventSubscriptionList) DeepCopy() *EventSubscriptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSubscriptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSubscriptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IgnoreCleanup) DeepCopyInto(out *IgnoreCleanup) {
 	*out = *in
@@ -1123,6 +1308,7 @@ func (in *ImageAllowRule) DeepCopyInto(out *ImageAllowRule) {
 		copy(*out, *in)
 	}
 	in.Signatures.DeepCopyInto(&out.Signatures)
+	in.SBOM.DeepCopyInto(&out.SBOM)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAllowRule.
@@ -1175,6 +1361,21 @@ func (in *ImageAllowRuleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAllowRuleResult) DeepCopyInto(out *ImageAllowRuleResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAllowRuleResult.
+func (in *ImageAllowRuleResult) DeepCopy() *ImageAllowRuleResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAllowRuleResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageDetails) DeepCopyInto(out *ImageDetails) {
 	*out = *in
@@ -1202,6 +1403,13 @@ func (in *ImageDetails) DeepCopyInto(out *ImageDetails) {
 		*out = new(internal_acorn_iov1.ParamSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SBOMs != nil {
+		in, out := &in.SBOMs, &out.SBOMs
+		*out = make([]ImageSBOM, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageDetails.
@@ -1312,6 +1520,58 @@ func (in *ImagePush) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSBOM) DeepCopyInto(out *ImageSBOM) {
+	*out = *in
+	if in.PackageTypes != nil {
+		in, out := &in.PackageTypes, &out.PackageTypes
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSBOM.
+func (in *ImageSBOM) DeepCopy() *ImageSBOM {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSBOM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSign) DeepCopyInto(out *ImageSign) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSign.
+func (in *ImageSign) DeepCopy() *ImageSign {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSign)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageSign) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageTag) DeepCopyInto(out *ImageTag) {
 	*out = *in
@@ -1337,6 +1597,47 @@ func (in *ImageTag) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerify) DeepCopyInto(out *ImageVerify) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(RegistryAuth)
+		**out = **in
+	}
+	in.Annotations.DeepCopyInto(&out.Annotations)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]ImageAllowRuleResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerify.
+func (in *ImageVerify) DeepCopy() *ImageVerify {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerify)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageVerify) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Info) DeepCopyInto(out *Info) {
 	*out = *in
@@ -1457,6 +1758,11 @@ func (in *LogOptions) DeepCopyInto(out *LogOptions) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Where != nil {
+		in, out := &in.Where, &out.Where
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogOptions.
@@ -1565,6 +1871,11 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LogSink != nil {
+		in, out := &in.LogSink, &out.LogSink
+		*out = new(internal_acorn_iov1.LogSink)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
@@ -1871,6 +2182,30 @@ func (in *Volume) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeArchiveOptions) DeepCopyInto(out *VolumeArchiveOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeArchiveOptions.
+func (in *VolumeArchiveOptions) DeepCopy() *VolumeArchiveOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeArchiveOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeArchiveOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeClass) DeepCopyInto(out *VolumeClass) {
 	*out = *in
@@ -2006,6 +2341,65 @@ func (in *VolumeList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshot) DeepCopyInto(out *VolumeSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshot.
+func (in *VolumeSnapshot) DeepCopy() *VolumeSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotList) DeepCopyInto(out *VolumeSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotList.
+func (in *VolumeSnapshotList) DeepCopy() *VolumeSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
 	*out = *in
@@ -13,6 +13,14 @@ type AppImage struct {
 	VCS       VCS        `json:"vcs,omitempty"`
 }
 
+// GetDigest returns the digest of the app image or the ID if the digest is not known
+func (in AppImage) GetDigest() string {
+	if in.Digest != "" {
+		return in.Digest
+	}
+	return in.ID
+}
+
 type VCS struct {
 	Remotes  []string `json:"remotes,omitempty"`
 	Revision string   `json:"revision,omitempty"`
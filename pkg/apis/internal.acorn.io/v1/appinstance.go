@@ -4,6 +4,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -103,6 +104,8 @@ type AppInstanceSpec struct {
 	AutoUpgradeInterval string           `json:"autoUpgradeInterval,omitempty"`
 	ComputeClasses      ComputeClassMap  `json:"computeClass,omitempty"`
 	Memory              MemoryMap        `json:"memory,omitempty"`
+	CPU                 CPUMap           `json:"cpu,omitempty"`
+	LogSink             *LogSink         `json:"logSink,omitempty"`
 }
 
 func (in *AppInstance) GetStopped() bool {
@@ -157,11 +160,14 @@ type VolumeBinding struct {
 	Size        Quantity    `json:"size,omitempty"`
 	AccessModes AccessModes `json:"accessModes,omitempty"`
 	Class       string      `json:"class,omitempty"`
+	From        string      `json:"from,omitempty"`
 }
 
 type AppColumns struct {
 	Healthy   string `json:"healthy,omitempty" column:"name=Healthy,jsonpath=.status.columns.healthy"`
 	UpToDate  string `json:"upToDate,omitempty" column:"name=Up-To-Date,jsonpath=.status.columns.upToDate"`
+	Desired   string `json:"desired,omitempty" column:"name=Desired,jsonpath=.status.columns.desired"`
+	Current   string `json:"current,omitempty" column:"name=Current,jsonpath=.status.columns.current"`
 	Message   string `json:"message,omitempty" column:"name=Message,jsonpath=.status.columns.message"`
 	Endpoints string `json:"endpoints,omitempty" column:"name=Endpoints,jsonpath=.status.columns.endpoints"`
 	Created   string `json:"created,omitempty" column:"name=Created,jsonpath=.metadata.creationTimestamp"`
@@ -172,27 +178,82 @@ func (a AppInstanceStatus) GetDevMode() bool {
 }
 
 type AppInstanceStatus struct {
-	DevSession                   *DevSessionInstanceSpec `json:"devSession,omitempty"`
-	ObservedGeneration           int64                   `json:"observedGeneration,omitempty"`
-	ObservedImageDigest          string                  `json:"observedImageDigest,omitempty"`
-	Columns                      AppColumns              `json:"columns,omitempty"`
-	Ready                        bool                    `json:"ready,omitempty"`
-	Namespace                    string                  `json:"namespace,omitempty"`
-	AppImage                     AppImage                `json:"appImage,omitempty"`
-	AvailableAppImage            string                  `json:"availableAppImage,omitempty"`
-	AvailableAppImageRemote      bool                    `json:"availableAppImageRemote,omitempty"`
-	ConfirmUpgradeAppImage       string                  `json:"confirmUpgradeAppImage,omitempty"`
-	ConfirmUpgradeAppImageRemote bool                    `json:"confirmUpgradeAppImageRemote,omitempty"`
-	AppSpec                      AppSpec                 `json:"appSpec,omitempty"`
-	AppStatus                    AppStatus               `json:"appStatus,omitempty"`
-	Scheduling                   map[string]Scheduling   `json:"scheduling,omitempty"`
-	Conditions                   []Condition             `json:"conditions,omitempty"`
-	Defaults                     Defaults                `json:"defaults,omitempty"`
+	DevSession                   *DevSessionInstanceSpec  `json:"devSession,omitempty"`
+	ObservedGeneration           int64                    `json:"observedGeneration,omitempty"`
+	ObservedImageDigest          string                   `json:"observedImageDigest,omitempty"`
+	Columns                      AppColumns               `json:"columns,omitempty"`
+	Ready                        bool                     `json:"ready,omitempty"`
+	Namespace                    string                   `json:"namespace,omitempty"`
+	AppImage                     AppImage                 `json:"appImage,omitempty"`
+	AvailableAppImage            string                   `json:"availableAppImage,omitempty"`
+	AvailableAppImageRemote      bool                     `json:"availableAppImageRemote,omitempty"`
+	ConfirmUpgradeAppImage       string                   `json:"confirmUpgradeAppImage,omitempty"`
+	ConfirmUpgradeAppImageRemote bool                     `json:"confirmUpgradeAppImageRemote,omitempty"`
+	AppSpec                      AppSpec                  `json:"appSpec,omitempty"`
+	AppStatus                    AppStatus                `json:"appStatus,omitempty"`
+	Scheduling                   map[string]Scheduling    `json:"scheduling,omitempty"`
+	Conditions                   []Condition              `json:"conditions,omitempty"`
+	Defaults                     Defaults                 `json:"defaults,omitempty"`
+	Rollout                      *RolloutStatus           `json:"rollout,omitempty"`
+	Revisions                    []AppRevision            `json:"revisions,omitempty"`
+	VolumeRestores               map[string]VolumeRestore `json:"volumeRestores,omitempty"`
+	// ChangeCause is set by auto-upgrades and rollbacks that change the app image without an update of the app. It is
+	// recorded as the trigger of the next revision.
+	ChangeCause string `json:"changeCause,omitempty"`
+	// RollbackRevision is the revision the app is rolled back to. The image of the revision is pulled by its digest and
+	// deployed under the image reference of the revision.
+	RollbackRevision *AppRevision `json:"rollbackRevision,omitempty"`
+}
+
+// VolumeRestore records the snapshot a volume is restored from. The snapshot is only looked up once, so it can be
+// deleted after the restore without affecting the app.
+type VolumeRestore struct {
+	// Snapshot is the name of the VolumeSnapshotInstance the volume is restored from
+	Snapshot string `json:"snapshot,omitempty"`
+	// Method is how the snapshot was taken, either "csi" or "copy"
+	Method string `json:"method,omitempty"`
+	// SnapshotName is the name of the VolumeSnapshot or PersistentVolumeClaim in the app namespace holding the data
+	SnapshotName string             `json:"snapshotName,omitempty"`
+	RestoreSize  *resource.Quantity `json:"restoreSize,omitempty"`
+	// Completed is true once the data has been restored into the volume
+	Completed bool `json:"completed,omitempty"`
+}
+
+const (
+	AppRevisionTriggerCreate      = "create"
+	AppRevisionTriggerUpdate      = "update"
+	AppRevisionTriggerAutoUpgrade = "autoupgrade"
+	AppRevisionTriggerDev         = "dev"
+	AppRevisionTriggerRollback    = "rollback"
+)
+
+// AppRevision is a deployed combination of app image, deploy args and profiles. The newest revision is the last one.
+type AppRevision struct {
+	Revision   int64       `json:"revision,omitempty"`
+	Image      string      `json:"image,omitempty"`
+	ImageID    string      `json:"imageID,omitempty"`
+	Digest     string      `json:"digest,omitempty"`
+	DeployArgs GenericMap  `json:"deployArgs,omitempty"`
+	Profiles   []string    `json:"profiles,omitempty"`
+	Trigger    string      `json:"trigger,omitempty"`
+	UpdatedBy  string      `json:"updatedBy,omitempty"`
+	Created    metav1.Time `json:"created,omitempty"`
+}
+
+// GetRevision returns the revision with the given number, or the newest revision if revision is 0
+func (in *AppInstanceStatus) GetRevision(revision int64) (AppRevision, bool) {
+	for i := len(in.Revisions) - 1; i >= 0; i-- {
+		if revision == 0 || in.Revisions[i].Revision == revision {
+			return in.Revisions[i], true
+		}
+	}
+	return AppRevision{}, false
 }
 
 type Defaults struct {
 	Volumes map[string]VolumeDefault `json:"volumes,omitempty"`
 	Memory  map[string]*int64        `json:"memory,omitempty"`
+	CPU     map[string]*int64        `json:"cpu,omitempty"`
 	Region  string                   `json:"region,omitempty"`
 }
 
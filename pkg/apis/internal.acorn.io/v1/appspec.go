@@ -2,10 +2,15 @@ package v1
 
 import (
 	"fmt"
+	"net/http"
+	"path"
+	"regexp"
 	"sort"
 	"strings"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -47,6 +52,7 @@ type Build struct {
 	BaseImage          string            `json:"baseImage,omitempty"`
 	ContextDirs        map[string]string `json:"contextDirs,omitempty"`
 	BuildArgs          map[string]string `json:"buildArgs,omitempty"`
+	Cache              *BuildCache       `json:"cache,omitempty"`
 }
 
 func (in Build) BaseBuild() Build {
@@ -54,9 +60,18 @@ func (in Build) BaseBuild() Build {
 		Context:    in.Context,
 		Dockerfile: in.Dockerfile,
 		Target:     in.Target,
+		Cache:      in.Cache,
 	}
 }
 
+// BuildCache configures the buildkit cache that is imported before and exported after a build. Entries use the
+// buildx syntax, like "type=registry,ref=ghcr.io/acme/app:cache" or "type=local,dest=app", a plain image reference
+// is a registry cache.
+type BuildCache struct {
+	From []string `json:"from,omitempty"`
+	To   string   `json:"to,omitempty"`
+}
+
 type Protocol string
 
 var (
@@ -365,25 +380,94 @@ type Container struct {
 	ComputeClass *string                `json:"class,omitempty"`
 	Memory       *int64                 `json:"memory,omitempty"`
 
+	// CPU is a quantity such as "500m" or 2. As with Kubernetes, a bare number is a count of cores.
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+
 	// Metrics is available on containers and jobs, but not sidecars
 	Metrics MetricsDef `json:"metrics,omitempty"`
 
 	// Scale is only available on containers, not sidecars or jobs
 	Scale *int32 `json:"scale,omitempty"`
 
+	// Autoscale is only available on containers, not sidecars or jobs. It is set
+	// when scale is defined as an object instead of a fixed replica count.
+	Autoscale *Autoscale `json:"autoscale,omitempty"`
+
 	// Schedule is only available on jobs
 	Schedule string `json:"schedule,omitempty"`
 
+	// Concurrency is only available on scheduled jobs. It decides what happens when a run is due while the previous
+	// run is still active, replace is the default.
+	Concurrency JobConcurrency `json:"concurrency,omitempty"`
+
+	// Suspend is only available on scheduled jobs. No new runs are scheduled while it is set.
+	Suspend bool `json:"suspend,omitempty"`
+
+	// DeadlineSeconds is only available on jobs. A run that is active for longer is stopped and fails.
+	DeadlineSeconds *int64 `json:"deadlineSeconds,omitempty"`
+
+	// SuccessfulHistory and FailedHistory are only available on scheduled jobs. They are the number of finished runs
+	// that are kept, 1 successful and 3 failed runs by default.
+	SuccessfulHistory *int32 `json:"successfulHistory,omitempty"`
+	FailedHistory     *int32 `json:"failedHistory,omitempty"`
+
 	// Events is only available on jobs
 	Events []string `json:"events,omitempty"`
 
 	// Init is only available on sidecars
 	Init bool `json:"init,omitempty"`
 
+	// Dev configures how dev mode applies source changes to the container, it is ignored outside of dev mode
+	Dev *DevConfig `json:"dev,omitempty"`
+
 	// Sidecars are not available on sidecars
 	Sidecars map[string]Container `json:"sidecars,omitempty"`
 }
 
+type DevConfig struct {
+	OnChange *DevOnChange `json:"onChange,omitempty"`
+}
+
+func (in *DevConfig) GetOnChange() *DevOnChange {
+	if in == nil {
+		return nil
+	}
+	return in.OnChange
+}
+
+// DevOnChange applies source changes to a running container in dev mode, instead of rebuilding its image
+type DevOnChange struct {
+	// Sync are the local directories that are synced into the container, in the format "LOCAL:REMOTE", like
+	// "./src:/app/src". The local directory is relative to the Acornfile.
+	Sync []string `json:"sync,omitempty"`
+	// Run is the shell command that runs in the container after the synced changes are copied into it
+	Run string `json:"run,omitempty"`
+}
+
+// SyncDirs returns the local directories of Sync by their directories in the container
+func (in DevOnChange) SyncDirs() (map[string]string, error) {
+	result := map[string]string{}
+	for _, sync := range in.Sync {
+		local, remote, ok := strings.Cut(sync, ":")
+		if !ok || local == "" || !path.IsAbs(remote) {
+			return nil, fmt.Errorf("invalid sync %q, must be in the format LOCAL:REMOTE with an absolute REMOTE directory", sync)
+		}
+		result[path.Clean(remote)] = local
+	}
+	return result, nil
+}
+
+type JobConcurrency string
+
+const (
+	// JobConcurrencyForbid skips a run while the previous run is active
+	JobConcurrencyForbid = JobConcurrency("forbid")
+	// JobConcurrencyReplace stops the active run and starts the new one
+	JobConcurrencyReplace = JobConcurrency("replace")
+	// JobConcurrencyAllow starts the new run next to the active one
+	JobConcurrencyAllow = JobConcurrency("allow")
+)
+
 type Image struct {
 	Image      string      `json:"image,omitempty"`
 	Build      *Build      `json:"containerBuild,omitempty"`
@@ -401,6 +485,7 @@ type AppSpec struct {
 	Acorns      map[string]Acorn         `json:"acorns,omitempty"`
 	Routers     map[string]Router        `json:"routers,omitempty"`
 	Services    map[string]Service       `json:"services,omitempty"`
+	Rollout     *Rollout                 `json:"rollout,omitempty"`
 }
 
 type Route struct {
@@ -408,6 +493,131 @@ type Route struct {
 	TargetServiceName string   `json:"targetServiceName,omitempty"`
 	TargetPort        int      `json:"targetPort,omitempty"`
 	PathType          PathType `json:"pathType,omitempty"`
+
+	// Targets split the requests of the route by weight across multiple services, instead of TargetServiceName
+	Targets []RouteTarget `json:"targets,omitempty"`
+
+	// Headers and Query are the request headers and query parameters that must have exactly these values, in
+	// addition to the path, for a request to match the route. The first route that matches a request is used.
+	Headers map[string]string `json:"headers,omitempty"`
+	Query   map[string]string `json:"query,omitempty"`
+
+	// Rewrite replaces the matched path before the request is sent to the target, "/" strips it
+	Rewrite string `json:"rewrite,omitempty"`
+
+	// Redirect responds with a redirect instead of sending the request to a target
+	Redirect *RouteRedirect `json:"redirect,omitempty"`
+
+	// TimeoutSeconds is how long the router waits for the target to send or receive data, 60 seconds if not set
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+}
+
+// Proxied returns true if the route uses features that only the router proxy implements. Ingresses send the requests
+// of routers with these routes to the router proxy instead of straight to the targets.
+func (in Route) Proxied() bool {
+	return len(in.Targets) > 0 ||
+		len(in.Headers) > 0 ||
+		len(in.Query) > 0 ||
+		in.Rewrite != "" ||
+		in.Redirect != nil ||
+		in.TimeoutSeconds > 0
+}
+
+var (
+	headerNameRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+	queryNameRegexp  = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+)
+
+// Empty returns true if the route has no path or nothing to send its requests to, these routes are ignored
+func (in Route) Empty() bool {
+	return in.Path == "" || (in.TargetServiceName == "" && len(in.Targets) == 0 && in.Redirect == nil)
+}
+
+// Validate checks that the route can be written to the nginx configuration of the router proxy as is
+func (in Route) Validate() error {
+	if !strings.HasPrefix(in.Path, "/") || strings.ContainsAny(in.Path, " \t\r\n\"';{}\\$#") {
+		return fmt.Errorf("path must start with / and must not contain whitespace or any of \"';{}\\$#")
+	}
+	if in.TargetServiceName != "" && len(in.Targets) > 0 {
+		return fmt.Errorf("targetServiceName and targets can not both be set")
+	}
+	if in.Redirect != nil && (in.TargetServiceName != "" || len(in.Targets) > 0) {
+		return fmt.Errorf("a redirect can not have targets")
+	}
+	if in.TimeoutSeconds < 0 {
+		return fmt.Errorf("timeoutSeconds must not be negative")
+	}
+	if in.Rewrite != "" && (!strings.HasPrefix(in.Rewrite, "/") || !safeRouteValue(in.Rewrite)) {
+		return fmt.Errorf("rewrite [%s] must start with / and must not contain whitespace or any of \"\\$", in.Rewrite)
+	}
+
+	var weight int
+	for _, target := range in.Targets {
+		if target.TargetServiceName == "" {
+			return fmt.Errorf("targets must have a targetServiceName")
+		}
+		if target.GetWeight() < 0 {
+			return fmt.Errorf("weight of target [%s] must not be negative", target.TargetServiceName)
+		}
+		weight += target.GetWeight()
+	}
+	if len(in.Targets) > 0 && weight == 0 {
+		return fmt.Errorf("at least one target must have a weight greater than 0")
+	}
+
+	for name, value := range in.Headers {
+		if !headerNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid header name [%s]", name)
+		}
+		if value == "" || !safeRouteValue(value) {
+			return fmt.Errorf("value of header [%s] must not be empty or contain whitespace or any of \"\\$", name)
+		}
+	}
+	for name, value := range in.Query {
+		if !queryNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid query parameter name [%s]", name)
+		}
+		if value == "" || !safeRouteValue(value) {
+			return fmt.Errorf("value of query parameter [%s] must not be empty or contain whitespace or any of \"\\$", name)
+		}
+	}
+
+	if in.Redirect != nil {
+		if in.Redirect.URL == "" || !safeRouteValue(in.Redirect.URL) {
+			return fmt.Errorf("redirect url [%s] must not be empty or contain whitespace or any of \"\\$", in.Redirect.URL)
+		}
+		switch in.Redirect.StatusCode {
+		case 0, http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		default:
+			return fmt.Errorf("redirect status code must be 301, 302, 303, 307 or 308, not %d", in.Redirect.StatusCode)
+		}
+	}
+	return nil
+}
+
+func safeRouteValue(s string) bool {
+	return !strings.ContainsAny(s, " \t\r\n\"\\$")
+}
+
+type RouteTarget struct {
+	TargetServiceName string `json:"targetServiceName,omitempty"`
+	TargetPort        int    `json:"targetPort,omitempty"`
+	// Weight is the share of the requests of the route that the target receives, relative to the weights of the
+	// other targets. It is 1 if not set, targets with a weight of 0 receive no requests.
+	Weight *int `json:"weight,omitempty"`
+}
+
+func (in RouteTarget) GetWeight() int {
+	if in.Weight == nil {
+		return 1
+	}
+	return *in.Weight
+}
+
+type RouteRedirect struct {
+	URL string `json:"url,omitempty"`
+	// StatusCode is 301, 302, 303, 307 or 308, 302 if not set
+	StatusCode int `json:"statusCode,omitempty"`
 }
 
 type Routes []Route
@@ -435,6 +645,7 @@ type Acorn struct {
 	NotifyUpgrade       *bool           `json:"notifyUpgrade,omitempty"`
 	AutoUpgradeInterval string          `json:"autoUpgradeInterval,omitempty"`
 	Memory              MemoryMap       `json:"memory,omitempty"`
+	CPU                 CPUMap          `json:"cpu,omitempty"`
 	ComputeClasses      ComputeClassMap `json:"computeClasses,omitempty"`
 }
 
@@ -446,6 +657,19 @@ type Secret struct {
 	Type        string            `json:"type,omitempty"`
 	Params      GenericMap        `json:"params,omitempty"`
 	Data        map[string]string `json:"data,omitempty"`
+	Rotate      *SecretRotate     `json:"rotate,omitempty"`
+}
+
+// HasFixedToken returns true if the value of a token secret is set in the Acornfile. Such a secret is never rotated.
+func (in Secret) HasFixedToken() bool {
+	return in.Type == "token" && in.Data["token"] != ""
+}
+
+// SecretRotate replaces the value of a generated or token secret every interval. The previous value is kept under the
+// previous key for the grace period, so consumers can switch over.
+type SecretRotate struct {
+	Every metav1.Duration  `json:"every,omitempty"`
+	Grace *metav1.Duration `json:"grace,omitempty"`
 }
 
 type AccessModes []AccessMode
@@ -456,11 +680,15 @@ type VolumeRequest struct {
 	Class       string            `json:"class,omitempty"`
 	Size        Quantity          `json:"size,omitempty"`
 	AccessModes AccessModes       `json:"accessModes,omitempty"`
+	From        string            `json:"from,omitempty"`
 }
 
 // Workload to its memory
 type MemoryMap map[string]*int64
 
+// Workload to its cpu
+type CPUMap map[string]*resource.Quantity
+
 // Workload to its class
 type ComputeClassMap map[string]string
 
@@ -469,6 +697,59 @@ type MetricsDef struct {
 	Path string `json:"path,omitempty"`
 }
 
+type Autoscale struct {
+	Min          *int32           `json:"min,omitempty"`
+	Max          int32            `json:"max,omitempty"`
+	TargetCPU    *int32           `json:"targetCPU,omitempty"`
+	TargetMemory *int32           `json:"targetMemory,omitempty"`
+	Metric       *AutoscaleMetric `json:"metric,omitempty"`
+}
+
+// GetScale returns the number of replicas the container runs with, which is the minimum for autoscaled containers
+func (in Container) GetScale() int32 {
+	if in.Autoscale != nil {
+		return in.Autoscale.GetMin()
+	} else if in.Scale != nil {
+		return *in.Scale
+	}
+	return 1
+}
+
+// IsStateful returns true if the container mounts a volume that can only be attached once. Such containers run a single
+// replica that is replaced in place.
+func (in *AppSpec) IsStateful(container Container) bool {
+	for _, dir := range container.Dirs {
+		if dir.Secret.Name != "" {
+			continue
+		}
+		for volName, vol := range in.Volumes {
+			if vol.Class == "ephemeral" {
+				continue
+			}
+			if dir.Volume == volName {
+				if len(vol.AccessModes) == 0 || (len(vol.AccessModes) == 1 && vol.AccessModes[0] == AccessModeReadWriteOnce) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (in Autoscale) GetMin() int32 {
+	if in.Min == nil {
+		return 1
+	}
+	return *in.Min
+}
+
+// AutoscaleMetric scales on a per pod custom metric scraped from the port and path
+// defined in the container's metrics
+type AutoscaleMetric struct {
+	Name               string `json:"name,omitempty"`
+	TargetAverageValue string `json:"targetAverageValue,omitempty"`
+}
+
 type GeneratedService struct {
 	Job string `json:"job,omitempty"`
 }
@@ -494,6 +775,7 @@ type Service struct {
 	NotifyUpgrade       *bool             `json:"notifyUpgrade,omitempty"`
 	AutoUpgradeInterval string            `json:"autoUpgradeInterval,omitempty"`
 	Memory              MemoryMap         `json:"memory,omitempty"`
+	CPU                 CPUMap            `json:"cpu,omitempty"`
 }
 
 func (s Service) GetJob() string {
@@ -1,6 +1,10 @@
 package v1
 
-import "fmt"
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type AppStatus struct {
 	Containers map[string]ContainerStatus `json:"containers,omitempty"`
@@ -106,12 +110,31 @@ type ContainerStatus struct {
 	MaxReplicaRestartCount int32                       `json:"maxReplicaRestartCount,omitempty"`
 	Dependencies           map[string]DependencyStatus `json:"dependencies,omitempty"`
 	ExpressionErrors       []ExpressionError           `json:"expressionErrors,omitempty"`
+	Autoscale              *AutoscaleStatus            `json:"autoscale,omitempty"`
+	Rollout                *ContainerRolloutStatus     `json:"rollout,omitempty"`
 }
 
 func (in ContainerStatus) GetCommonStatus() CommonStatus {
 	return in.CommonStatus
 }
 
+type AutoscaleStatus struct {
+	MinReplicas     int32        `json:"minReplicas,omitempty"`
+	MaxReplicas     int32        `json:"maxReplicas,omitempty"`
+	CurrentReplicas int32        `json:"currentReplicas,omitempty"`
+	DesiredReplicas int32        `json:"desiredReplicas,omitempty"`
+	LastScaleTime   *metav1.Time `json:"lastScaleTime,omitempty"`
+	LastDecision    string       `json:"lastDecision,omitempty"`
+}
+
+// ContainerRolloutStatus is the status of the deployment of the new revision of a container during a rollout
+type ContainerRolloutStatus struct {
+	Revision            string `json:"revision,omitempty"`
+	ReadyReplicaCount   int32  `json:"readyCount,omitempty"`
+	DesiredReplicaCount int32  `json:"desiredCount,omitempty"`
+	Ready               bool   `json:"ready,omitempty"`
+}
+
 type JobStatus struct {
 	CommonStatus         `json:",inline"`
 	RunningCount         int                         `json:"runningCount,omitempty"`
@@ -120,6 +143,30 @@ type JobStatus struct {
 	Dependencies         map[string]DependencyStatus `json:"dependencies,omitempty"`
 	Skipped              bool                        `json:"skipped,omitempty"`
 	ExpressionErrors     []ExpressionError           `json:"expressionErrors,omitempty"`
+	// Suspended is set for scheduled jobs that don't schedule new runs
+	Suspended bool `json:"suspended,omitempty"`
+	// Runs are the runs of the job that are kept, newest first
+	Runs []JobRun `json:"runs,omitempty"`
+}
+
+type JobRunState string
+
+const (
+	JobRunStateRunning   = JobRunState("running")
+	JobRunStateSucceeded = JobRunState("succeeded")
+	JobRunStateFailed    = JobRunState("failed")
+)
+
+type JobRun struct {
+	// Name is the name of the Kubernetes job of the run
+	Name  string      `json:"name,omitempty"`
+	State JobRunState `json:"state,omitempty"`
+	// Manual is set for runs that were started with acorn job run instead of the schedule
+	Manual         bool         `json:"manual,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Message is the reason a failed run failed
+	Message string `json:"message,omitempty"`
 }
 
 type DependencyStatus struct {
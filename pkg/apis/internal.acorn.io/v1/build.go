@@ -100,6 +100,13 @@ type AcornImageBuildInstanceSpec struct {
 	Platforms   []Platform `json:"platforms,omitempty"`
 	Args        GenericMap `json:"args,omitempty"`
 	VCS         VCS        `json:"vcs,omitempty"`
+	// SBOM is the format (spdx or cyclonedx) of the SBOMs that are stored next to the app image, none if empty
+	SBOM string `json:"sbom,omitempty"`
+	// Cache is added to the cache configuration of every build of the Acornfile
+	Cache *BuildCache `json:"cache,omitempty"`
+	// Reuse are the images of a previous build of the Acornfile. The containers, sidecars, jobs and images whose
+	// build is unchanged since then use these images instead of being built again.
+	Reuse *ImagesData `json:"reuse,omitempty"`
 }
 
 type AcornImageBuildInstanceStatus struct {
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func ParseCPU(s []string) (CPUMap, error) {
+	result := CPUMap{}
+	for _, s := range s {
+		workload, cpu, specific := strings.Cut(s, "=")
+
+		// If setting all, swap workload and cpu
+		if !specific {
+			cpu = workload
+			workload = ""
+		}
+
+		quantity, err := resource.ParseQuantity(cpu)
+		if err != nil {
+			return CPUMap{}, fmt.Errorf("invalid cpu %q: %w", cpu, err)
+		}
+
+		result[workload] = &quantity
+	}
+	return result, nil
+}
+
+var (
+	ErrInvalidAcornCPU   = errors.New("invalid cpu from Acornfile")
+	ErrInvalidSetCPU     = errors.New("invalid cpu set by user")
+	ErrInvalidDefaultCPU = errors.New("invalid cpu default")
+)
+
+func ValidateCPU(cpuSpec CPUMap, containerName string, container Container, specCPUDefault, specCPUMaximum *int64) (resource.Quantity, error) {
+	var cpuMaximum, cpuDefault int64
+	if specCPUDefault != nil {
+		cpuDefault = *specCPUDefault
+	}
+	if specCPUMaximum != nil {
+		cpuMaximum = *specCPUMaximum
+	}
+
+	// Determine which cpu should be used to set the resource limit/requests. Gets set
+	// 4 ways: User setting a specific workload, user setting all workloads, Acornfile, or
+	// from the apiv1.Config default.
+	milliCPU, errType := cpuDefault, ErrInvalidDefaultCPU
+	if c, set := cpuSpec[containerName]; set && c != nil {
+		errType = ErrInvalidSetCPU
+		milliCPU = c.MilliValue()
+	} else if cpuSpec[""] != nil {
+		errType = ErrInvalidSetCPU
+		milliCPU = cpuSpec[""].MilliValue()
+	} else if container.CPU != nil {
+		errType = ErrInvalidAcornCPU
+		milliCPU = container.CPU.MilliValue()
+	}
+
+	// For maximum cpu, 0 is equivalent to "unrestricted". Unlike memory, an unset cpu is left
+	// as 0 so that the cpu is derived from the memory by the ComputeClass instead.
+	var err error
+	if cpuMaximum != 0 {
+		var (
+			maxQuantity     = resource.NewMilliQuantity(cpuMaximum, resource.DecimalSI).String()
+			defaultQuantity = resource.NewMilliQuantity(cpuDefault, resource.DecimalSI).String()
+			cpuQuantity     = resource.NewMilliQuantity(milliCPU, resource.DecimalSI).String()
+		)
+
+		if milliCPU > cpuMaximum {
+			err = fmt.Errorf(
+				"%w: workload \"%v\" with cpu of %v exceeds the workload-cpu-maximum of %v",
+				errType, containerName, cpuQuantity, maxQuantity)
+			if milliCPU == cpuDefault {
+				err = fmt.Errorf(
+					"%w: workload-cpu-default set to %v but exceeds the workload-cpu-maximum of %v",
+					errType, defaultQuantity, maxQuantity)
+			}
+		}
+	}
+
+	return *resource.NewMilliQuantity(milliCPU, resource.DecimalSI), err
+}
@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestParseCPU(t *testing.T) {
+	result, err := ParseCPU([]string{"500m", "web=2", "worker=0.25"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.EqualValues(t, 500, result[""].MilliValue())
+	assert.EqualValues(t, 2000, result["web"].MilliValue())
+	assert.EqualValues(t, 250, result["worker"].MilliValue())
+
+	_, err = ParseCPU([]string{"web=lots"})
+	assert.Error(t, err)
+}
+
+func TestValidateCPU(t *testing.T) {
+	tests := []struct {
+		name           string
+		specCPU        CPUMap
+		container      Container
+		containerName  string
+		specCPUDefault *int64
+		specCPUMaximum *int64
+		want           *int64
+		err            error
+	}{
+		{
+			name:           "successful with default",
+			specCPU:        CPUMap{},
+			container:      Container{},
+			containerName:  "onecontainer",
+			specCPUDefault: &[]int64{100}[0],
+			specCPUMaximum: &[]int64{0}[0],
+			want:           &[]int64{100}[0],
+		},
+		{
+			name:           "successful with setting from user",
+			specCPU:        CPUMap{"onecontainer": milliCPU(500)},
+			container:      Container{},
+			containerName:  "onecontainer",
+			specCPUDefault: &[]int64{0}[0],
+			specCPUMaximum: &[]int64{0}[0],
+			want:           &[]int64{500}[0],
+		},
+		{
+			name:           "successful with setting from user for all workloads",
+			specCPU:        CPUMap{"": milliCPU(250)},
+			container:      Container{CPU: milliCPU(500)},
+			containerName:  "onecontainer",
+			specCPUDefault: &[]int64{0}[0],
+			specCPUMaximum: &[]int64{0}[0],
+			want:           &[]int64{250}[0],
+		},
+		{
+			name:           "successful with setting from Acornfile",
+			specCPU:        CPUMap{},
+			container:      Container{CPU: milliCPU(500)},
+			containerName:  "onecontainer",
+			specCPUDefault: &[]int64{100}[0],
+			specCPUMaximum: &[]int64{0}[0],
+			want:           &[]int64{500}[0],
+		},
+		{
+			name:           "unset cpu is left unset with a maximum",
+			specCPU:        CPUMap{},
+			container:      Container{},
+			containerName:  "onecontainer",
+			specCPUDefault: &[]int64{0}[0],
+			specCPUMaximum: &[]int64{1000}[0],
+			want:           &[]int64{0}[0],
+		},
+		{
+			name:           "user set exceeds maximum",
+			specCPU:        CPUMap{"onecontainer": milliCPU(2000)},
+			container:      Container{},
+			containerName:  "onecontainer",
+			specCPUDefault: &[]int64{0}[0],
+			specCPUMaximum: &[]int64{1000}[0],
+			err:            ErrInvalidSetCPU,
+		},
+		{
+			name:           "Acornfile exceeds maximum",
+			specCPU:        CPUMap{},
+			container:      Container{CPU: milliCPU(2000)},
+			containerName:  "onecontainer",
+			specCPUDefault: &[]int64{0}[0],
+			specCPUMaximum: &[]int64{1000}[0],
+			err:            ErrInvalidAcornCPU,
+		},
+		{
+			name:           "default exceeds maximum",
+			specCPU:        CPUMap{},
+			container:      Container{},
+			containerName:  "onecontainer",
+			specCPUDefault: &[]int64{2000}[0],
+			specCPUMaximum: &[]int64{1000}[0],
+			err:            ErrInvalidDefaultCPU,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ValidateCPU(tt.specCPU, tt.containerName, tt.container, tt.specCPUDefault, tt.specCPUMaximum)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			if assert.NoError(t, err) && tt.want != nil {
+				assert.EqualValues(t, *tt.want, actual.MilliValue())
+			}
+		})
+	}
+}
+
+func milliCPU(m int64) *resource.Quantity {
+	return resource.NewMilliQuantity(m, resource.DecimalSI)
+}
+
+func TestUnmarshalCPU(t *testing.T) {
+	var c Container
+	if assert.NoError(t, c.UnmarshalJSON([]byte(`{"image": "nginx", "cpu": "1500m"}`))) {
+		assert.EqualValues(t, 1500, c.CPU.MilliValue())
+	}
+
+	// As with Kubernetes quantities, a bare number is a count of cores
+	c = Container{}
+	if assert.NoError(t, c.UnmarshalJSON([]byte(`{"image": "nginx", "cpu": 2}`))) {
+		assert.EqualValues(t, 2000, c.CPU.MilliValue())
+	}
+
+	c = Container{}
+	if assert.NoError(t, c.UnmarshalJSON([]byte(`{"image": "nginx", "cpu": 0.5}`))) {
+		assert.EqualValues(t, 500, c.CPU.MilliValue())
+	}
+
+	var m CPUMap
+	if assert.NoError(t, m.UnmarshalJSON([]byte(`"2"`))) {
+		assert.EqualValues(t, 2000, m[""].MilliValue())
+	}
+
+	m = CPUMap{}
+	if assert.NoError(t, m.UnmarshalJSON([]byte(`{"web": "100m", "worker": 3}`))) {
+		assert.EqualValues(t, 100, m["web"].MilliValue())
+		assert.EqualValues(t, 3000, m["worker"].MilliValue())
+	}
+}
+
+func TestMarshalCPU(t *testing.T) {
+	c := Container{CPU: milliCPU(1500)}
+	data, err := json.Marshal(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var roundTrip Container
+	if assert.NoError(t, json.Unmarshal(data, &roundTrip)) {
+		assert.EqualValues(t, 1500, roundTrip.CPU.MilliValue())
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,6 +53,12 @@ type EventInstance struct {
 	//
 	// +optional
 	Details GenericMap `json:"details,omitempty"`
+
+	// Count is the number of repeated events that were collapsed into this one.
+	// It's only set when events are queried with collapse.
+	//
+	// +optional
+	Count int `json:"count,omitempty"`
 }
 
 // GetObserved returns the time that the Event was first observed.
@@ -124,3 +131,15 @@ type EventSource struct {
 func (e EventSource) String() string {
 	return fmt.Sprintf("%s/%s", e.Kind, e.Name)
 }
+
+// RepeatKey returns a key that is the same for events that only differ in the time they were observed.
+func (e EventInstance) RepeatKey() string {
+	return strings.Join([]string{
+		e.Type,
+		string(e.Severity),
+		e.Actor,
+		e.Source.String(),
+		string(e.Source.UID),
+		e.Description,
+	}, ",")
+}
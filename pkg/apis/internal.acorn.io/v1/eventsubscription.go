@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EventSubscriptionInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSubscriptionInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EventSubscriptionInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   EventSubscriptionInstanceSpec   `json:"spec,omitempty"`
+	Status EventSubscriptionInstanceStatus `json:"status,omitempty"`
+}
+
+type EventSubscriptionInstanceSpec struct {
+	// Types of the events to deliver, all types are delivered if empty
+	Types []string `json:"types,omitempty"`
+	// Severities of the events to deliver, all severities are delivered if empty
+	Severities []EventSeverity `json:"severities,omitempty"`
+	// SourceKinds of the events to deliver, like "app", all kinds are delivered if empty
+	SourceKinds []string `json:"sourceKinds,omitempty"`
+	// Apps are the names of the apps whose events are delivered, the events of all apps are delivered if empty
+	Apps []string `json:"apps,omitempty"`
+
+	Webhook EventWebhook `json:"webhook,omitempty"`
+}
+
+type EventWebhook struct {
+	// URL receives a POST request with the event as JSON
+	URL string `json:"url,omitempty"`
+	// SecretName is a secret in the project. The value of its "token" key is used to sign the requests.
+	SecretName string `json:"secretName,omitempty"`
+}
+
+type EventSubscriptionInstanceStatus struct {
+	LastDelivered *metav1.Time `json:"lastDelivered,omitempty"`
+	LastFailed    *metav1.Time `json:"lastFailed,omitempty"`
+	LastError     string       `json:"lastError,omitempty"`
+}
+
+// Matches returns true if the event is delivered to the subscription
+func (in *EventSubscriptionInstance) Matches(e *EventInstance) bool {
+	spec := in.Spec
+	if len(spec.Types) > 0 && !slices.Contains(spec.Types, e.Type) {
+		return false
+	}
+	if len(spec.Severities) > 0 && !slices.Contains(spec.Severities, e.Severity) {
+		return false
+	}
+	if len(spec.SourceKinds) > 0 && !slices.Contains(spec.SourceKinds, e.Source.Kind) {
+		return false
+	}
+	if len(spec.Apps) > 0 && (e.Source.Kind != "app" || !slices.Contains(spec.Apps, e.Source.Name)) {
+		return false
+	}
+	return true
+}
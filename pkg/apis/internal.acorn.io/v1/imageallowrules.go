@@ -10,6 +10,35 @@ import (
 type SignedBy struct {
 	AnyOf []string `json:"anyOf,omitempty"`
 	AllOf []string `json:"allOf,omitempty"`
+
+	// Keyless matches signatures made with short-lived certificates that were issued to an OIDC identity, e.g. by Fulcio
+	Keyless *KeylessSigner `json:"keyless,omitempty"`
+}
+
+// KeylessSigner describes the identities that keyless signatures must be made by and the roots that their
+// certificates and transparency log entries are verified against
+type KeylessSigner struct {
+	// Identities the signing certificate must match, one match is good enough
+	Identities []KeylessIdentity `json:"identities,omitempty"`
+
+	// CertificateAuthority is the PEM encoded root (and optional intermediate) certificates that signing certificates
+	// must chain up to. Defaults to the public Sigstore Fulcio roots.
+	CertificateAuthority string `json:"certificateAuthority,omitempty"`
+
+	// TransparencyLogKey is the PEM encoded public key of the transparency log that signatures must be included in.
+	// Defaults to the public Sigstore Rekor key.
+	TransparencyLogKey string `json:"transparencyLogKey,omitempty"`
+
+	// IgnoreTransparencyLog skips the check that the signature was included in the transparency log
+	IgnoreTransparencyLog bool `json:"ignoreTransparencyLog,omitempty"`
+}
+
+// KeylessIdentity matches the OIDC issuer and the subject (e.g. email or workflow URI) of a signing certificate
+type KeylessIdentity struct {
+	Issuer        string `json:"issuer,omitempty"`
+	IssuerRegexp  string `json:"issuerRegexp,omitempty"`
+	Subject       string `json:"subject,omitempty"`
+	SubjectRegexp string `json:"subjectRegexp,omitempty"`
 }
 
 type SignatureAnnotations struct {
@@ -35,6 +64,14 @@ type ImageAllowRuleSignatures struct {
 	Rules []SignatureRules `json:"rules,omitempty"`
 }
 
+// ImageAllowRuleSBOM describes the SBOMs that images must have, see acorn build --sbom
+type ImageAllowRuleSBOM struct {
+	// Required denies images that do not have a SBOM stored next to them
+	Required bool `json:"required,omitempty"`
+	// Formats the SBOM must be in (spdx, cyclonedx), any format if empty
+	Formats []string `json:"formats,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type ImageAllowRuleInstance struct {
@@ -43,6 +80,7 @@ type ImageAllowRuleInstance struct {
 
 	Images     []string                 `json:"images,omitempty"` // list of patterns to match against image names
 	Signatures ImageAllowRuleSignatures `json:"signatures,omitempty"`
+	SBOM       ImageAllowRuleSBOM       `json:"sbom,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	SyslogProtocolUDP = "udp"
+	SyslogProtocolTCP = "tcp"
+
+	// LogSinkSecretURLKey is the key of the URL in the secret of an HTTP log sink
+	LogSinkSecretURLKey = "url"
+)
+
+// LogSink is an endpoint that receives the logs of all containers, jobs and sidecars of an app
+type LogSink struct {
+	// HTTP posts batches of log entries as a JSON array to an endpoint
+	HTTP *HTTPLogSink `json:"http,omitempty"`
+	// Syslog sends every log entry as a RFC 5424 message to a syslog server
+	Syslog *SyslogLogSink `json:"syslog,omitempty"`
+	// File appends log entries as JSON lines to a file on a volume of the app
+	File *FileLogSink `json:"file,omitempty"`
+}
+
+type HTTPLogSink struct {
+	URL string `json:"url,omitempty"`
+	// Secret is the name of a secret in the project with the URL in the url key. URLs with credentials should be
+	// stored in a secret, so they aren't visible in the spec of the app or project.
+	Secret string `json:"secret,omitempty"`
+}
+
+type SyslogLogSink struct {
+	// Protocol is udp or tcp, udp is the default
+	Protocol string `json:"protocol,omitempty"`
+	Address  string `json:"address,omitempty"`
+}
+
+type FileLogSink struct {
+	Volume string `json:"volume,omitempty"`
+	Path   string `json:"path,omitempty"`
+}
+
+// ParseLogSink parses a log sink from a URL. http:// and https:// URLs are HTTP sinks, secret://name is an HTTP sink with
+// the URL in a secret, syslog://host:port and syslog+tcp://host:port are syslog sinks and file://volume/path is a file
+// on a volume. An empty string returns nil.
+func ParseLogSink(s string) (*LogSink, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log sink [%s]: %w", s, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid log sink [%s]: missing host or volume", s)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		if u.User != nil {
+			return nil, fmt.Errorf("invalid log sink [%s]: credentials in the URL would be stored in plain text, "+
+				"store the URL in the %s key of a secret and use secret://<secret name>", u.Redacted(), LogSinkSecretURLKey)
+		}
+		return &LogSink{HTTP: &HTTPLogSink{URL: s}}, nil
+	case "secret":
+		return &LogSink{HTTP: &HTTPLogSink{Secret: u.Host}}, nil
+	case "syslog", "syslog+udp":
+		return &LogSink{Syslog: &SyslogLogSink{Protocol: SyslogProtocolUDP, Address: u.Host}}, nil
+	case "syslog+tcp":
+		return &LogSink{Syslog: &SyslogLogSink{Protocol: SyslogProtocolTCP, Address: u.Host}}, nil
+	case "file":
+		path := strings.TrimPrefix(u.Path, "/")
+		if path == "" {
+			return nil, fmt.Errorf("invalid log sink [%s]: missing file path in the volume", s)
+		}
+		return &LogSink{File: &FileLogSink{Volume: u.Host, Path: path}}, nil
+	}
+	return nil, fmt.Errorf("invalid log sink [%s]: scheme must be http, https, secret, syslog, syslog+tcp or file", s)
+}
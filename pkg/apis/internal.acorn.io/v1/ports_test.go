@@ -98,6 +98,21 @@ func TestParseVolumesWithBinding(t *testing.T) {
 	}, vs[6])
 }
 
+func TestParseVolumesFromSnapshot(t *testing.T) {
+	vs, err := ParseVolumes([]string{"data,from=snapshot://nightly"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, VolumeBinding{
+		Target: "data",
+		From:   "snapshot://nightly",
+	}, vs[0])
+
+	_, err = ParseVolumes([]string{"data,from=nightly"}, true)
+	assert.Error(t, err)
+
+	_, err = ParseVolumes([]string{"data,from=snapshot://nightly"}, false)
+	assert.Error(t, err)
+}
+
 func TestParsePorts(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rancher/wrangler/pkg/name"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// RolloutStrategyRolling updates the containers of the app in place, this is the default
+	RolloutStrategyRolling = "rolling"
+	// RolloutStrategyBlueGreen runs the new revision next to the current one without sending it any traffic until the
+	// rollout is promoted
+	RolloutStrategyBlueGreen = "bluegreen"
+	// RolloutStrategyCanary runs the new revision next to the current one and shifts traffic to it in steps
+	RolloutStrategyCanary = "canary"
+
+	// RolloutRevisionCanary is the revision name of the containers of a new app image during a canary rollout
+	RolloutRevisionCanary = "canary"
+	// RolloutRevisionPreview is the revision name of the containers of a new app image during a blue/green rollout
+	RolloutRevisionPreview = "preview"
+	// RolloutRevisionStable is the revision name of the containers of the stable app image during a canary rollout
+	RolloutRevisionStable = "stable"
+	// RolloutProxy is the name suffix of the proxy that splits the traffic of a container during a canary rollout
+	RolloutProxy = "rollout"
+)
+
+// Rollout defines how the containers of the app are updated when the app image changes
+type Rollout struct {
+	Strategy string        `json:"strategy,omitempty"`
+	Steps    []RolloutStep `json:"steps,omitempty"`
+}
+
+// RolloutStep sends Weight percent of the traffic to the new revision of a canary rollout. Once the new revision is
+// ready the rollout moves to the next step after Pause, or waits to be promoted if Pause is not set.
+type RolloutStep struct {
+	Weight int32            `json:"weight,omitempty"`
+	Pause  *metav1.Duration `json:"pause,omitempty"`
+}
+
+func (in *Rollout) GetStrategy() string {
+	if in == nil || in.Strategy == "" {
+		return RolloutStrategyRolling
+	}
+	return in.Strategy
+}
+
+func (in *Rollout) Validate() error {
+	switch in.GetStrategy() {
+	case RolloutStrategyRolling, RolloutStrategyBlueGreen:
+		if len(in.Steps) > 0 {
+			return fmt.Errorf("rollout steps are only supported by the %s strategy", RolloutStrategyCanary)
+		}
+	case RolloutStrategyCanary:
+		if len(in.Steps) == 0 {
+			return fmt.Errorf("rollout strategy %s requires at least one step", RolloutStrategyCanary)
+		}
+		var last int32
+		for i, step := range in.Steps {
+			if step.Weight < 1 || step.Weight > 100 {
+				return fmt.Errorf("rollout step %d weight [%d] must be between 1 and 100", i+1, step.Weight)
+			}
+			if step.Weight < last {
+				return fmt.Errorf("rollout step %d weight [%d] must not be less than the previous step", i+1, step.Weight)
+			}
+			last = step.Weight
+		}
+	default:
+		return fmt.Errorf("invalid rollout strategy [%s], must be one of %s, %s or %s", in.Strategy,
+			RolloutStrategyRolling, RolloutStrategyBlueGreen, RolloutStrategyCanary)
+	}
+	return nil
+}
+
+// RolloutRevision is the app image and the spec parsed from it that containers are deployed from
+type RolloutRevision struct {
+	AppImage AppImage `json:"appImage,omitempty"`
+	AppSpec  AppSpec  `json:"appSpec,omitempty"`
+}
+
+type RolloutStatus struct {
+	Strategy string `json:"strategy,omitempty"`
+	// Stable is the revision that is kept running until the rollout of a new app image is promoted
+	Stable RolloutRevision `json:"stable,omitempty"`
+	// Digest is the digest of the app image being rolled out
+	Digest        string       `json:"digest,omitempty"`
+	Step          int          `json:"step,omitempty"`
+	Weight        int32        `json:"weight,omitempty"`
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+	// Ready is true when all containers of the new revision are ready
+	Ready bool `json:"ready,omitempty"`
+	// Promote and PromoteFull are set by the apps/promote subresource and cleared once the rollout has moved on
+	Promote     bool `json:"promote,omitempty"`
+	PromoteFull bool `json:"promoteFull,omitempty"`
+	// RolledBackImage and RolledBackDigest are the app image of the last rollout that was rolled back. Auto-upgrades
+	// skip this image so that the app is not upgraded to it again.
+	RolledBackImage  string `json:"rolledBackImage,omitempty"`
+	RolledBackDigest string `json:"rolledBackDigest,omitempty"`
+}
+
+// IsRolledBack returns true if the image, or the digest if it is known, is the one the last rollout was rolled back from
+func (in *RolloutStatus) IsRolledBack(image, digest string) bool {
+	if in == nil || in.RolledBackImage == "" {
+		return false
+	}
+	if digest != "" {
+		return strings.TrimPrefix(digest, "sha256:") == strings.TrimPrefix(in.RolledBackDigest, "sha256:")
+	}
+	return image == in.RolledBackImage
+}
+
+// InProgress returns true if a new app image is being rolled out next to the stable revision
+func (in *RolloutStatus) InProgress(appImage AppImage) bool {
+	return in != nil && in.Stable.AppImage.GetDigest() != "" && in.Stable.AppImage.GetDigest() != appImage.GetDigest()
+}
+
+// Revision returns the name of the revision the containers of the new app image run as
+func (in *RolloutStatus) Revision() string {
+	if in != nil && in.Strategy == RolloutStrategyBlueGreen {
+		return RolloutRevisionPreview
+	}
+	return RolloutRevisionCanary
+}
+
+// DeploymentName returns the name of the deployment of the container for the new revision
+func (in *RolloutStatus) DeploymentName(containerName string) string {
+	return name.SafeConcatName(containerName, in.Revision())
+}
+
+// RolloutInPlace returns true if the container is updated in place instead of running next to the stable revision during a
+// rollout. That is the case for containers with volumes that can only be attached once and for containers that are not in
+// the stable revision.
+func (in *AppInstanceStatus) RolloutInPlace(containerName string) bool {
+	container, ok := in.AppSpec.Containers[containerName]
+	if !ok || in.Rollout == nil {
+		return true
+	}
+	if _, ok := in.Rollout.Stable.AppSpec.Containers[containerName]; !ok {
+		return true
+	}
+	return in.AppSpec.IsStateful(container)
+}
+
+// RolloutProxied returns true if the traffic of the container is split between the stable and the canary revision by
+// the rollout proxy of the container. The service of the container targets the proxy until the rollout is complete.
+func (in *AppInstanceStatus) RolloutProxied(containerName string) bool {
+	return in.Rollout.InProgress(in.AppImage) && in.Rollout.Strategy == RolloutStrategyCanary && !in.RolloutInPlace(containerName)
+}
+
+// ServiceName returns the name of the service of the container that only targets the pods of the given revision
+func (in *RolloutStatus) ServiceName(containerName, revision string) string {
+	return name.SafeConcatName(containerName, revision)
+}
+
+// ProxyName returns the name of the proxy that splits the traffic of the container during a canary rollout
+func (in *RolloutStatus) ProxyName(containerName string) string {
+	return name.SafeConcatName(containerName, RolloutProxy)
+}
+
+// RolloutNameConflict returns the name of a container, job, router, service or nested acorn of the app that is the
+// name of a deployment, service or proxy of another container during a rollout, like web-canary for the container web,
+// and the name of that container. The objects of the rollout would overwrite the ones of the app.
+func (in *AppSpec) RolloutNameConflict() (string, string, bool) {
+	reserved := map[string]string{}
+	for containerName := range in.Containers {
+		for _, suffix := range []string{RolloutRevisionCanary, RolloutRevisionPreview, RolloutRevisionStable, RolloutProxy} {
+			reserved[name.SafeConcatName(containerName, suffix)] = containerName
+		}
+	}
+
+	var names []string
+	for _, objectNames := range [][]string{
+		keys(in.Containers), keys(in.Jobs), keys(in.Routers), keys(in.Services), keys(in.Acorns),
+	} {
+		names = append(names, objectNames...)
+	}
+	sort.Strings(names)
+
+	for _, objectName := range names {
+		if containerName, ok := reserved[objectName]; ok {
+			return objectName, containerName, true
+		}
+	}
+	return "", "", false
+}
+
+func keys[T any](m map[string]T) (result []string) {
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
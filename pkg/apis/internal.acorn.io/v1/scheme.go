@@ -32,6 +32,10 @@ func AddToScheme(scheme *runtime.Scheme) error {
 		&EventInstanceList{},
 		&DevSessionInstance{},
 		&DevSessionInstanceList{},
+		&VolumeSnapshotInstance{},
+		&VolumeSnapshotInstanceList{},
+		&EventSubscriptionInstance{},
+		&EventSubscriptionInstanceList{},
 	)
 
 	// Add common types
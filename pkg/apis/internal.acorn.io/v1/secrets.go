@@ -9,6 +9,7 @@ const (
 	SecretTypeTemplate  corev1.SecretType = "secrets.acorn.io/template"
 	SecretTypeBasic     corev1.SecretType = "secrets.acorn.io/basic"
 	SecretTypeToken     corev1.SecretType = "secrets.acorn.io/token"
+	SecretTypeExternal  corev1.SecretType = "secrets.acorn.io/external"
 )
 
 var (
@@ -18,5 +19,6 @@ var (
 		SecretTypeTemplate:  true,
 		SecretTypeBasic:     true,
 		SecretTypeToken:     true,
+		SecretTypeExternal:  true,
 	}
 )
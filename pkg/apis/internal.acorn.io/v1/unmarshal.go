@@ -25,34 +25,65 @@ var (
 	ReadVerbs           = []string{"get", "list", "watch"}
 )
 
-type routeTarget struct {
-	PathType          PathType `json:"pathType,omitempty"`
-	TargetPort        int      `json:"targetPort,omitempty"`
-	TargetServiceName string   `json:"targetServiceName,omitempty"`
-}
+// routeValue is a route without its path, the value of the map form of routes
+type routeValue Route
 
-func (in *routeTarget) UnmarshalJSON(data []byte) error {
+func (in *routeValue) UnmarshalJSON(data []byte) error {
 	if !isString(data) {
-		type routeTargetType routeTarget
-		return json.Unmarshal(data, (*routeTargetType)(in))
+		type routeValueType routeValue
+		return json.Unmarshal(data, (*routeValueType)(in))
 	}
 
 	s, err := parseString(data)
 	if err != nil {
 		return err
 	}
+	in.TargetServiceName, in.TargetPort, err = parseRouteTarget(s)
+	if err != nil {
+		return err
+	}
+	in.PathType = PathTypePrefix
+	return nil
+}
+
+// parseRouteTarget parses a target in the format service[:port]
+func parseRouteTarget(s string) (string, int, error) {
 	parts := strings.Split(s, ":")
 	if len(parts) == 2 {
 		n, err := strconv.Atoi(parts[1])
 		if err != nil {
-			return fmt.Errorf("failed to parse port %s in %s: %w", parts[1], s, err)
+			return "", 0, fmt.Errorf("failed to parse port %s in %s: %w", parts[1], s, err)
 		}
-		in.TargetPort = n
-		in.TargetServiceName = parts[0]
-	} else {
-		in.TargetServiceName = s
+		return parts[0], n, nil
 	}
-	in.PathType = PathTypePrefix
+	return s, 0, nil
+}
+
+func (in *RouteTarget) UnmarshalJSON(data []byte) error {
+	if !isString(data) {
+		type routeTarget RouteTarget
+		return json.Unmarshal(data, (*routeTarget)(in))
+	}
+
+	s, err := parseString(data)
+	if err != nil {
+		return err
+	}
+	in.TargetServiceName, in.TargetPort, err = parseRouteTarget(s)
+	return err
+}
+
+func (in *RouteRedirect) UnmarshalJSON(data []byte) error {
+	if !isString(data) {
+		type routeRedirect RouteRedirect
+		return json.Unmarshal(data, (*routeRedirect)(in))
+	}
+
+	s, err := parseString(data)
+	if err != nil {
+		return err
+	}
+	in.URL = s
 	return nil
 }
 
@@ -62,18 +93,15 @@ func (in *Routes) UnmarshalJSON(data []byte) error {
 		return json.Unmarshal(data, (*routesType)(in))
 	}
 
-	routeMap := map[string]routeTarget{}
+	routeMap := map[string]routeValue{}
 	if err := json.Unmarshal(data, &routeMap); err != nil {
 		return err
 	}
 	var routes []Route
 	for k, v := range routeMap {
-		routes = append(routes, Route{
-			Path:              k,
-			TargetServiceName: v.TargetServiceName,
-			TargetPort:        v.TargetPort,
-			PathType:          v.PathType,
-		})
+		route := Route(v)
+		route.Path = k
+		routes = append(routes, route)
 	}
 	sort.Slice(routes, func(i, j int) bool {
 		if len(routes[i].Path) > len(routes[j].Path) {
@@ -174,6 +202,20 @@ func (in *MemoryMap) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (in *CPUMap) UnmarshalJSON(data []byte) error {
+	if isObject(data) {
+		return json.Unmarshal(data, (*map[string]*resource.Quantity)(in))
+	}
+	var quantity resource.Quantity
+	if err := json.Unmarshal(data, &quantity); err != nil {
+		return err
+	}
+	*in = CPUMap{
+		"": &quantity,
+	}
+	return nil
+}
+
 func (in *ServiceBindings) UnmarshalJSON(data []byte) error {
 	if isArray(data) {
 		return json.Unmarshal(data, (*[]ServiceBinding)(in))
@@ -461,6 +503,12 @@ func (in *AppSpec) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	if in.Rollout != nil {
+		if err := in.Rollout.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return checkForDuplicateNames(in)
 }
 
@@ -663,13 +711,101 @@ func (in *Acorn) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// extractAutoscale removes scale from the container data if it is defined as an object
+// so that the remaining data can be decoded with scale as a fixed replica count.
+func extractAutoscale(data []byte) ([]byte, *Autoscale, error) {
+	var scale struct {
+		Scale json.RawMessage `json:"scale,omitempty"`
+	}
+	if err := json.Unmarshal(data, &scale); err != nil {
+		return nil, nil, err
+	}
+	if !isObject(scale.Scale) {
+		return data, nil, nil
+	}
+
+	autoscale := &Autoscale{}
+	if err := json.Unmarshal(scale.Scale, autoscale); err != nil {
+		return nil, nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, nil, err
+	}
+	delete(fields, "scale")
+
+	data, err := json.Marshal(fields)
+	return data, autoscale, err
+}
+
+func validateAutoscale(c Container) error {
+	if c.Autoscale == nil {
+		return nil
+	}
+	if c.Autoscale.Max < 1 {
+		return fmt.Errorf("scale max must be greater than zero")
+	}
+	if c.Autoscale.Max < c.Autoscale.GetMin() {
+		return fmt.Errorf("scale max [%d] must be greater than or equal to min [%d]", c.Autoscale.Max, c.Autoscale.GetMin())
+	}
+	if c.Autoscale.TargetCPU == nil && c.Autoscale.TargetMemory == nil && c.Autoscale.Metric == nil {
+		return fmt.Errorf("scale must define at least one of targetCPU, targetMemory or metric")
+	}
+	if c.Autoscale.Metric != nil {
+		if c.Autoscale.Metric.Name == "" || c.Autoscale.Metric.TargetAverageValue == "" {
+			return fmt.Errorf("scale metric must define name and targetAverageValue")
+		}
+		if _, err := resource.ParseQuantity(c.Autoscale.Metric.TargetAverageValue); err != nil {
+			return fmt.Errorf("invalid scale metric targetAverageValue: %w", err)
+		}
+		if c.Metrics.Port == 0 || c.Metrics.Path == "" {
+			return fmt.Errorf("scale metric requires metrics port and path to be defined")
+		}
+	}
+	return nil
+}
+
+func validateJobOptions(c Container) error {
+	switch c.Concurrency {
+	case "", JobConcurrencyForbid, JobConcurrencyReplace, JobConcurrencyAllow:
+	default:
+		return fmt.Errorf("invalid concurrency [%s], must be %s, %s or %s", c.Concurrency, JobConcurrencyForbid, JobConcurrencyReplace, JobConcurrencyAllow)
+	}
+	if c.DeadlineSeconds != nil && *c.DeadlineSeconds < 1 {
+		return fmt.Errorf("deadlineSeconds [%d] must be greater than 0", *c.DeadlineSeconds)
+	}
+	if c.SuccessfulHistory != nil && *c.SuccessfulHistory < 0 {
+		return fmt.Errorf("successfulHistory [%d] must not be negative", *c.SuccessfulHistory)
+	}
+	if c.FailedHistory != nil && *c.FailedHistory < 0 {
+		return fmt.Errorf("failedHistory [%d] must not be negative", *c.FailedHistory)
+	}
+	return nil
+}
+
 func (in *Container) UnmarshalJSON(data []byte) error {
+	data, autoscale, err := extractAutoscale(data)
+	if err != nil {
+		return err
+	}
+
 	var c Container
 	type container Container
 	if err := json.Unmarshal(data, (*container)(&c)); err != nil {
 		return err
 	}
 
+	if autoscale != nil {
+		c.Autoscale = autoscale
+	}
+	if err := validateAutoscale(c); err != nil {
+		return err
+	}
+	if err := validateJobOptions(c); err != nil {
+		return err
+	}
+
 	var alias containerAliases
 	if err := json.Unmarshal(data, &alias); err != nil {
 		return err
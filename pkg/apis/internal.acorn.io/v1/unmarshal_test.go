@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
 
@@ -43,3 +44,99 @@ func TestParseEnv(t *testing.T) {
 		Value: "y111",
 	}, f[1])
 }
+
+func TestParseScale(t *testing.T) {
+	c := Container{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"image":"nginx","scale":3}`), &c))
+	assert.Equal(t, int32(3), *c.Scale)
+	assert.Nil(t, c.Autoscale)
+
+	c = Container{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"image":"nginx","scale":{"min":2,"max":5,"targetCPU":80}}`), &c))
+	assert.Nil(t, c.Scale)
+	assert.Equal(t, "nginx", c.Image)
+	assert.Equal(t, &Autoscale{
+		Min:       &[]int32{2}[0],
+		Max:       5,
+		TargetCPU: &[]int32{80}[0],
+	}, c.Autoscale)
+
+	c = Container{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"metrics":{"port":9090,"path":"/metrics"},"scale":{"max":5,"metric":{"name":"requests","targetAverageValue":"10"}}}`), &c))
+	assert.Equal(t, int32(1), c.Autoscale.GetMin())
+	assert.Equal(t, "requests", c.Autoscale.Metric.Name)
+}
+
+func TestParseScaleInvalid(t *testing.T) {
+	for _, input := range []string{
+		`{"scale":{"min":2}}`,
+		`{"scale":{"min":5,"max":2,"targetCPU":80}}`,
+		`{"scale":{"max":2}}`,
+		`{"scale":{"max":2,"metric":{"name":"requests","targetAverageValue":"10"}}}`,
+	} {
+		c := Container{}
+		assert.Error(t, json.Unmarshal([]byte(input), &c), input)
+	}
+}
+
+func TestParseJobOptions(t *testing.T) {
+	c := Container{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"schedule":"hourly","concurrency":"forbid","suspend":true,"deadlineSeconds":600,"successfulHistory":5,"failedHistory":0}`), &c))
+	assert.Equal(t, JobConcurrencyForbid, c.Concurrency)
+	assert.True(t, c.Suspend)
+	assert.Equal(t, int64(600), *c.DeadlineSeconds)
+	assert.Equal(t, int32(5), *c.SuccessfulHistory)
+	assert.Equal(t, int32(0), *c.FailedHistory)
+}
+
+func TestParseJobOptionsInvalid(t *testing.T) {
+	for _, input := range []string{
+		`{"concurrency":"queue"}`,
+		`{"deadlineSeconds":0}`,
+		`{"successfulHistory":-1}`,
+		`{"failedHistory":-1}`,
+	} {
+		c := Container{}
+		assert.Error(t, json.Unmarshal([]byte(input), &c), input)
+	}
+}
+
+func TestParseRoutes(t *testing.T) {
+	r := Router{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"routes":{
+		"/": "web:8080",
+		"/api": {"targets": ["api", {"targetServiceName": "api-canary", "targetPort": 81, "weight": 0}], "rewrite": "/", "timeoutSeconds": 30},
+		"/admin": {"pathType": "exact", "targetServiceName": "admin", "headers": {"X-Admin": "true"}, "query": {"debug": "1"}},
+		"/old": {"redirect": "https://example.com/new"}
+	}}`), &r))
+	assert.Equal(t, Routes{
+		{
+			Path:              "/admin",
+			PathType:          PathTypeExact,
+			TargetServiceName: "admin",
+			Headers:           map[string]string{"X-Admin": "true"},
+			Query:             map[string]string{"debug": "1"},
+		},
+		{
+			Path: "/api",
+			Targets: []RouteTarget{
+				{TargetServiceName: "api"},
+				{TargetServiceName: "api-canary", TargetPort: 81, Weight: new(int)},
+			},
+			Rewrite:        "/",
+			TimeoutSeconds: 30,
+		},
+		{
+			Path:     "/old",
+			Redirect: &RouteRedirect{URL: "https://example.com/new"},
+		},
+		{
+			Path:              "/",
+			PathType:          PathTypePrefix,
+			TargetServiceName: "web",
+			TargetPort:        8080,
+		},
+	}, r.Routes)
+	assert.False(t, r.Routes[3].Proxied())
+	assert.True(t, r.Routes[2].Proxied())
+}
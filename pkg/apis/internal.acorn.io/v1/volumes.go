@@ -35,6 +35,12 @@ func ParseVolumes(args []string, fromCLI bool) (result []VolumeBinding, _ error)
 				return nil, fmt.Errorf("parsing [%s]: %w", arg, err)
 			}
 			volumeBinding.Size = q
+			volumeBinding.From = strings.TrimSpace(kvOpts["from"])
+			if volumeBinding.From != "" {
+				if _, ok := ParseSnapshotFrom(volumeBinding.From); !ok {
+					return nil, fmt.Errorf("parsing [%s]: invalid from [%s], must be in the format %sNAME", arg, volumeBinding.From, SnapshotFromPrefix)
+				}
+			}
 		} else if len(kvOpts) > 0 {
 			return nil, fmt.Errorf("options [%s] are not supported in acorn volume binding definition", opts)
 		}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SnapshotFromPrefix is the prefix used in a volume "from" field to refer to a volume snapshot
+	SnapshotFromPrefix = "snapshot://"
+
+	// VolumeSnapshotMethodCSI is used when the volume is backed by a CSI driver that supports VolumeSnapshots
+	VolumeSnapshotMethodCSI = "csi"
+	// VolumeSnapshotMethodCopy is used when CSI snapshots are not available and the data is copied by a helper job
+	VolumeSnapshotMethodCopy = "copy"
+
+	VolumeSnapshotConditionReady = "ready"
+)
+
+// ParseSnapshotFrom returns the snapshot name from a "snapshot://name" string. The second return value
+// is false if the string does not reference a snapshot.
+func ParseSnapshotFrom(from string) (string, bool) {
+	name, ok := strings.CutPrefix(strings.TrimSpace(from), SnapshotFromPrefix)
+	if !ok || name == "" {
+		return "", false
+	}
+	return name, true
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshotInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshotInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshotInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   VolumeSnapshotInstanceSpec   `json:"spec,omitempty"`
+	Status VolumeSnapshotInstanceStatus `json:"status,omitempty"`
+}
+
+type VolumeSnapshotInstanceSpec struct {
+	// VolumeName is the name of the volume (as shown by "acorn volumes") to snapshot
+	VolumeName string `json:"volumeName,omitempty"`
+}
+
+type VolumeSnapshotInstanceStatus struct {
+	// Method is how the snapshot was taken, either "csi" or "copy"
+	Method       string `json:"method,omitempty"`
+	AppName      string `json:"appName,omitempty"`
+	AppNamespace string `json:"appNamespace,omitempty"`
+	VolumeName   string `json:"volumeName,omitempty"`
+	// ClaimName is the PersistentVolumeClaim in the app namespace that was snapshotted
+	ClaimName string `json:"claimName,omitempty"`
+	// ClassName is the VolumeSnapshotClass for the "csi" method or the StorageClass of the copy for the "copy" method
+	ClassName string `json:"className,omitempty"`
+	// SnapshotName is the name of the VolumeSnapshot or PersistentVolumeClaim in the app namespace holding the data
+	SnapshotName string             `json:"snapshotName,omitempty"`
+	ReadyToUse   bool               `json:"readyToUse,omitempty"`
+	RestoreSize  *resource.Quantity `json:"restoreSize,omitempty"`
+	Conditions   []Condition        `json:"conditions,omitempty"`
+}
+
+func (in *VolumeSnapshotInstance) Conditions() *[]Condition {
+	return &in.Status.Conditions
+}
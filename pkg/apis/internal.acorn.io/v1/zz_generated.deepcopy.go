@@ -106,6 +106,16 @@ func (in *Acorn) DeepCopyInto(out *Acorn) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = make(CPUMap, len(*in))
+		for key, val := range *in {
+			{
+				x := val.DeepCopy()
+				(*out)[key] = &x
+			}
+		}
+	}
 	if in.ComputeClasses != nil {
 		in, out := &in.ComputeClasses, &out.ComputeClasses
 		*out = make(ComputeClassMap, len(*in))
@@ -237,6 +247,16 @@ func (in *AcornImageBuildInstanceSpec) DeepCopyInto(out *AcornImageBuildInstance
 	}
 	out.Args = in.Args.DeepCopy()
 	in.VCS.DeepCopyInto(&out.VCS)
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(BuildCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reuse != nil {
+		in, out := &in.Reuse, &out.Reuse
+		*out = new(ImagesData)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcornImageBuildInstanceSpec.
@@ -485,6 +505,21 @@ func (in *AppInstanceSpec) DeepCopyInto(out *AppInstanceSpec) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = make(CPUMap, len(*in))
+		for key, val := range *in {
+			{
+				x := val.DeepCopy()
+				(*out)[key] = &x
+			}
+		}
+	}
+	if in.LogSink != nil {
+		in, out := &in.LogSink, &out.LogSink
+		*out = new(LogSink)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppInstanceSpec.
@@ -524,6 +559,30 @@ func (in *AppInstanceStatus) DeepCopyInto(out *AppInstanceStatus) {
 		}
 	}
 	in.Defaults.DeepCopyInto(&out.Defaults)
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]AppRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeRestores != nil {
+		in, out := &in.VolumeRestores, &out.VolumeRestores
+		*out = make(map[string]VolumeRestore, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.RollbackRevision != nil {
+		in, out := &in.RollbackRevision, &out.RollbackRevision
+		*out = new(AppRevision)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppInstanceStatus.
@@ -536,6 +595,28 @@ func (in *AppInstanceStatus) DeepCopy() *AppInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRevision) DeepCopyInto(out *AppRevision) {
+	*out = *in
+	out.DeployArgs = in.DeployArgs.DeepCopy()
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Created.DeepCopyInto(&out.Created)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRevision.
+func (in *AppRevision) DeepCopy() *AppRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppSpec) DeepCopyInto(out *AppSpec) {
 	*out = *in
@@ -609,6 +690,11 @@ func (in *AppSpec) DeepCopyInto(out *AppSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(Rollout)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppSpec.
@@ -690,6 +776,75 @@ func (in *AppStatus) DeepCopy() *AppStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Autoscale) DeepCopyInto(out *Autoscale) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPU != nil {
+		in, out := &in.TargetCPU, &out.TargetCPU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemory != nil {
+		in, out := &in.TargetMemory, &out.TargetMemory
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(AutoscaleMetric)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscale.
+func (in *Autoscale) DeepCopy() *Autoscale {
+	if in == nil {
+		return nil
+	}
+	out := new(Autoscale)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscaleMetric) DeepCopyInto(out *AutoscaleMetric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscaleMetric.
+func (in *AutoscaleMetric) DeepCopy() *AutoscaleMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscaleMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscaleStatus) DeepCopyInto(out *AutoscaleStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscaleStatus.
+func (in *AutoscaleStatus) DeepCopy() *AutoscaleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscaleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Build) DeepCopyInto(out *Build) {
 	*out = *in
@@ -707,6 +862,11 @@ func (in *Build) DeepCopyInto(out *Build) {
 			(*out)[key] = val
 		}
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(BuildCache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Build.
@@ -719,6 +879,26 @@ func (in *Build) DeepCopy() *Build {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildCache) DeepCopyInto(out *BuildCache) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildCache.
+func (in *BuildCache) DeepCopy() *BuildCache {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildRecord) DeepCopyInto(out *BuildRecord) {
 	*out = *in
@@ -877,6 +1057,30 @@ func (in *BuilderSpec) DeepCopy() *BuilderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in CPUMap) DeepCopyInto(out *CPUMap) {
+	{
+		in := &in
+		*out = make(CPUMap, len(*in))
+		for key, val := range *in {
+			{
+				x := val.DeepCopy()
+				(*out)[key] = &x
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUMap.
+func (in CPUMap) DeepCopy() CPUMap {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUMap)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in CommandSlice) DeepCopyInto(out *CommandSlice) {
 	{
@@ -1041,17 +1245,47 @@ func (in *Container) DeepCopyInto(out *Container) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	out.Metrics = in.Metrics
 	if in.Scale != nil {
 		in, out := &in.Scale, &out.Scale
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Autoscale != nil {
+		in, out := &in.Autoscale, &out.Autoscale
+		*out = new(Autoscale)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadlineSeconds != nil {
+		in, out := &in.DeadlineSeconds, &out.DeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessfulHistory != nil {
+		in, out := &in.SuccessfulHistory, &out.SuccessfulHistory
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedHistory != nil {
+		in, out := &in.FailedHistory, &out.FailedHistory
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Events != nil {
 		in, out := &in.Events, &out.Events
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Dev != nil {
+		in, out := &in.Dev, &out.Dev
+		*out = new(DevConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Sidecars != nil {
 		in, out := &in.Sidecars, &out.Sidecars
 		*out = make(map[string]Container, len(*in))
@@ -1120,6 +1354,21 @@ func (in *ContainerImageBuilderSpec) DeepCopy() *ContainerImageBuilderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRolloutStatus) DeepCopyInto(out *ContainerRolloutStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRolloutStatus.
+func (in *ContainerRolloutStatus) DeepCopy() *ContainerRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerStatus) DeepCopyInto(out *ContainerStatus) {
 	*out = *in
@@ -1138,6 +1387,16 @@ func (in *ContainerStatus) DeepCopyInto(out *ContainerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Autoscale != nil {
+		in, out := &in.Autoscale, &out.Autoscale
+		*out = new(AutoscaleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ContainerRolloutStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerStatus.
@@ -1175,6 +1434,21 @@ func (in *Defaults) DeepCopyInto(out *Defaults) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = make(map[string]*int64, len(*in))
+		for key, val := range *in {
+			var outVal *int64
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(int64)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Defaults.
@@ -1251,6 +1525,46 @@ func (in *DependencyStatus) DeepCopy() *DependencyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DevConfig) DeepCopyInto(out *DevConfig) {
+	*out = *in
+	if in.OnChange != nil {
+		in, out := &in.OnChange, &out.OnChange
+		*out = new(DevOnChange)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevConfig.
+func (in *DevConfig) DeepCopy() *DevConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DevConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DevOnChange) DeepCopyInto(out *DevOnChange) {
+	*out = *in
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevOnChange.
+func (in *DevOnChange) DeepCopy() *DevOnChange {
+	if in == nil {
+		return nil
+	}
+	out := new(DevOnChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DevSessionImageSource) DeepCopyInto(out *DevSessionImageSource) {
 	*out = *in
@@ -1527,69 +1841,217 @@ func (in *EventSource) DeepCopy() *EventSource {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecProbe) DeepCopyInto(out *ExecProbe) {
+func (in *EventSubscriptionInstance) DeepCopyInto(out *EventSubscriptionInstance) {
 	*out = *in
-	if in.Command != nil {
-		in, out := &in.Command, &out.Command
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecProbe.
-func (in *ExecProbe) DeepCopy() *ExecProbe {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionInstance.
+func (in *EventSubscriptionInstance) DeepCopy() *EventSubscriptionInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecProbe)
+	out := new(EventSubscriptionInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSubscriptionInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExpressionError) DeepCopyInto(out *ExpressionError) {
+func (in *EventSubscriptionInstanceList) DeepCopyInto(out *EventSubscriptionInstanceList) {
 	*out = *in
-	if in.DependencyNotFound != nil {
-		in, out := &in.DependencyNotFound, &out.DependencyNotFound
-		*out = new(DependencyNotFound)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EventSubscriptionInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpressionError.
-func (in *ExpressionError) DeepCopy() *ExpressionError {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionInstanceList.
+func (in *EventSubscriptionInstanceList) DeepCopy() *EventSubscriptionInstanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(ExpressionError)
+	out := new(EventSubscriptionInstanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSubscriptionInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *File) DeepCopyInto(out *File) {
+func (in *EventSubscriptionInstanceSpec) DeepCopyInto(out *EventSubscriptionInstanceSpec) {
 	*out = *in
-	out.Secret = in.Secret
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]EventSeverity, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceKinds != nil {
+		in, out := &in.SourceKinds, &out.SourceKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Webhook = in.Webhook
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
-func (in *File) DeepCopy() *File {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionInstanceSpec.
+func (in *EventSubscriptionInstanceSpec) DeepCopy() *EventSubscriptionInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(File)
+	out := new(EventSubscriptionInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in Files) DeepCopyInto(out *Files) {
-	{
-		in := &in
-		*out = make(Files, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+func (in *EventSubscriptionInstanceStatus) DeepCopyInto(out *EventSubscriptionInstanceStatus) {
+	*out = *in
+	if in.LastDelivered != nil {
+		in, out := &in.LastDelivered, &out.LastDelivered
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailed != nil {
+		in, out := &in.LastFailed, &out.LastFailed
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionInstanceStatus.
+func (in *EventSubscriptionInstanceStatus) DeepCopy() *EventSubscriptionInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSubscriptionInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventWebhook) DeepCopyInto(out *EventWebhook) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventWebhook.
+func (in *EventWebhook) DeepCopy() *EventWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(EventWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecProbe) DeepCopyInto(out *ExecProbe) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecProbe.
+func (in *ExecProbe) DeepCopy() *ExecProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpressionError) DeepCopyInto(out *ExpressionError) {
+	*out = *in
+	if in.DependencyNotFound != nil {
+		in, out := &in.DependencyNotFound, &out.DependencyNotFound
+		*out = new(DependencyNotFound)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpressionError.
+func (in *ExpressionError) DeepCopy() *ExpressionError {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpressionError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *File) DeepCopyInto(out *File) {
+	*out = *in
+	out.Secret = in.Secret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
+func (in *File) DeepCopy() *File {
+	if in == nil {
+		return nil
+	}
+	out := new(File)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileLogSink) DeepCopyInto(out *FileLogSink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileLogSink.
+func (in *FileLogSink) DeepCopy() *FileLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Files) DeepCopyInto(out *Files) {
+	{
+		in := &in
+		*out = make(Files, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
@@ -1628,6 +2090,21 @@ func (in GenericMap) DeepCopy() GenericMap {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPLogSink) DeepCopyInto(out *HTTPLogSink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPLogSink.
+func (in *HTTPLogSink) DeepCopy() *HTTPLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPProbe) DeepCopyInto(out *HTTPProbe) {
 	*out = *in
@@ -1686,6 +2163,7 @@ func (in *ImageAllowRuleInstance) DeepCopyInto(out *ImageAllowRuleInstance) {
 		copy(*out, *in)
 	}
 	in.Signatures.DeepCopyInto(&out.Signatures)
+	in.SBOM.DeepCopyInto(&out.SBOM)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAllowRuleInstance.
@@ -1738,6 +2216,26 @@ func (in *ImageAllowRuleInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAllowRuleSBOM) DeepCopyInto(out *ImageAllowRuleSBOM) {
+	*out = *in
+	if in.Formats != nil {
+		in, out := &in.Formats, &out.Formats
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAllowRuleSBOM.
+func (in *ImageAllowRuleSBOM) DeepCopy() *ImageAllowRuleSBOM {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAllowRuleSBOM)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageAllowRuleSignatures) DeepCopyInto(out *ImageAllowRuleSignatures) {
 	*out = *in
@@ -1912,6 +2410,29 @@ func (in *ImagesData) DeepCopy() *ImagesData {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobRun) DeepCopyInto(out *JobRun) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobRun.
+func (in *JobRun) DeepCopy() *JobRun {
+	if in == nil {
+		return nil
+	}
+	out := new(JobRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JobStatus) DeepCopyInto(out *JobStatus) {
 	*out = *in
@@ -1930,6 +2451,13 @@ func (in *JobStatus) DeepCopyInto(out *JobStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Runs != nil {
+		in, out := &in.Runs, &out.Runs
+		*out = make([]JobRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobStatus.
@@ -1942,6 +2470,71 @@ func (in *JobStatus) DeepCopy() *JobStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessIdentity) DeepCopyInto(out *KeylessIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessIdentity.
+func (in *KeylessIdentity) DeepCopy() *KeylessIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessSigner) DeepCopyInto(out *KeylessSigner) {
+	*out = *in
+	if in.Identities != nil {
+		in, out := &in.Identities, &out.Identities
+		*out = make([]KeylessIdentity, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessSigner.
+func (in *KeylessSigner) DeepCopy() *KeylessSigner {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessSigner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSink) DeepCopyInto(out *LogSink) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPLogSink)
+		**out = **in
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogLogSink)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileLogSink)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSink.
+func (in *LogSink) DeepCopy() *LogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in MemoryMap) DeepCopyInto(out *MemoryMap) {
 	{
@@ -2314,9 +2907,114 @@ func (in *ReplicasSummary) DeepCopy() *ReplicasSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollout) DeepCopyInto(out *Rollout) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]RolloutStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollout.
+func (in *Rollout) DeepCopy() *Rollout {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutRevision) DeepCopyInto(out *RolloutRevision) {
+	*out = *in
+	in.AppImage.DeepCopyInto(&out.AppImage)
+	in.AppSpec.DeepCopyInto(&out.AppSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutRevision.
+func (in *RolloutRevision) DeepCopy() *RolloutRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	in.Stable.DeepCopyInto(&out.Stable)
+	if in.StepStartTime != nil {
+		in, out := &in.StepStartTime, &out.StepStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStep) DeepCopyInto(out *RolloutStep) {
+	*out = *in
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStep.
+func (in *RolloutStep) DeepCopy() *RolloutStep {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]RouteTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(RouteRedirect)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
@@ -2329,6 +3027,41 @@ func (in *Route) DeepCopy() *Route {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteRedirect) DeepCopyInto(out *RouteRedirect) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteRedirect.
+func (in *RouteRedirect) DeepCopy() *RouteRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTarget) DeepCopyInto(out *RouteTarget) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTarget.
+func (in *RouteTarget) DeepCopy() *RouteTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Router) DeepCopyInto(out *Router) {
 	*out = *in
@@ -2349,7 +3082,9 @@ func (in *Router) DeepCopyInto(out *Router) {
 	if in.Routes != nil {
 		in, out := &in.Routes, &out.Routes
 		*out = make(Routes, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2384,7 +3119,9 @@ func (in Routes) DeepCopyInto(out *Routes) {
 	{
 		in := &in
 		*out = make(Routes, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2485,6 +3222,11 @@ func (in *Secret) DeepCopyInto(out *Secret) {
 			(*out)[key] = val
 		}
 	}
+	if in.Rotate != nil {
+		in, out := &in.Rotate, &out.Rotate
+		*out = new(SecretRotate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Secret.
@@ -2546,6 +3288,27 @@ func (in *SecretReference) DeepCopy() *SecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRotate) DeepCopyInto(out *SecretRotate) {
+	*out = *in
+	out.Every = in.Every
+	if in.Grace != nil {
+		in, out := &in.Grace, &out.Grace
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRotate.
+func (in *SecretRotate) DeepCopy() *SecretRotate {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretStatus) DeepCopyInto(out *SecretStatus) {
 	*out = *in
@@ -2647,6 +3410,16 @@ func (in *Service) DeepCopyInto(out *Service) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = make(CPUMap, len(*in))
+		for key, val := range *in {
+			{
+				x := val.DeepCopy()
+				(*out)[key] = &x
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Service.
@@ -2790,7 +3563,9 @@ func (in *ServiceInstanceSpec) DeepCopyInto(out *ServiceInstanceSpec) {
 	if in.Routes != nil {
 		in, out := &in.Routes, &out.Routes
 		*out = make([]Route, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Publish != nil {
 		in, out := &in.Publish, &out.Publish
@@ -2929,6 +3704,11 @@ func (in *SignedBy) DeepCopyInto(out *SignedBy) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Keyless != nil {
+		in, out := &in.Keyless, &out.Keyless
+		*out = new(KeylessSigner)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignedBy.
@@ -2941,6 +3721,21 @@ func (in *SignedBy) DeepCopy() *SignedBy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogLogSink) DeepCopyInto(out *SyslogLogSink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogLogSink.
+func (in *SyslogLogSink) DeepCopy() *SyslogLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPProbe) DeepCopyInto(out *TCPProbe) {
 	*out = *in
@@ -3087,6 +3882,26 @@ func (in *VolumeRequest) DeepCopy() *VolumeRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeRestore) DeepCopyInto(out *VolumeRestore) {
+	*out = *in
+	if in.RestoreSize != nil {
+		in, out := &in.RestoreSize, &out.RestoreSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeRestore.
+func (in *VolumeRestore) DeepCopy() *VolumeRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSecretMount) DeepCopyInto(out *VolumeSecretMount) {
 	*out = *in
@@ -3102,6 +3917,107 @@ func (in *VolumeSecretMount) DeepCopy() *VolumeSecretMount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotInstance) DeepCopyInto(out *VolumeSnapshotInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotInstance.
+func (in *VolumeSnapshotInstance) DeepCopy() *VolumeSnapshotInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotInstanceList) DeepCopyInto(out *VolumeSnapshotInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeSnapshotInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotInstanceList.
+func (in *VolumeSnapshotInstanceList) DeepCopy() *VolumeSnapshotInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotInstanceSpec) DeepCopyInto(out *VolumeSnapshotInstanceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotInstanceSpec.
+func (in *VolumeSnapshotInstanceSpec) DeepCopy() *VolumeSnapshotInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotInstanceStatus) DeepCopyInto(out *VolumeSnapshotInstanceStatus) {
+	*out = *in
+	if in.RestoreSize != nil {
+		in, out := &in.RestoreSize, &out.RestoreSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotInstanceStatus.
+func (in *VolumeSnapshotInstanceStatus) DeepCopy() *VolumeSnapshotInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 	*out = *in
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AppPolicyRuleModeEnforce rejects apps that violate the rule, this is the default
+	AppPolicyRuleModeEnforce = "enforce"
+	// AppPolicyRuleModeAudit records an event for apps that violate the rule without rejecting them
+	AppPolicyRuleModeAudit = "audit"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectAppPolicyRuleInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Description string                    `json:"description,omitempty"`
+	Mode        string                    `json:"mode,omitempty"`
+	Rules       []AppPolicyRuleExpression `json:"rules,omitempty"`
+}
+
+// AppPolicyRuleExpression is a CEL expression that must evaluate to true for an app to be allowed. The expression can
+// use the variables "app" (the spec of the app), "spec" (the app spec resolved from the image, deploy args and
+// profiles), "name" and "project", the namespace of the app.
+type AppPolicyRuleExpression struct {
+	Expression string `json:"expression"`
+	// Message is returned when the expression evaluates to false, the expression is returned if not set
+	Message string `json:"message,omitempty"`
+	// Field is the path of the field the violation is reported for, like "spec.containers"
+	Field string `json:"field,omitempty"`
+}
+
+func (in *ProjectAppPolicyRuleInstance) GetMode() string {
+	if in.Mode == "" {
+		return AppPolicyRuleModeEnforce
+	}
+	return in.Mode
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectAppPolicyRuleInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectAppPolicyRuleInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterAppPolicyRuleInstance ProjectAppPolicyRuleInstance
+
+func (c *ClusterAppPolicyRuleInstance) NamespaceScoped() bool {
+	return false
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterAppPolicyRuleInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAppPolicyRuleInstance `json:"items"`
+}
@@ -26,6 +26,10 @@ func AddToScheme(scheme *runtime.Scheme) error {
 		&ProjectComputeClassInstanceList{},
 		&QuotaRequestInstance{},
 		&QuotaRequestInstanceList{},
+		&ProjectAppPolicyRuleInstance{},
+		&ProjectAppPolicyRuleInstanceList{},
+		&ClusterAppPolicyRuleInstance{},
+		&ClusterAppPolicyRuleInstanceList{},
 	)
 
 	// Add common types
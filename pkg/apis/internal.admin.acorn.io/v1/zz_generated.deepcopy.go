@@ -11,6 +11,83 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppPolicyRuleExpression) DeepCopyInto(out *AppPolicyRuleExpression) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppPolicyRuleExpression.
+func (in *AppPolicyRuleExpression) DeepCopy() *AppPolicyRuleExpression {
+	if in == nil {
+		return nil
+	}
+	out := new(AppPolicyRuleExpression)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAppPolicyRuleInstance) DeepCopyInto(out *ClusterAppPolicyRuleInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AppPolicyRuleExpression, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAppPolicyRuleInstance.
+func (in *ClusterAppPolicyRuleInstance) DeepCopy() *ClusterAppPolicyRuleInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAppPolicyRuleInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAppPolicyRuleInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAppPolicyRuleInstanceList) DeepCopyInto(out *ClusterAppPolicyRuleInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAppPolicyRuleInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAppPolicyRuleInstanceList.
+func (in *ClusterAppPolicyRuleInstanceList) DeepCopy() *ClusterAppPolicyRuleInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAppPolicyRuleInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAppPolicyRuleInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterComputeClassInstance) DeepCopyInto(out *ClusterComputeClassInstance) {
 	*out = *in
@@ -29,6 +106,7 @@ func (in *ClusterComputeClassInstance) DeepCopyInto(out *ClusterComputeClassInst
 		}
 	}
 	in.Memory.DeepCopyInto(&out.Memory)
+	in.CPU.DeepCopyInto(&out.CPU)
 	if in.SupportedRegions != nil {
 		in, out := &in.SupportedRegions, &out.SupportedRegions
 		*out = make([]string, len(*in))
@@ -154,6 +232,26 @@ func (in *ClusterVolumeClassInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeClassCPU) DeepCopyInto(out *ComputeClassCPU) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeClassCPU.
+func (in *ComputeClassCPU) DeepCopy() *ComputeClassCPU {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeClassCPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComputeClassMemory) DeepCopyInto(out *ComputeClassMemory) {
 	*out = *in
@@ -174,6 +272,68 @@ func (in *ComputeClassMemory) DeepCopy() *ComputeClassMemory {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAppPolicyRuleInstance) DeepCopyInto(out *ProjectAppPolicyRuleInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AppPolicyRuleExpression, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAppPolicyRuleInstance.
+func (in *ProjectAppPolicyRuleInstance) DeepCopy() *ProjectAppPolicyRuleInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAppPolicyRuleInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAppPolicyRuleInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAppPolicyRuleInstanceList) DeepCopyInto(out *ProjectAppPolicyRuleInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectAppPolicyRuleInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAppPolicyRuleInstanceList.
+func (in *ProjectAppPolicyRuleInstanceList) DeepCopy() *ProjectAppPolicyRuleInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAppPolicyRuleInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAppPolicyRuleInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectComputeClassInstance) DeepCopyInto(out *ProjectComputeClassInstance) {
 	*out = *in
@@ -192,6 +352,7 @@ func (in *ProjectComputeClassInstance) DeepCopyInto(out *ProjectComputeClassInst
 		}
 	}
 	in.Memory.DeepCopyInto(&out.Memory)
+	in.CPU.DeepCopyInto(&out.CPU)
 	if in.SupportedRegions != nil {
 		in, out := &in.SupportedRegions, &out.SupportedRegions
 		*out = make([]string, len(*in))
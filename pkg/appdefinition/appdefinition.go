@@ -2,7 +2,6 @@ package appdefinition
 
 import (
 	"archive/tar"
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,7 +9,6 @@ import (
 	"path/filepath"
 	"sort"
 
-	"github.com/acorn-io/aml"
 	"github.com/acorn-io/aml/pkg/cue"
 	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
@@ -130,12 +128,12 @@ func (a *AppDefinition) JSON() (string, error) {
 	return string(app), err
 }
 
-func (a *AppDefinition) newDecoder() *aml.Decoder {
-	return aml.NewDecoder(bytes.NewReader(a.data), aml.Options{
-		Args:      a.args,
-		Profiles:  a.profiles,
-		Acornfile: true,
-	})
+func (a *AppDefinition) newDecoder() *decoder {
+	return &decoder{
+		data:     a.data,
+		args:     a.args,
+		profiles: a.profiles,
+	}
 }
 
 func (a *AppDefinition) imagesData() (result v1.ImagesData) {
@@ -155,6 +153,12 @@ func (a *AppDefinition) AppSpec() (*v1.AppSpec, error) {
 		return nil, err
 	}
 
+	for _, secretName := range typed.SortedKeys(spec.Secrets) {
+		if secret := spec.Secrets[secretName]; secret.Rotate != nil && secret.HasFixedToken() {
+			return nil, fmt.Errorf("secret [%s] has a fixed token and can not be rotated", secretName)
+		}
+	}
+
 	if !a.hasImageData {
 		return spec, nil
 	}
@@ -301,6 +305,85 @@ func (a *AppDefinition) WatchFiles(cwd string) (result []string, _ error) {
 	return result, nil
 }
 
+// BuildContexts returns the sorted build contexts of the containers, sidecars, jobs and images that are built from a
+// Dockerfile. The files in the build contexts are part of the built images.
+func (a *AppDefinition) BuildContexts(cwd string) (result []string, _ error) {
+	spec, err := a.BuilderSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	contextSet := map[string]bool{}
+	addContainerContexts(contextSet, spec.Containers, cwd)
+	addContainerContexts(contextSet, spec.Jobs, cwd)
+	for _, image := range spec.Images {
+		if image.ContainerBuild != nil {
+			contextSet[filepath.Join(cwd, image.ContainerBuild.Context)] = true
+		}
+	}
+
+	for k := range contextSet {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func addContainerContexts(contextSet map[string]bool, builds map[string]v1.ContainerImageBuilderSpec, cwd string) {
+	for _, build := range builds {
+		addContainerContexts(contextSet, build.Sidecars, cwd)
+		if build.Build == nil || build.Build.BaseImage != "" {
+			continue
+		}
+		contextSet[filepath.Join(cwd, build.Build.Context)] = true
+	}
+}
+
+// SyncedDirs returns the sorted local directories that dev mode syncs into the containers and sidecars, the
+// directories of the dirs that are mounted from the build context and the directories synced on change.
+func (a *AppDefinition) SyncedDirs(cwd string) (result []string, _ error) {
+	spec, err := a.AppSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	dirSet := map[string]bool{}
+	if err := addSyncedDirs(dirSet, spec.Containers, cwd); err != nil {
+		return nil, err
+	}
+
+	for k := range dirSet {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func addSyncedDirs(dirSet map[string]bool, containers map[string]v1.Container, cwd string) error {
+	for name, container := range containers {
+		if err := addSyncedDirs(dirSet, container.Sidecars, cwd); err != nil {
+			return err
+		}
+		for _, mount := range container.Dirs {
+			if mount.ContextDir != "" {
+				dirSet[filepath.Join(cwd, mount.ContextDir)] = true
+			}
+		}
+		onChange := container.Dev.GetOnChange()
+		if onChange == nil {
+			continue
+		}
+		dirs, err := onChange.SyncDirs()
+		if err != nil {
+			return fmt.Errorf("container %s: %w", name, err)
+		}
+		for _, dir := range dirs {
+			dirSet[filepath.Join(cwd, dir)] = true
+		}
+	}
+	return nil
+}
+
 func (a *AppDefinition) BuilderSpec() (*v1.BuilderSpec, error) {
 	spec := &v1.BuilderSpec{}
 	return spec, a.newDecoder().Decode(spec)
@@ -6,12 +6,15 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"cuelang.org/go/cue/errors"
 	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestParseRouters(t *testing.T) {
@@ -316,6 +319,71 @@ images: {
 	}, files)
 }
 
+func TestBuildContextsAndSyncedDirs(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+containers: {
+  web: {
+    build: "web"
+    dirs: "/src": "./web/src"
+    sidecars: {
+      proxy: {
+        build: "proxy"
+        dirs: "/etc/proxy": "./proxy/conf"
+      }
+    }
+  }
+  none: {
+    image: "done"
+  }
+}
+
+jobs: {
+  migrate: {
+    build: {
+      context: "migrations"
+      dockerfile: "migrations/Dockerfile.migrate"
+    }
+  }
+}
+
+images: {
+  ifull: {
+    containerBuild: {
+      context: "tools"
+    }
+  }
+  iacorn: {
+    acornBuild: "sub/dir2"
+  }
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contexts, err := appImage.BuildContexts("root-path")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{
+		filepath.Join("root-path", "migrations"),
+		filepath.Join("root-path", "proxy"),
+		filepath.Join("root-path", "tools"),
+		filepath.Join("root-path", "web"),
+	}, contexts)
+
+	dirs, err := appImage.SyncedDirs("root-path")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{
+		filepath.Join("root-path", "proxy", "conf"),
+		filepath.Join("root-path", "web", "src"),
+	}, dirs)
+}
+
 func TestEntrypoint(t *testing.T) {
 	appImage, err := NewAppDefinition([]byte(`
 containers: {
@@ -1308,6 +1376,27 @@ volumes: {
 	assert.Equal(t, "./sub", appSpec.Containers["s"].Dirs["/var/named-context-vol"].ContextDir)
 }
 
+func TestVolumeFromSnapshot(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+volumes: data: from: "snapshot://nightly"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appImage.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "snapshot://nightly", appSpec.Volumes["data"].From)
+
+	_, err = NewAppDefinition([]byte(`
+volumes: data: from: "nightly"
+`))
+	assert.Error(t, err)
+}
+
 func TestSecrets(t *testing.T) {
 	appImage, err := NewAppDefinition([]byte(`
 containers: {
@@ -1380,6 +1469,298 @@ secrets: {
 	}, appSpec.Secrets["opt"])
 }
 
+func TestExternalSecret(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+containers: s: image: ""
+secrets: db: {
+  type: "external"
+  params: {
+    provider: "vault"
+    path: "apps/db"
+    ttl: "10m"
+  }
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appImage.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "external", appSpec.Secrets["db"].Type)
+	assert.Equal(t, "vault", appSpec.Secrets["db"].Params["provider"])
+	assert.Equal(t, "apps/db", appSpec.Secrets["db"].Params["path"])
+	assert.Equal(t, "10m", appSpec.Secrets["db"].Params["ttl"])
+}
+
+func TestSecretRotateFixedToken(t *testing.T) {
+	_, err := NewAppDefinition([]byte(`
+containers: s: image: ""
+secrets: token: {
+  type: "token"
+  data: token: "fixed"
+  rotate: every: "720h"
+}
+`))
+	assert.EqualError(t, err, "secret [token] has a fixed token and can not be rotated")
+}
+
+func TestSecretRotate(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+containers: s: image: ""
+jobs: gen: image: ""
+secrets: {
+  token: {
+    type: "token"
+    rotate: every: "720h"
+  }
+  generated: {
+    type: "generated"
+    params: job: "gen"
+    rotate: {
+      every: "24h"
+      grace: "10m"
+    }
+  }
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appImage.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.SecretRotate{
+		Every: metav1.Duration{Duration: 720 * time.Hour},
+	}, appSpec.Secrets["token"].Rotate)
+	assert.Equal(t, &v1.SecretRotate{
+		Every: metav1.Duration{Duration: 24 * time.Hour},
+		Grace: &metav1.Duration{Duration: 10 * time.Minute},
+	}, appSpec.Secrets["generated"].Rotate)
+}
+
+func TestJobOptions(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+jobs: backup: {
+  image: ""
+  schedule: "@daily"
+  concurrency: "forbid"
+  suspend: true
+  deadlineSeconds: 600
+  successfulHistory: 2
+  failedHistory: 0
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appImage.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := appSpec.Jobs["backup"]
+	assert.Equal(t, v1.JobConcurrencyForbid, job.Concurrency)
+	assert.True(t, job.Suspend)
+	assert.Equal(t, int64(600), *job.DeadlineSeconds)
+	assert.Equal(t, int32(2), *job.SuccessfulHistory)
+	assert.Equal(t, int32(0), *job.FailedHistory)
+
+	_, err = NewAppDefinition([]byte(`
+jobs: backup: {
+  image: ""
+  concurrency: "sometimes"
+}
+`))
+	assert.Error(t, err)
+}
+
+func TestJobLifecycleEvents(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+containers: web: image: ""
+jobs: {
+  migrate: {
+    image: ""
+    events: ["pre-update"]
+  }
+  smoke: {
+    image: ""
+    events: ["create", "post-deploy"]
+  }
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appImage.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{"pre-update"}, appSpec.Jobs["migrate"].Events)
+	assert.Equal(t, []string{"create", "post-deploy"}, appSpec.Jobs["smoke"].Events)
+
+	_, err = NewAppDefinition([]byte(`
+jobs: migrate: {
+  image: ""
+  events: ["pre-deploy"]
+}
+`))
+	assert.Error(t, err)
+}
+
+func TestDevOnChange(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+containers: web: {
+  build: "."
+  dev: onChange: {
+    sync: ["./src:/app/src", "static:/usr/share/nginx/html/"]
+    run: "npm run reload"
+  }
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appImage.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	onChange := appSpec.Containers["web"].Dev.GetOnChange()
+	if onChange == nil {
+		t.Fatal("onChange is not set")
+	}
+	assert.Equal(t, "npm run reload", onChange.Run)
+
+	dirs, err := onChange.SyncDirs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{
+		"/app/src":              "./src",
+		"/usr/share/nginx/html": "static",
+	}, dirs)
+
+	for _, sync := range []string{"./src", ":/app/src", "./src:app/src"} {
+		appImage, err := NewAppDefinition([]byte(`
+containers: web: {
+  build: "."
+  dev: onChange: sync: ["` + sync + `"]
+}
+`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		appSpec, err := appImage.AppSpec()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = appSpec.Containers["web"].Dev.GetOnChange().SyncDirs()
+		assert.Error(t, err, sync)
+	}
+
+	_, err = NewAppDefinition([]byte(`
+containers: web: {
+  build: "."
+  dev: onChange: restart: true
+}
+`))
+	assert.Error(t, err)
+}
+
+func TestRouteOptions(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+containers: {
+  api: image: ""
+  "api-canary": image: ""
+}
+routers: {
+  "map-routes": routes: {
+    "/api": {
+      targets: [
+        {
+          targetServiceName: "api"
+          targetPort: 8081
+          weight: 9
+        },
+        "api-canary:8081",
+      ]
+      headers: "X-Version": "v2"
+      query: beta: "true"
+      rewrite: "/"
+      timeoutSeconds: 300
+    }
+    "/docs": redirect: {
+      url: "https://docs.example.com"
+      statusCode: 301
+    }
+    "/old": redirect: "/new"
+    "/short": "api:8081"
+  }
+  "list-routes": routes: [
+    {
+      path: "/"
+      targetServiceName: "api"
+      rewrite: "/v1"
+    },
+  ]
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appImage.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nine := 9
+	routes := map[string]v1.Route{}
+	for _, route := range appSpec.Routers["map-routes"].Routes {
+		routes[route.Path] = route
+	}
+	assert.Equal(t, v1.Route{
+		Path:     "/api",
+		PathType: v1.PathTypePrefix,
+		Targets: []v1.RouteTarget{
+			{TargetServiceName: "api", TargetPort: 8081, Weight: &nine},
+			{TargetServiceName: "api-canary", TargetPort: 8081},
+		},
+		Headers:        map[string]string{"X-Version": "v2"},
+		Query:          map[string]string{"beta": "true"},
+		Rewrite:        "/",
+		TimeoutSeconds: 300,
+	}, routes["/api"])
+	assert.Equal(t, &v1.RouteRedirect{URL: "https://docs.example.com", StatusCode: 301}, routes["/docs"].Redirect)
+	assert.Equal(t, &v1.RouteRedirect{URL: "/new"}, routes["/old"].Redirect)
+	assert.Equal(t, "api", routes["/short"].TargetServiceName)
+	assert.Equal(t, 8081, routes["/short"].TargetPort)
+	assert.Equal(t, "/v1", appSpec.Routers["list-routes"].Routes[0].Rewrite)
+
+	for _, route := range []string{
+		`"/": redirect: {url: "/new", statusCode: 200}`,
+		`"/": {targetServiceName: "api", timeoutSeconds: 0}`,
+		`"/": targets: [{targetServiceName: "api", weight: -1}]`,
+	} {
+		_, err := NewAppDefinition([]byte(`
+containers: api: image: ""
+routers: router: routes: {` + route + `}
+`))
+		assert.Error(t, err, route)
+	}
+}
+
 func TestImageDataOverride(t *testing.T) {
 	acornCue := `
 containers: db: image: "mariadb"
@@ -1520,6 +1901,136 @@ containers: zero: scale: 0
 	assert.Equal(t, int32(0), *appSpec.Containers["zero"].Scale)
 }
 
+func TestAutoscale(t *testing.T) {
+	acornCue := `
+containers: web: {
+	image: "nginx"
+	scale: {
+		min: 2
+		max: 10
+		targetCPU: 75
+	}
+}
+containers: worker: {
+	image: "worker"
+	metrics: {
+		port: 9090
+		path: "/metrics"
+	}
+	scale: {
+		max: 5
+		metric: {
+			name: "queue_depth"
+			targetAverageValue: "30"
+		}
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Nil(t, appSpec.Containers["web"].Scale)
+	assert.Equal(t, &v1.Autoscale{
+		Min:       &[]int32{2}[0],
+		Max:       10,
+		TargetCPU: &[]int32{75}[0],
+	}, appSpec.Containers["web"].Autoscale)
+	assert.Equal(t, &v1.Autoscale{
+		Max: 5,
+		Metric: &v1.AutoscaleMetric{
+			Name:               "queue_depth",
+			TargetAverageValue: "30",
+		},
+	}, appSpec.Containers["worker"].Autoscale)
+}
+
+func TestCPU(t *testing.T) {
+	acornCue := `
+containers: web: {
+	image: "nginx"
+	cpu: 2
+	sidecars: log: {
+		image: "fluentd"
+		cpu: 0.5
+	}
+}
+jobs: migrate: {
+	image: "migrate"
+	cpu: "250m"
+}
+acorns: db: {
+	image: "mariadb"
+	cpu: {
+		mariadb: 1
+		backup: "100m"
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.EqualValues(t, 2000, appSpec.Containers["web"].CPU.MilliValue())
+	assert.EqualValues(t, 500, appSpec.Containers["web"].Sidecars["log"].CPU.MilliValue())
+	assert.EqualValues(t, 250, appSpec.Jobs["migrate"].CPU.MilliValue())
+	assert.EqualValues(t, 1000, appSpec.Acorns["db"].CPU["mariadb"].MilliValue())
+	assert.EqualValues(t, 100, appSpec.Acorns["db"].CPU["backup"].MilliValue())
+}
+
+func TestRollout(t *testing.T) {
+	acornCue := `
+containers: web: {
+	image: "nginx"
+	scale: 4
+}
+rollout: {
+	strategy: "canary"
+	steps: [
+		{weight: 25, pause: "5m"},
+		{weight: 50},
+	]
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.Rollout{
+		Strategy: v1.RolloutStrategyCanary,
+		Steps: []v1.RolloutStep{
+			{Weight: 25, Pause: &metav1.Duration{Duration: 5 * time.Minute}},
+			{Weight: 50},
+		},
+	}, appSpec.Rollout)
+
+	// The traffic is split by the rollout proxy, so the weights do not depend on the replicas
+	def, err = NewAppDefinition([]byte(strings.Replace(acornCue, "scale: 4", "scale: 1", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = def.AppSpec()
+	assert.NoError(t, err)
+}
+
 func TestBuildProfileParameters(t *testing.T) {
 	acornCue := `
 args: {
@@ -1589,6 +2100,32 @@ containers: foo: build: buildArgs: one: args.foo
 	assert.Equal(t, "two", buildSpec.Containers["foo"].Build.BuildArgs["one"])
 }
 
+func TestBuildCache(t *testing.T) {
+	acornCue := `
+containers: web: build: {
+	context: "."
+	cache: {
+		from: ["ghcr.io/acme/app:cache"]
+		to: "type=registry,ref=ghcr.io/acme/app:cache,mode=max"
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buildSpec, err := def.BuilderSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.BuildCache{
+		From: []string{"ghcr.io/acme/app:cache"},
+		To:   "type=registry,ref=ghcr.io/acme/app:cache,mode=max",
+	}, buildSpec.Containers["web"].Build.Cache)
+}
+
 func TestAcornFirstForm(t *testing.T) {
 	acornCue := `
 acorns: first: {
@@ -0,0 +1,156 @@
+package appdefinition
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	cuelang "cuelang.org/go/cue"
+	cue_mod "github.com/acorn-io/aml/cue.mod"
+	"github.com/acorn-io/aml/pkg/cue"
+	"github.com/acorn-io/aml/pkg/definition"
+	"github.com/acorn-io/aml/pkg/loader"
+	"github.com/acorn-io/runtime/third_party/aml/schema"
+)
+
+// acornfileKeys are the top-level keys of the Acornfile that are decoded into the app spec
+var acornfileKeys = []string{"containers", "jobs", "acorns", "secrets", "volumes", "images", "routers", "labels", "annotations", "services", "rollout"}
+
+// decoder evaluates an Acornfile like the decoder of the aml module, but against the Acornfile schema of the runtime
+// in third_party/aml/schema. The aml module only knows its own schema, which doesn't have all keys of the runtime.
+type decoder struct {
+	data     []byte
+	args     map[string]any
+	profiles []string
+}
+
+func (d *decoder) files() ([]cue.File, error) {
+	return loader.ToFiles(bytes.NewReader(d.data))
+}
+
+func (d *decoder) context() (*cue.Context, error) {
+	files, err := d.files()
+	if err != nil {
+		return nil, err
+	}
+	ctx := cue.NewContext().
+		WithNestedFS("schema", schema.Files).
+		WithNestedFS("cue.mod", cue_mod.Files).
+		WithFiles(files...).
+		WithSchema(definition.Schema, definition.AppType)
+	if _, err := ctx.Value(); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// Args returns the args and profiles of the Acornfile. They are read without the schema, like the aml module does.
+func (d *decoder) Args() (*definition.ParamSpec, error) {
+	if _, err := d.context(); err != nil {
+		return nil, err
+	}
+	files, err := d.files()
+	if err != nil {
+		return nil, err
+	}
+	def, err := definition.NewData(files)
+	if err != nil {
+		return nil, err
+	}
+	return def.Args()
+}
+
+func (d *decoder) ComputedArgs() (map[string]any, error) {
+	ctx, err := d.context()
+	if err != nil {
+		return nil, err
+	}
+	_, args, err := withArgs(ctx, d.args, d.profiles)
+	return args, err
+}
+
+func (d *decoder) Decode(out any) error {
+	ctx, err := d.context()
+	if err != nil {
+		return err
+	}
+	ctx, _, err = withArgs(ctx, d.args, d.profiles)
+	if err != nil {
+		return err
+	}
+
+	app, err := ctx.Value()
+	if err != nil {
+		return err
+	}
+
+	objs := map[string]any{}
+	for _, key := range acornfileKeys {
+		v := app.LookupPath(cuelang.ParsePath(key))
+		if v.Exists() {
+			objs[key] = v
+		}
+	}
+
+	newApp, err := ctx.Encode(objs)
+	if err != nil {
+		return err
+	}
+	return ctx.Decode(newApp, out)
+}
+
+// withArgs applies the profiles to the args and adds the result to the Acornfile
+func withArgs(ctx *cue.Context, args map[string]any, profiles []string) (*cue.Context, map[string]any, error) {
+	args, err := argsForProfiles(ctx, args, profiles)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(args) == 0 {
+		return ctx, args, nil
+	}
+	data, err := json.Marshal(map[string]any{
+		"args": args,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return ctx.WithFile("args.cue", data), args, nil
+}
+
+func argsForProfiles(ctx *cue.Context, args map[string]any, profiles []string) (map[string]any, error) {
+	val, err := ctx.Value()
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		optional := false
+		if strings.HasSuffix(profile, "?") {
+			optional = true
+			profile = profile[:len(profile)-1]
+		}
+		pValue := val.LookupPath(cuelang.ParsePath(fmt.Sprintf("profiles[\"%s\"]", profile)))
+		if !pValue.Exists() {
+			if !optional {
+				return nil, fmt.Errorf("failed to find profile %s", profile)
+			}
+			continue
+		}
+
+		if args == nil {
+			args = map[string]any{}
+		}
+
+		inValue, err := ctx.Encode(args)
+		if err != nil {
+			return nil, err
+		}
+
+		newArgs := map[string]any{}
+		if err := pValue.Unify(*inValue).Decode(&newArgs); err != nil {
+			return nil, cue.WrapErr(err)
+		}
+		args = newArgs
+	}
+	return args, nil
+}
@@ -0,0 +1,545 @@
+package appdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/strings/slices"
+)
+
+type Action string
+
+const (
+	ActionAdd    = Action("add")
+	ActionRemove = Action("remove")
+	ActionChange = Action("change")
+)
+
+const (
+	KindApp         = "app"
+	KindContainer   = "container"
+	KindJob         = "job"
+	KindPort        = "port"
+	KindSecret      = "secret"
+	KindVolume      = "volume"
+	KindPermissions = "permissions"
+)
+
+// App is the desired state of an app, the spec of the app and the app spec its image and deploy args evaluate to.
+type App struct {
+	Spec    v1.AppInstanceSpec
+	AppSpec v1.AppSpec
+}
+
+// Change is a change to an object of an app, like a container or a secret.
+type Change struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Action Action `json:"action"`
+	// Fields are the names of the changed fields of the object. The values are never part of a change, so that the
+	// values of secrets and deploy args are not disclosed.
+	Fields []string `json:"fields,omitempty"`
+	// Redeploy are the reasons the change redeploys the containers of the object, like a changed image.
+	Redeploy []string `json:"redeploy,omitempty"`
+	// NeedsApproval is true if the change requests permissions that were not granted to the app yet.
+	NeedsApproval bool `json:"needsApproval,omitempty"`
+}
+
+// podTemplateFields are the fields of a container that are part of the pod template of its deployment or job, a
+// change of one of them redeploys the container. Ports are compared by podTemplatePorts, because publishing a port
+// doesn't change the pod template. TestPodTemplateFields fails for new fields of v1.Container until they are added
+// here or to the fields that don't change the pod template.
+var podTemplateFields = map[string]bool{
+	"labels":      true,
+	"annotations": true,
+	"dirs":        true,
+	"files":       true,
+	"image":       true,
+	"command":     true,
+	"interactive": true,
+	"entrypoint":  true,
+	"environment": true,
+	"workingDir":  true,
+	"probes":      true,
+	"class":       true,
+	"memory":      true,
+	"cpu":         true,
+	"metrics":     true,
+	"init":        true,
+}
+
+// appPodTemplateFields are the fields of the app spec that are applied to the pod templates of all containers and jobs
+var appPodTemplateFields = []string{"labels", "annotations", "environment", "computeClass", "memory", "cpu"}
+
+// Compare returns the changes that updating an app from the old to the new state makes, sorted by kind and name.
+func Compare(from, to App) ([]Change, error) {
+	var result []Change
+
+	changedSecrets, err := secretChanges(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	appChange, err := appChanges(from.Spec, to.Spec)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, appChange...)
+
+	containers, err := containerChanges(KindContainer, from.AppSpec.Containers, to.AppSpec.Containers, changedSecrets)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, containers...)
+
+	jobs, err := containerChanges(KindJob, from.AppSpec.Jobs, to.AppSpec.Jobs, changedSecrets)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, jobs...)
+
+	ports, err := portChanges(from.AppSpec.Containers, to.AppSpec.Containers)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, ports...)
+	result = append(result, changedSecrets...)
+
+	volumes, err := volumeChanges(from, to)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, volumes...)
+	result = append(result, permissionChanges(from, to)...)
+	return result, nil
+}
+
+// NeedsApproval returns true if any of the changes requests permissions that were not granted yet.
+func NeedsApproval(changes []Change) bool {
+	for _, change := range changes {
+		if change.NeedsApproval {
+			return true
+		}
+	}
+	return false
+}
+
+// appChanges compares the fields of the app spec that are not compared for every object of the app
+func appChanges(from, to v1.AppInstanceSpec) ([]Change, error) {
+	allFields, err := changedFields(from, to, "volumes", "secrets", "permissions", "deployArgs")
+	if err != nil {
+		return nil, err
+	}
+
+	var fields, redeploy []string
+	for _, field := range allFields {
+		if slices.Contains(appPodTemplateFields, field) {
+			redeploy = append(redeploy, field+" changed")
+		}
+		if field != "environment" {
+			fields = append(fields, field)
+		}
+	}
+	for _, arg := range changedKeys(from.DeployArgs, to.DeployArgs) {
+		fields = append(fields, "deployArgs."+arg)
+	}
+	for _, env := range changedKeys(nameValues(from.Environment), nameValues(to.Environment)) {
+		fields = append(fields, "environment."+env)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	sort.Strings(fields)
+	return []Change{{
+		Kind:     KindApp,
+		Action:   ActionChange,
+		Fields:   fields,
+		Redeploy: redeploy,
+	}}, nil
+}
+
+func containerChanges(kind string, from, to map[string]v1.Container, changedSecrets []Change) (result []Change, _ error) {
+	for _, name := range sortedNames(from, to) {
+		oldContainer, inOld := from[name]
+		newContainer, inNew := to[name]
+		switch {
+		case !inNew:
+			result = append(result, Change{Kind: kind, Name: name, Action: ActionRemove})
+		case !inOld:
+			result = append(result, Change{Kind: kind, Name: name, Action: ActionAdd})
+		default:
+			newContainer = withUnbuiltImages(oldContainer, newContainer)
+			fields, err := changedFields(oldContainer, newContainer, "ports")
+			if err != nil {
+				return nil, err
+			}
+			redeploy, err := redeployReasons(oldContainer, newContainer, changedSecrets)
+			if err != nil {
+				return nil, err
+			}
+			if len(fields) > 0 || len(redeploy) > 0 {
+				result = append(result, Change{
+					Kind:     kind,
+					Name:     name,
+					Action:   ActionChange,
+					Fields:   fields,
+					Redeploy: redeploy,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// withUnbuiltImages sets the images of the new container and its sidecars to the old images if they are built from
+// source and not built yet, because their images are only known after the build.
+func withUnbuiltImages(from, to v1.Container) v1.Container {
+	if to.Image == "" && to.Build != nil {
+		to.Image = from.Image
+	}
+	if len(to.Sidecars) > 0 {
+		sidecars := make(map[string]v1.Container, len(to.Sidecars))
+		for name, sidecar := range to.Sidecars {
+			if sidecar.Image == "" && sidecar.Build != nil {
+				sidecar.Image = from.Sidecars[name].Image
+			}
+			sidecars[name] = sidecar
+		}
+		to.Sidecars = sidecars
+	}
+	return to
+}
+
+// redeployReasons returns the reasons the pod template of the container changes, like a changed image, command or
+// environment of the container or of one of its sidecars, or a changed secret that redeploys the container.
+func redeployReasons(from, to v1.Container, changedSecrets []Change) (result []string, _ error) {
+	fields, err := podTemplateChanges(from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range fields {
+		result = append(result, field+" changed")
+	}
+
+	for _, name := range sortedNames(from.Sidecars, to.Sidecars) {
+		oldSidecar, inOld := from.Sidecars[name]
+		newSidecar, inNew := to.Sidecars[name]
+		switch {
+		case !inNew:
+			result = append(result, fmt.Sprintf("sidecar %s removed", name))
+		case !inOld:
+			result = append(result, fmt.Sprintf("sidecar %s added", name))
+		default:
+			fields, err := podTemplateChanges(oldSidecar, newSidecar)
+			if err != nil {
+				return nil, err
+			}
+			for _, field := range fields {
+				result = append(result, fmt.Sprintf("%s of sidecar %s changed", field, name))
+			}
+		}
+	}
+
+	redeploySecrets := redeploySecretNames(to)
+	for _, change := range changedSecrets {
+		if change.Action == ActionChange && redeploySecrets[change.Name] {
+			result = append(result, fmt.Sprintf("secret %s changed", change.Name))
+		}
+	}
+	return result, nil
+}
+
+// podTemplateChanges returns the changed fields of a container or sidecar that are part of its pod template
+func podTemplateChanges(from, to v1.Container) (result []string, _ error) {
+	fields, err := changedFields(from, to, "sidecars")
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range fields {
+		if podTemplateFields[field] {
+			result = append(result, field)
+		}
+	}
+	if !equality.Semantic.DeepEqual(podTemplatePorts(from.Ports), podTemplatePorts(to.Ports)) {
+		result = append(result, "ports")
+		sort.Strings(result)
+	}
+	return result, nil
+}
+
+// podTemplatePorts returns the sorted ports the container listens on, which are the ports of its pod template
+func podTemplatePorts(ports v1.Ports) (result []string) {
+	for _, port := range ports {
+		port = port.Complete()
+		result = append(result, fmt.Sprintf("%d/%s", port.TargetPort, port.Protocol))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// redeploySecretNames returns the names of the secrets whose changes redeploy the container, like the controller does
+// when it computes the secret revisions of a deployment
+func redeploySecretNames(container v1.Container) map[string]bool {
+	result := map[string]bool{}
+	for _, env := range container.Environment {
+		if env.Secret.OnChange == v1.ChangeTypeRedeploy && env.Secret.Name != "" {
+			result[env.Secret.Name] = true
+		}
+	}
+	for _, file := range container.Files {
+		if file.Secret.OnChange == v1.ChangeTypeRedeploy && file.Secret.Name != "" {
+			result[file.Secret.Name] = true
+		}
+	}
+	for _, dir := range container.Dirs {
+		if dir.Secret.OnChange == v1.ChangeTypeRedeploy && dir.Secret.Name != "" {
+			result[dir.Secret.Name] = true
+		}
+	}
+	return result
+}
+
+func portChanges(from, to map[string]v1.Container) (result []Change, _ error) {
+	oldPorts, newPorts := ports(from), ports(to)
+	for _, name := range sortedNames(oldPorts, newPorts) {
+		oldPort, inOld := oldPorts[name]
+		newPort, inNew := newPorts[name]
+		switch {
+		case !inNew:
+			result = append(result, Change{Kind: KindPort, Name: name, Action: ActionRemove})
+		case !inOld:
+			result = append(result, Change{Kind: KindPort, Name: name, Action: ActionAdd})
+		default:
+			fields, err := changedFields(oldPort, newPort)
+			if err != nil {
+				return nil, err
+			}
+			if len(fields) > 0 {
+				result = append(result, Change{Kind: KindPort, Name: name, Action: ActionChange, Fields: fields})
+			}
+		}
+	}
+	return result, nil
+}
+
+// ports returns the completed ports of the containers and their sidecars by CONTAINER:PORT/PROTOCOL
+func ports(containers map[string]v1.Container) map[string]v1.PortDef {
+	result := map[string]v1.PortDef{}
+	add := func(name string, ports v1.Ports) {
+		for _, port := range ports {
+			port = port.Complete()
+			result[fmt.Sprintf("%s:%d/%s", name, port.Port, port.Protocol)] = port
+		}
+	}
+	for name, container := range containers {
+		add(name, container.Ports)
+		for sidecarName, sidecar := range container.Sidecars {
+			add(name+"."+sidecarName, sidecar.Ports)
+		}
+	}
+	return result
+}
+
+func secretChanges(from, to App) (result []Change, _ error) {
+	oldBindings, newBindings := secretBindings(from.Spec.Secrets), secretBindings(to.Spec.Secrets)
+	for _, name := range sortedNames(from.AppSpec.Secrets, to.AppSpec.Secrets) {
+		oldSecret, inOld := from.AppSpec.Secrets[name]
+		newSecret, inNew := to.AppSpec.Secrets[name]
+		switch {
+		case !inNew:
+			result = append(result, Change{Kind: KindSecret, Name: name, Action: ActionRemove})
+		case !inOld:
+			result = append(result, Change{Kind: KindSecret, Name: name, Action: ActionAdd})
+		default:
+			fields, err := changedFields(oldSecret, newSecret)
+			if err != nil {
+				return nil, err
+			}
+			if oldBindings[name] != newBindings[name] {
+				fields = append(fields, "binding")
+			}
+			if len(fields) > 0 {
+				result = append(result, Change{Kind: KindSecret, Name: name, Action: ActionChange, Fields: fields})
+			}
+		}
+	}
+	return result, nil
+}
+
+func secretBindings(bindings []v1.SecretBinding) map[string]string {
+	result := map[string]string{}
+	for _, binding := range bindings {
+		result[binding.Target] = binding.Secret
+	}
+	return result
+}
+
+func volumeChanges(from, to App) (result []Change, _ error) {
+	oldBindings, newBindings := volumeBindings(from.Spec.Volumes), volumeBindings(to.Spec.Volumes)
+	for _, name := range sortedNames(from.AppSpec.Volumes, to.AppSpec.Volumes) {
+		oldVolume, inOld := from.AppSpec.Volumes[name]
+		newVolume, inNew := to.AppSpec.Volumes[name]
+		switch {
+		case !inNew:
+			result = append(result, Change{Kind: KindVolume, Name: name, Action: ActionRemove})
+		case !inOld:
+			result = append(result, Change{Kind: KindVolume, Name: name, Action: ActionAdd})
+		default:
+			fields, err := changedFields(oldVolume, newVolume)
+			if err != nil {
+				return nil, err
+			}
+			if !equality.Semantic.DeepEqual(oldBindings[name], newBindings[name]) {
+				fields = append(fields, "binding")
+			}
+			if len(fields) > 0 {
+				result = append(result, Change{Kind: KindVolume, Name: name, Action: ActionChange, Fields: fields})
+			}
+		}
+	}
+	return result, nil
+}
+
+func volumeBindings(bindings []v1.VolumeBinding) map[string]v1.VolumeBinding {
+	result := map[string]v1.VolumeBinding{}
+	for _, binding := range bindings {
+		result[binding.Target] = binding
+	}
+	return result
+}
+
+// permissionChanges compares the permissions the containers and jobs request. Like the API server does when an app is
+// updated, requested permissions that don't match the permissions granted to the app need to be approved.
+func permissionChanges(from, to App) (result []Change) {
+	oldPermissions, newPermissions := permissions(from.AppSpec), permissions(to.AppSpec)
+	for _, name := range sortedNames(oldPermissions, newPermissions) {
+		oldRules, inOld := oldPermissions[name]
+		newRules, inNew := newPermissions[name]
+		switch {
+		case !inNew:
+			result = append(result, Change{Kind: KindPermissions, Name: name, Action: ActionRemove})
+		case !inOld || !equality.Semantic.DeepEqual(oldRules, newRules):
+			granted := v1.FindPermission(name, to.Spec.Permissions)
+			change := Change{
+				Kind:          KindPermissions,
+				Name:          name,
+				Action:        ActionChange,
+				Fields:        []string{"rules"},
+				NeedsApproval: !granted.HasRules() || !equality.Semantic.DeepEqual(newRules, granted.GetRules()),
+			}
+			if !inOld {
+				change.Action = ActionAdd
+				change.Fields = nil
+			}
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+// permissions returns the rules the containers and jobs request, including the rules of their sidecars
+func permissions(appSpec v1.AppSpec) map[string][]v1.PolicyRule {
+	result := map[string][]v1.PolicyRule{}
+	for _, containers := range []map[string]v1.Container{appSpec.Containers, appSpec.Jobs} {
+		for name, container := range containers {
+			rules := container.Permissions.Get().GetRules()
+			for _, sidecar := range typed.Sorted(container.Sidecars) {
+				rules = append(rules, sidecar.Value.Permissions.Get().GetRules()...)
+			}
+			if len(rules) > 0 {
+				result[name] = rules
+			}
+		}
+	}
+	return result
+}
+
+// changedFields returns the sorted JSON names of the top level fields whose values differ
+func changedFields(from, to any, ignore ...string) ([]string, error) {
+	oldFields, err := toMap(from)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := toMap(to)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range ignore {
+		delete(oldFields, field)
+		delete(newFields, field)
+	}
+	return changedKeys(oldFields, newFields), nil
+}
+
+// changedKeys returns the sorted keys that are only in one of the maps or whose values differ
+func changedKeys[T any](from, to map[string]T) (result []string) {
+	for _, key := range sortedNames(from, to) {
+		oldValue, inOld := from[key]
+		newValue, inNew := to[key]
+		if inOld != inNew || !equality.Semantic.DeepEqual(oldValue, newValue) {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+func toMap(obj any) (map[string]any, error) {
+	result := map[string]any{}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return result, json.Unmarshal(data, &result)
+}
+
+func nameValues(values []v1.NameValue) map[string]string {
+	result := map[string]string{}
+	for _, value := range values {
+		result[value.Name] = value.Value
+	}
+	return result
+}
+
+func sortedNames[T any](from, to map[string]T) []string {
+	names := map[string]struct{}{}
+	for name := range from {
+		names[name] = struct{}{}
+	}
+	for name := range to {
+		names[name] = struct{}{}
+	}
+	return typed.SortedKeys(names)
+}
+
+// String formats a change as a line, like "~ container web: image (redeploy: image changed)"
+func (c Change) String() string {
+	var marker string
+	switch c.Action {
+	case ActionAdd:
+		marker = "+"
+	case ActionRemove:
+		marker = "-"
+	default:
+		marker = "~"
+	}
+
+	line := marker + " " + c.Kind
+	if c.Name != "" {
+		line += " " + c.Name
+	}
+	if len(c.Fields) > 0 {
+		line += ": " + strings.Join(c.Fields, ", ")
+	}
+	if len(c.Redeploy) > 0 {
+		line += " (redeploy: " + strings.Join(c.Redeploy, ", ") + ")"
+	}
+	if c.NeedsApproval {
+		line += " (needs approval)"
+	}
+	return line
+}
@@ -0,0 +1,292 @@
+package appdiff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func newApp() App {
+	return App{
+		Spec: v1.AppInstanceSpec{
+			Image:      "image-name",
+			DeployArgs: v1.GenericMap{"replicas": 1},
+		},
+		AppSpec: v1.AppSpec{
+			Containers: map[string]v1.Container{
+				"web": {
+					Image: "sha256:web",
+					Ports: v1.Ports{{Port: 80, Protocol: v1.ProtocolHTTP}},
+					Environment: v1.EnvVars{{
+						Name:   "PASSWORD",
+						Secret: v1.SecretReference{Name: "db", Key: "password", OnChange: v1.ChangeTypeRedeploy},
+					}},
+				},
+				"worker": {
+					Image:    "sha256:worker",
+					Sidecars: map[string]v1.Container{"migrate": {Image: "sha256:migrate"}},
+					Environment: v1.EnvVars{{
+						Name:   "PASSWORD",
+						Secret: v1.SecretReference{Name: "db", Key: "password", OnChange: v1.ChangeTypeNoAction},
+					}},
+				},
+			},
+			Jobs: map[string]v1.Container{
+				"migrate": {Image: "sha256:migrate"},
+			},
+			Secrets: map[string]v1.Secret{
+				"db": {Type: "basic", Data: map[string]string{"password": "a"}},
+			},
+			Volumes: map[string]v1.VolumeRequest{
+				"data": {Size: "1G"},
+			},
+		},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	rules := []v1.PolicyRule{{
+		PolicyRule: rbacv1.PolicyRule{
+			Verbs:     []string{"get"},
+			APIGroups: []string{""},
+			Resources: []string{"secrets"},
+		},
+	}}
+
+	for _, tt := range []struct {
+		name          string
+		update        func(app *App)
+		expected      []Change
+		needsApproval bool
+	}{
+		{
+			name:   "unchanged",
+			update: func(*App) {},
+		},
+		{
+			name: "image",
+			update: func(app *App) {
+				app.Spec.Image = "image-name:v2"
+				app.AppSpec.Containers["web"] = withImage(app.AppSpec.Containers["web"], "sha256:web2")
+			},
+			expected: []Change{
+				{Kind: KindApp, Action: ActionChange, Fields: []string{"image"}},
+				{Kind: KindContainer, Name: "web", Action: ActionChange, Fields: []string{"image"}, Redeploy: []string{"image changed"}},
+			},
+		},
+		{
+			name: "image built from source",
+			update: func(app *App) {
+				web := app.AppSpec.Containers["web"]
+				web.Image = ""
+				web.Build = &v1.Build{Context: "."}
+				app.AppSpec.Containers["web"] = web
+			},
+			expected: []Change{
+				{Kind: KindContainer, Name: "web", Action: ActionChange, Fields: []string{"build"}},
+			},
+		},
+		{
+			name: "deploy args and environment",
+			update: func(app *App) {
+				app.Spec.DeployArgs = v1.GenericMap{"replicas": 2, "debug": true}
+				app.Spec.Environment = []v1.NameValue{{Name: "LOG_LEVEL", Value: "debug"}}
+			},
+			expected: []Change{
+				{Kind: KindApp, Action: ActionChange, Fields: []string{"deployArgs.debug", "deployArgs.replicas", "environment.LOG_LEVEL"}, Redeploy: []string{"environment changed"}},
+			},
+		},
+		{
+			name: "pod template",
+			update: func(app *App) {
+				web := app.AppSpec.Containers["web"]
+				web.Command = v1.CommandSlice{"nginx", "-g", "daemon off;"}
+				web.Memory = &[]int64{512}[0]
+				web.Environment = append(web.Environment, v1.EnvVar{Name: "DEBUG", Value: "true"})
+				web.Sidecars = map[string]v1.Container{"proxy": {Image: "sha256:proxy"}}
+				app.AppSpec.Containers["web"] = web
+
+				worker := app.AppSpec.Containers["worker"]
+				worker.Ports = v1.Ports{{Port: 8080, Protocol: v1.ProtocolHTTP}}
+				app.AppSpec.Containers["worker"] = worker
+			},
+			expected: []Change{
+				{Kind: KindContainer, Name: "web", Action: ActionChange, Fields: []string{"command", "environment", "memory", "sidecars"},
+					Redeploy: []string{"command changed", "environment changed", "memory changed", "sidecar proxy added"}},
+				{Kind: KindContainer, Name: "worker", Action: ActionChange, Redeploy: []string{"ports changed"}},
+				{Kind: KindPort, Name: "worker:8080/http", Action: ActionAdd},
+			},
+		},
+		{
+			name: "init sidecar",
+			update: func(app *App) {
+				app.AppSpec.Containers["worker"] = withSidecar(app.AppSpec.Containers["worker"], "migrate", v1.Container{Image: "sha256:migrate", Init: true})
+			},
+			expected: []Change{
+				{Kind: KindContainer, Name: "worker", Action: ActionChange, Fields: []string{"sidecars"}, Redeploy: []string{"init of sidecar migrate changed"}},
+			},
+		},
+		{
+			name: "compute class of the app",
+			update: func(app *App) {
+				app.Spec.ComputeClasses = v1.ComputeClassMap{"": "large"}
+			},
+			expected: []Change{
+				{Kind: KindApp, Action: ActionChange, Fields: []string{"computeClass"}, Redeploy: []string{"computeClass changed"}},
+			},
+		},
+		{
+			name: "secret with redeploy",
+			update: func(app *App) {
+				app.AppSpec.Secrets["db"] = v1.Secret{Type: "basic", Data: map[string]string{"password": "b"}}
+			},
+			expected: []Change{
+				{Kind: KindContainer, Name: "web", Action: ActionChange, Redeploy: []string{"secret db changed"}},
+				{Kind: KindSecret, Name: "db", Action: ActionChange, Fields: []string{"data"}},
+			},
+		},
+		{
+			name: "secret binding",
+			update: func(app *App) {
+				app.Spec.Secrets = []v1.SecretBinding{{Secret: "prod-db", Target: "db"}}
+			},
+			expected: []Change{
+				{Kind: KindContainer, Name: "web", Action: ActionChange, Redeploy: []string{"secret db changed"}},
+				{Kind: KindSecret, Name: "db", Action: ActionChange, Fields: []string{"binding"}},
+			},
+		},
+		{
+			name: "ports",
+			update: func(app *App) {
+				web := app.AppSpec.Containers["web"]
+				web.Ports = v1.Ports{{Port: 80, Protocol: v1.ProtocolHTTP, Publish: true}, {Port: 9090, Protocol: v1.ProtocolTCP}}
+				app.AppSpec.Containers["web"] = web
+			},
+			expected: []Change{
+				{Kind: KindContainer, Name: "web", Action: ActionChange, Redeploy: []string{"ports changed"}},
+				{Kind: KindPort, Name: "web:80/http", Action: ActionChange, Fields: []string{"publish"}},
+				{Kind: KindPort, Name: "web:9090/tcp", Action: ActionAdd},
+			},
+		},
+		{
+			name: "containers, jobs and volumes",
+			update: func(app *App) {
+				delete(app.AppSpec.Containers, "worker")
+				app.AppSpec.Jobs["backup"] = v1.Container{Image: "sha256:backup"}
+				app.AppSpec.Volumes["data"] = v1.VolumeRequest{Size: "2G"}
+				app.Spec.Volumes = []v1.VolumeBinding{{Volume: "existing", Target: "data"}}
+			},
+			expected: []Change{
+				{Kind: KindContainer, Name: "worker", Action: ActionRemove},
+				{Kind: KindJob, Name: "backup", Action: ActionAdd},
+				{Kind: KindVolume, Name: "data", Action: ActionChange, Fields: []string{"size", "binding"}},
+			},
+		},
+		{
+			name: "new permissions",
+			update: func(app *App) {
+				web := app.AppSpec.Containers["web"]
+				web.Permissions = &v1.Permissions{Rules: rules}
+				app.AppSpec.Containers["web"] = web
+			},
+			expected: []Change{
+				{Kind: KindContainer, Name: "web", Action: ActionChange, Fields: []string{"permissions"}},
+				{Kind: KindPermissions, Name: "web", Action: ActionAdd, NeedsApproval: true},
+			},
+			needsApproval: true,
+		},
+		{
+			name: "granted permissions",
+			update: func(app *App) {
+				web := app.AppSpec.Containers["web"]
+				web.Permissions = &v1.Permissions{Rules: rules}
+				app.AppSpec.Containers["web"] = web
+				app.Spec.Permissions = []v1.Permissions{{ServiceName: "web", Rules: rules}}
+			},
+			expected: []Change{
+				{Kind: KindContainer, Name: "web", Action: ActionChange, Fields: []string{"permissions"}},
+				{Kind: KindPermissions, Name: "web", Action: ActionAdd},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := newApp()
+			tt.update(&updated)
+			changes, err := Compare(newApp(), updated)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, changes)
+			assert.Equal(t, tt.needsApproval, NeedsApproval(changes))
+		})
+	}
+}
+
+// notPodTemplateFields are the fields of v1.Container that don't change the pod template of a container
+var notPodTemplateFields = map[string]bool{
+	// the image that is built is compared instead
+	"build": true,
+	// compared by podTemplatePorts
+	"ports": true,
+	// only decide when the deployment or job is created
+	"dependencies": true,
+	// applied to the role of the service account, the name of the service account doesn't change
+	"permissions": true,
+	// applied to the replicas of the deployment or its autoscaler
+	"scale":     true,
+	"autoscale": true,
+	// applied to the job or cron job
+	"schedule":          true,
+	"concurrency":       true,
+	"suspend":           true,
+	"deadlineSeconds":   true,
+	"successfulHistory": true,
+	"failedHistory":     true,
+	"events":            true,
+	// only applied in dev mode, which redeploys on its own
+	"dev": true,
+	// compared one by one
+	"sidecars": true,
+}
+
+func TestPodTemplateFields(t *testing.T) {
+	containerType := reflect.TypeOf(v1.Container{})
+	for i := 0; i < containerType.NumField(); i++ {
+		name, _, _ := strings.Cut(containerType.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		assert.Truef(t, podTemplateFields[name] != notPodTemplateFields[name],
+			"field %s of v1.Container must be in either podTemplateFields or notPodTemplateFields", name)
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	assert.Equal(t, "~ container web: image (redeploy: image changed)", Change{
+		Kind:     KindContainer,
+		Name:     "web",
+		Action:   ActionChange,
+		Fields:   []string{"image"},
+		Redeploy: []string{"image changed"},
+	}.String())
+	assert.Equal(t, "+ permissions web (needs approval)", Change{
+		Kind:          KindPermissions,
+		Name:          "web",
+		Action:        ActionAdd,
+		NeedsApproval: true,
+	}.String())
+	assert.Equal(t, "- volume data", Change{Kind: KindVolume, Name: "data", Action: ActionRemove}.String())
+}
+
+func withSidecar(container v1.Container, name string, sidecar v1.Container) v1.Container {
+	container.Sidecars = map[string]v1.Container{name: sidecar}
+	return container
+}
+
+func withImage(container v1.Container, image string) v1.Container {
+	container.Image = image
+	return container
+}
@@ -0,0 +1,303 @@
+package apppolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/appdefinition"
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// costLimit keeps a single expression from using too much time of an API request
+	costLimit = 1000000
+	// maxCachedRules bounds the number of rules with compiled expressions in the cache
+	maxCachedRules = 1000
+)
+
+var env, envErr = cel.NewEnv(
+	cel.Variable("app", cel.DynType),
+	cel.Variable("spec", cel.DynType),
+	cel.Variable("name", cel.StringType),
+	// namespace is a reserved word in CEL
+	cel.Variable("project", cel.StringType),
+)
+
+var (
+	cacheLock sync.Mutex
+	// cache holds the compiled expressions of a rule by its UID, they are compiled again when the generation changes
+	cache = map[types.UID]compiledRule{}
+)
+
+type compiledRule struct {
+	generation int64
+	programs   []compiledExpression
+}
+
+type compiledExpression struct {
+	program cel.Program
+	err     error
+}
+
+// Violation is an app policy rule expression that an app does not satisfy
+type Violation struct {
+	RuleKind string
+	RuleName string
+	Mode     string
+	Field    string
+	Message  string
+}
+
+func (v Violation) Error() string {
+	return fmt.Sprintf("app violates %s %s: %s", v.RuleKind, v.RuleName, v.Message)
+}
+
+func (v Violation) Enforced() bool {
+	return v.Mode != adminv1.AppPolicyRuleModeAudit
+}
+
+// FieldError returns the violation as an error for the field of the rule expression
+func (v Violation) FieldError() *field.Error {
+	path := field.NewPath("spec")
+	if v.Field != "" {
+		parts := strings.Split(v.Field, ".")
+		path = field.NewPath(parts[0], parts[1:]...)
+	}
+	return field.Forbidden(path, v.Error())
+}
+
+// Compile checks that the expression is valid and evaluates to a bool
+func Compile(expression string) (cel.Program, error) {
+	if envErr != nil {
+		return nil, envErr
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not %s", ast.OutputType())
+	}
+	return env.Program(ast, cel.CostLimit(costLimit))
+}
+
+// Validate returns the errors of the expressions and mode of a rule
+func Validate(rule *adminv1.ProjectAppPolicyRuleInstance) (result field.ErrorList) {
+	switch rule.GetMode() {
+	case adminv1.AppPolicyRuleModeEnforce, adminv1.AppPolicyRuleModeAudit:
+	default:
+		result = append(result, field.NotSupported(field.NewPath("mode"), rule.Mode,
+			[]string{adminv1.AppPolicyRuleModeEnforce, adminv1.AppPolicyRuleModeAudit}))
+	}
+
+	for i, expr := range rule.Rules {
+		if strings.TrimSpace(expr.Expression) == "" {
+			result = append(result, field.Required(field.NewPath("rules").Index(i).Child("expression"), "expression is required"))
+		} else if _, err := Compile(expr.Expression); err != nil {
+			result = append(result, field.Invalid(field.NewPath("rules").Index(i).Child("expression"), expr.Expression, err.Error()))
+		}
+	}
+	return result
+}
+
+// Check evaluates the project and cluster app policy rules against the app. The app spec is the one resolved from the
+// app image with the deploy args and profiles of the app.
+func Check(ctx context.Context, c kclient.Reader, namespace, name string, app v1.AppInstanceSpec, appSpec *v1.AppSpec) ([]Violation, error) {
+	projectRules := &adminv1.ProjectAppPolicyRuleInstanceList{}
+	if err := c.List(ctx, projectRules, kclient.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ProjectAppPolicyRules: %w", err)
+	}
+
+	clusterRules := &adminv1.ClusterAppPolicyRuleInstanceList{}
+	if err := c.List(ctx, clusterRules); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterAppPolicyRules: %w", err)
+	}
+
+	if len(projectRules.Items) == 0 && len(clusterRules.Items) == 0 {
+		return nil, nil
+	}
+
+	vars, err := variables(namespace, name, app, appSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []Violation
+	for _, rule := range projectRules.Items {
+		violations = append(violations, Evaluate("ProjectAppPolicyRule", &rule, vars)...)
+	}
+	for _, rule := range clusterRules.Items {
+		violations = append(violations, Evaluate("ClusterAppPolicyRule", (*adminv1.ProjectAppPolicyRuleInstance)(&rule), vars)...)
+	}
+	return violations, nil
+}
+
+// CheckAppImage returns the first violation of a rule in enforce mode by the app with the given app image. It checks
+// the images that are resolved after the app is admitted, like the images found by an auto-upgrade.
+func CheckAppImage(ctx context.Context, c kclient.Reader, app *v1.AppInstance, appImage *v1.AppImage) error {
+	appDef, err := appdefinition.FromAppImage(appImage)
+	if err != nil {
+		return err
+	}
+	appDef, _, err = appDef.WithArgs(app.Spec.DeployArgs, app.Spec.GetProfiles(app.Status.GetDevMode()))
+	if err != nil {
+		return err
+	}
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		return err
+	}
+
+	violations, err := Check(ctx, c, app.Namespace, app.Name, app.Spec, appSpec)
+	if err != nil {
+		return err
+	}
+	for _, violation := range violations {
+		if violation.Enforced() {
+			return violation
+		}
+	}
+	return nil
+}
+
+// Evaluate returns the violations of the expressions of the rule. Expressions that fail to evaluate are violations,
+// so that an app is not allowed because of a broken rule.
+func Evaluate(kind string, rule *adminv1.ProjectAppPolicyRuleInstance, vars map[string]any) (result []Violation) {
+	programs := compileRule(rule)
+	for i, expr := range rule.Rules {
+		violation := Violation{
+			RuleKind: kind,
+			RuleName: rule.Name,
+			Mode:     rule.GetMode(),
+			Field:    expr.Field,
+			Message:  expr.Message,
+		}
+		if violation.Message == "" {
+			violation.Message = fmt.Sprintf("failed expression [%s]", expr.Expression)
+		}
+
+		allowed, err := eval(programs[i], vars)
+		if err != nil {
+			violation.Message = fmt.Sprintf("failed to evaluate expression [%s]: %v", expr.Expression, err)
+		} else if allowed {
+			continue
+		}
+		result = append(result, violation)
+	}
+	return result
+}
+
+// compileRule returns the compiled expressions of the rule. They are cached for the generation of the rule, so they
+// are not compiled again on every request.
+func compileRule(rule *adminv1.ProjectAppPolicyRuleInstance) []compiledExpression {
+	if rule.UID != "" {
+		cacheLock.Lock()
+		cached, ok := cache[rule.UID]
+		cacheLock.Unlock()
+		if ok && cached.generation == rule.Generation && len(cached.programs) == len(rule.Rules) {
+			return cached.programs
+		}
+	}
+
+	programs := make([]compiledExpression, 0, len(rule.Rules))
+	for _, expr := range rule.Rules {
+		prg, err := Compile(expr.Expression)
+		programs = append(programs, compiledExpression{program: prg, err: err})
+	}
+
+	if rule.UID != "" {
+		cacheLock.Lock()
+		if len(cache) >= maxCachedRules {
+			// Rules are not removed from the cache when they are deleted, so start over when it is full
+			cache = map[types.UID]compiledRule{}
+		}
+		cache[rule.UID] = compiledRule{
+			generation: rule.Generation,
+			programs:   programs,
+		}
+		cacheLock.Unlock()
+	}
+	return programs
+}
+
+func eval(compiled compiledExpression, vars map[string]any) (bool, error) {
+	if compiled.err != nil {
+		return false, compiled.err
+	}
+
+	out, _, err := compiled.program.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+
+	allowed, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v, not a bool", out.Value())
+	}
+	return allowed, nil
+}
+
+func variables(namespace, name string, app v1.AppInstanceSpec, appSpec *v1.AppSpec) (map[string]any, error) {
+	appVar, err := toValue(app)
+	if err != nil {
+		return nil, err
+	}
+	specVar, err := toValue(appSpec)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"app":     appVar,
+		"spec":    specVar,
+		"name":    name,
+		"project": namespace,
+	}, nil
+}
+
+// toValue converts the object to the JSON representation that the expressions are written against. Whole numbers are
+// kept as integers so that they can be compared with integer literals.
+func toValue(obj any) (any, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var result any
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	return convertNumbers(result), nil
+}
+
+func convertNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, val := range v {
+			v[key] = convertNumbers(val)
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = convertNumbers(val)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return value
+}
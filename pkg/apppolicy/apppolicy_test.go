@@ -0,0 +1,182 @@
+package apppolicy
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	noSSH = `!has(spec.containers) || spec.containers.all(name,
+  !has(spec.containers[name].ports) || spec.containers[name].ports.all(p,
+    !(has(p.publish) && p.publish && p.targetPort == 22)))`
+	jobsMemory = `!has(spec.jobs) || spec.jobs.all(name, has(spec.jobs[name].memory))`
+	noDirs     = `!has(spec.containers) || spec.containers.all(name, !has(spec.containers[name].dirs) ||
+  spec.containers[name].dirs.all(path, !has(spec.containers[name].dirs[path].contextDir)))`
+)
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func testAppSpec() *v1.AppSpec {
+	return &v1.AppSpec{
+		Containers: map[string]v1.Container{
+			"web": {
+				Ports: []v1.PortDef{{Port: 80, TargetPort: 80, Publish: true}},
+			},
+		},
+		Jobs: map[string]v1.Container{
+			"migrate": {Memory: int64Ptr(1024)},
+		},
+	}
+}
+
+func evaluate(t *testing.T, mode string, appSpec *v1.AppSpec, exprs ...string) []Violation {
+	t.Helper()
+
+	rule := &adminv1.ProjectAppPolicyRuleInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "rule"},
+		Mode:       mode,
+	}
+	for _, expr := range exprs {
+		rule.Rules = append(rule.Rules, adminv1.AppPolicyRuleExpression{Expression: expr, Message: "denied"})
+	}
+	require.Empty(t, Validate(rule))
+
+	vars, err := variables("namespace", "name", v1.AppInstanceSpec{Image: "image"}, appSpec)
+	require.NoError(t, err)
+	return Evaluate("ProjectAppPolicyRule", rule, vars)
+}
+
+func TestEvaluateAllowed(t *testing.T) {
+	assert.Empty(t, evaluate(t, "", testAppSpec(), noSSH, jobsMemory, noDirs, `app.image == "image"`, `project == "namespace"`))
+	assert.Empty(t, evaluate(t, "", &v1.AppSpec{}, noSSH, jobsMemory, noDirs))
+}
+
+func TestEvaluatePublishedPort(t *testing.T) {
+	appSpec := testAppSpec()
+	appSpec.Containers["ssh"] = v1.Container{
+		Ports: []v1.PortDef{{Port: 2222, TargetPort: 22, Publish: true}},
+	}
+
+	violations := evaluate(t, "", appSpec, noSSH, jobsMemory)
+	require.Len(t, violations, 1)
+	assert.True(t, violations[0].Enforced())
+	assert.Equal(t, "app violates ProjectAppPolicyRule rule: denied", violations[0].Error())
+	assert.Equal(t, "spec", violations[0].FieldError().Field)
+
+	// Internal ports are allowed
+	appSpec.Containers["ssh"] = v1.Container{
+		Ports: []v1.PortDef{{Port: 22, TargetPort: 22}},
+	}
+	assert.Empty(t, evaluate(t, "", appSpec, noSSH))
+}
+
+func TestEvaluateJobMemoryAndDirs(t *testing.T) {
+	appSpec := testAppSpec()
+	appSpec.Jobs["cleanup"] = v1.Container{}
+	appSpec.Containers["web"] = v1.Container{
+		Dirs: map[string]v1.VolumeMount{"/src": {ContextDir: "./src"}},
+	}
+
+	assert.Len(t, evaluate(t, "", appSpec, jobsMemory, noDirs), 2)
+}
+
+func TestEvaluateAuditMode(t *testing.T) {
+	appSpec := testAppSpec()
+	appSpec.Jobs["cleanup"] = v1.Container{}
+
+	violations := evaluate(t, adminv1.AppPolicyRuleModeAudit, appSpec, jobsMemory)
+	require.Len(t, violations, 1)
+	assert.False(t, violations[0].Enforced())
+}
+
+func TestEvaluateError(t *testing.T) {
+	// Accessing a field that is not set fails the evaluation, which is a violation
+	violations := evaluate(t, "", &v1.AppSpec{}, `spec.jobs.size() == 0`)
+	require.Len(t, violations, 1)
+	assert.Contains(t, violations[0].Message, "failed to evaluate expression")
+}
+
+func TestEvaluateCachesPrograms(t *testing.T) {
+	rule := &adminv1.ProjectAppPolicyRuleInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "rule", UID: "cached", Generation: 1},
+		Rules: []adminv1.AppPolicyRuleExpression{
+			{Expression: "true"},
+		},
+	}
+	vars, err := variables("namespace", "name", v1.AppInstanceSpec{}, &v1.AppSpec{})
+	require.NoError(t, err)
+
+	assert.Empty(t, Evaluate("ProjectAppPolicyRule", rule, vars))
+
+	// The expression is not compiled again for the same generation
+	rule.Rules[0].Expression = "false"
+	assert.Empty(t, Evaluate("ProjectAppPolicyRule", rule, vars))
+
+	rule.Generation = 2
+	assert.Len(t, Evaluate("ProjectAppPolicyRule", rule, vars), 1)
+}
+
+func TestValidate(t *testing.T) {
+	errs := Validate(&adminv1.ProjectAppPolicyRuleInstance{
+		Mode: "warn",
+		Rules: []adminv1.AppPolicyRuleExpression{
+			{Expression: "spec.containers.size() >"},
+			{Expression: " "},
+			{Expression: `"not a bool"`},
+			{Expression: "true"},
+		},
+	})
+	require.Len(t, errs, 4)
+	assert.Equal(t, "mode", errs[0].Field)
+	assert.Equal(t, "rules[0].expression", errs[1].Field)
+	assert.Equal(t, "rules[1].expression", errs[2].Field)
+	assert.Equal(t, "rules[2].expression", errs[3].Field)
+}
+
+func TestCheck(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&adminv1.ProjectAppPolicyRuleInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "jobs", Namespace: "namespace"},
+			Rules: []adminv1.AppPolicyRuleExpression{
+				{Expression: jobsMemory, Message: "jobs must set memory", Field: "spec.jobs"},
+			},
+		},
+		&adminv1.ProjectAppPolicyRuleInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other-namespace"},
+			Rules: []adminv1.AppPolicyRuleExpression{
+				{Expression: "false"},
+			},
+		},
+		&adminv1.ClusterAppPolicyRuleInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "names"},
+			Mode:       adminv1.AppPolicyRuleModeAudit,
+			Rules: []adminv1.AppPolicyRuleExpression{
+				{Expression: `name.startsWith("prod-")`, Message: "app names must start with prod-"},
+			},
+		},
+	).Build()
+
+	appSpec := testAppSpec()
+	appSpec.Jobs["cleanup"] = v1.Container{}
+
+	violations, err := Check(context.Background(), c, "namespace", "name", v1.AppInstanceSpec{}, appSpec)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+
+	assert.Equal(t, "ProjectAppPolicyRule", violations[0].RuleKind)
+	assert.True(t, violations[0].Enforced())
+	assert.Equal(t, "spec.jobs", violations[0].FieldError().Field)
+
+	assert.Equal(t, "ClusterAppPolicyRule", violations[1].RuleKind)
+	assert.False(t, violations[1].Enforced())
+}
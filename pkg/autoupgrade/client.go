@@ -6,6 +6,7 @@ import (
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/imageallowrules"
 	"github.com/acorn-io/runtime/pkg/images"
 	tags2 "github.com/acorn-io/runtime/pkg/tags"
@@ -23,6 +24,7 @@ type daemonClient interface {
 	imageDigest(context.Context, string, string, ...remote.Option) (string, error)
 	resolveLocalTag(context.Context, string, string) (string, bool, error)
 	checkImageAllowed(context.Context, string, string) error
+	recordEvent(context.Context, *apiv1.Event) error
 }
 
 type client struct {
@@ -62,3 +64,7 @@ func (c *client) resolveLocalTag(ctx context.Context, namespace, name string) (s
 func (c *client) checkImageAllowed(ctx context.Context, namespace, name string) error {
 	return imageallowrules.CheckImageAllowed(ctx, c.client, namespace, name, "")
 }
+
+func (c *client) recordEvent(ctx context.Context, e *apiv1.Event) error {
+	return event.NewRecorder(c.client).Record(ctx, e)
+}
@@ -232,6 +232,12 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 				}
 			}
 
+			if app.Status.Rollout.IsRolledBack(nextAppImage, digest) {
+				// The rollout of this image was rolled back, wait for a newer one
+				d.appKeysPrevCheck[appKey] = updateTime
+				continue
+			}
+
 			if updated || strings.TrimPrefix(app.Status.AppImage.Digest, "sha256:") != strings.TrimPrefix(digest, "sha256:") {
 				if !updated && digest != "" {
 					if err := d.client.checkImageAllowed(ctx, app.Namespace, nextAppImage); err != nil {
@@ -254,6 +260,7 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 					}
 					app.Status.AvailableAppImage = nextAppImage
 					app.Status.AvailableAppImageRemote = remote
+					app.Status.ChangeCause = v1.AppRevisionTriggerAutoUpgrade
 					app.Status.ConfirmUpgradeAppImage = ""
 					app.Status.ConfirmUpgradeAppImageRemote = false
 				case "notify":
@@ -280,6 +287,9 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 					logrus.Errorf("Problem updating %v: %v", appKey, err)
 					continue
 				}
+				if mode == "notify" {
+					d.recordUpgradeAvailable(ctx, &app, nextAppImage)
+				}
 			}
 
 			// This app was checked on this run, so update the prevCheckTime time for this app
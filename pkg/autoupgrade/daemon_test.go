@@ -28,6 +28,7 @@ type mockDaemonClient struct {
 	remoteImageDigest, resolvedLocalTag string
 	localTagFound                       bool
 	imageDenyList                       map[string]struct{}
+	events                              []*apiv1.Event
 }
 
 func (m *mockDaemonClient) getConfig(_ context.Context) (*apiv1.Config, error) {
@@ -76,6 +77,11 @@ func (m *mockDaemonClient) checkImageAllowed(_ context.Context, _ string, img st
 	return nil
 }
 
+func (m *mockDaemonClient) recordEvent(_ context.Context, e *apiv1.Event) error {
+	m.events = append(m.events, e)
+	return nil
+}
+
 func TestDetermineAppsToRefresh(t *testing.T) {
 	defaultNextCheckInterval := time.Minute
 	now := time.Now()
@@ -105,6 +111,12 @@ func TestDetermineAppsToRefresh(t *testing.T) {
 			app.Spec.AutoUpgrade = ptrTrue
 		case "notify-app":
 			app.Spec.NotifyUpgrade = ptrTrue
+		case "rolled-back-app":
+			app.Spec.AutoUpgrade = ptrTrue
+			app.Status.Rollout = &v1.RolloutStatus{
+				RolledBackImage:  entry.Value,
+				RolledBackDigest: "sha256:rolledback",
+			}
 		}
 		apps[router.Key(app.Namespace, app.Name)] = app
 	}
@@ -199,6 +211,7 @@ func TestRefreshImages(t *testing.T) {
 		"test-single-app":      "test-single:*",
 		"test-double-app":      "test-double:**",
 		"test-hash-double-app": "test-hash-double:v#.#.#**",
+		"rolled-back-app":      "docker.io/acorn/rolled-back:latest",
 	}
 	apps := make(map[kclient.ObjectKey]v1.AppInstance, len(appImages))
 	for _, entry := range typed.Sorted(appImages) {
@@ -212,6 +225,12 @@ func TestRefreshImages(t *testing.T) {
 			app.Spec.AutoUpgrade = ptrTrue
 		case "notify-app":
 			app.Spec.NotifyUpgrade = ptrTrue
+		case "rolled-back-app":
+			app.Spec.AutoUpgrade = ptrTrue
+			app.Status.Rollout = &v1.RolloutStatus{
+				RolledBackImage:  entry.Value,
+				RolledBackDigest: "sha256:rolledback",
+			}
 		}
 		apps[router.Key(app.Namespace, app.Name)] = app
 	}
@@ -297,6 +316,13 @@ func TestRefreshImages(t *testing.T) {
 			imagesToRefresh:        map[imageAndNamespaceKey][]kclient.ObjectKey{{image: "docker.io/acorn/acorn-1", namespace: "acorn"}: {router.Key("acorn", "acorn-1")}},
 			appKeysPrevCheckAfter:  map[kclient.ObjectKey]time.Time{router.Key("acorn", "acorn-1"): now},
 		},
+		{
+			name:                   "Auto refresh tag with remote digest change to a rolled back image, no update",
+			client:                 &mockDaemonClient{remoteImageDigest: "sha256:rolledback"},
+			appKeysPrevCheckBefore: map[kclient.ObjectKey]time.Time{router.Key("acorn", "rolled-back-app"): thirtySecondsAgo},
+			imagesToRefresh:        map[imageAndNamespaceKey][]kclient.ObjectKey{{image: "docker.io/acorn/rolled-back:latest", namespace: "acorn"}: {router.Key("acorn", "rolled-back-app")}},
+			appKeysPrevCheckAfter:  map[kclient.ObjectKey]time.Time{router.Key("acorn", "rolled-back-app"): now},
+		},
 		{
 			name:                   "Auto refresh tag with local digest change",
 			client:                 &mockDaemonClient{resolvedLocalTag: "sha256:acorn4321docker.io/acorn/acorn-1dcba", localTagFound: true},
@@ -443,6 +469,19 @@ func TestRefreshImages(t *testing.T) {
 			for appName, image := range tt.appsUpdated {
 				assert.Equalf(t, image, tt.client.appUpdates[appName], "%s app doesn't have expected new version", appName)
 			}
+
+			// Only apps that notify about upgrades record an event
+			var notified []string
+			for _, e := range tt.client.events {
+				assert.Equal(t, UpgradeAvailableEventType, e.Type)
+				assert.Equal(t, tt.appsUpdated[e.Source.Name], e.Details["availableImage"])
+				notified = append(notified, e.Source.Name)
+			}
+			if _, ok := tt.appsUpdated["notify-app"]; ok {
+				assert.Equal(t, []string{"notify-app"}, notified)
+			} else {
+				assert.Empty(t, notified)
+			}
 		})
 	}
 }
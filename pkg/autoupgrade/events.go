@@ -0,0 +1,50 @@
+package autoupgrade
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	UpgradeAvailableEventType = "UpgradeAvailable"
+)
+
+// UpgradeAvailableEventDetails captures additional info about an upgrade that has to be confirmed.
+type UpgradeAvailableEventDetails struct {
+	// CurrentImage is the image the app is running.
+	CurrentImage string `json:"currentImage"`
+
+	// AvailableImage is the image the app can be upgraded to.
+	AvailableImage string `json:"availableImage"`
+}
+
+// recordUpgradeAvailable records an event for an app that is configured to notify about upgrades instead of upgrading
+func (d *daemon) recordUpgradeAvailable(ctx context.Context, app *v1.AppInstance, availableImage string) {
+	e := apiv1.Event{
+		Type:        UpgradeAvailableEventType,
+		Actor:       "acorn-system",
+		Severity:    v1.EventSeverityInfo,
+		Description: fmt.Sprintf("Upgrade to %s is available", availableImage),
+		Source:      event.ObjectSource(app),
+		Observed:    v1.MicroTime(metav1.NowMicro()),
+	}
+	e.SetNamespace(app.GetNamespace())
+
+	var err error
+	if e.Details, err = v1.Mapify(UpgradeAvailableEventDetails{
+		CurrentImage:   app.Status.AppImage.Name,
+		AvailableImage: availableImage,
+	}); err != nil {
+		logrus.Warnf("Failed to mapify event details: %s", err.Error())
+	}
+
+	if err := d.client.recordEvent(ctx, &e); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
@@ -0,0 +1,171 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+const defaultRegion = "us-east-1"
+
+// Write copies the backup read from r to the destination and returns the final location of the backup. The destination
+// can be a local directory, a local file, s3://bucket/key or an http(s) URL of an S3-compatible service in the form
+// https://host/bucket/key. If the destination is a directory (or a bucket/key ending with "/") then name is used
+// as the file name. The backup is buffered to a temporary file and done is called after r is read to the end, the
+// backup is only written to the destination if done returns nil, so a failed backup never replaces a previous one.
+func Write(ctx context.Context, r io.Reader, to, name string, done func() error) (string, error) {
+	if strings.HasPrefix(to, "s3://") || strings.HasPrefix(to, "http://") || strings.HasPrefix(to, "https://") {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx)
+		if err != nil {
+			return "", err
+		}
+		target, err := objectURL(to, name, cfg.Region, endpointFromEnv())
+		if err != nil {
+			return "", err
+		}
+		return target, upload(ctx, cfg, r, target, done)
+	}
+
+	target := to
+	if strings.HasSuffix(to, string(filepath.Separator)) {
+		if err := os.MkdirAll(to, 0755); err != nil {
+			return "", err
+		}
+		target = filepath.Join(to, name)
+	} else if s, err := os.Stat(to); err == nil && s.IsDir() {
+		target = filepath.Join(to, name)
+	}
+
+	// The temporary file is created next to the target so that it can be renamed
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	if err := done(); err != nil {
+		return "", err
+	}
+	return target, os.Rename(tmp.Name(), target)
+}
+
+func endpointFromEnv() string {
+	if endpoint := os.Getenv("AWS_ENDPOINT_URL_S3"); endpoint != "" {
+		return endpoint
+	}
+	return os.Getenv("AWS_ENDPOINT_URL")
+}
+
+// objectURL returns the path-style URL of the object for the destination
+func objectURL(to, name, region, endpoint string) (string, error) {
+	u, err := url.Parse(to)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Scheme == "s3" {
+		if u.Host == "" {
+			return "", fmt.Errorf("invalid destination [%s], bucket is missing", to)
+		}
+		if endpoint == "" {
+			if region == "" {
+				region = defaultRegion
+			}
+			endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+		}
+		base, err := url.Parse(endpoint)
+		if err != nil {
+			return "", err
+		}
+		base.Path = path.Join("/", base.Path, u.Host, u.Path)
+		if strings.HasSuffix(u.Path, "/") || u.Path == "" {
+			base.Path += "/"
+		}
+		u = base
+	}
+
+	if strings.Count(strings.Trim(u.Path, "/"), "/") == 0 && !strings.HasSuffix(u.Path, "/") {
+		// Only a bucket was given
+		u.Path += "/"
+	}
+	if strings.HasSuffix(u.Path, "/") {
+		u.Path += name
+	}
+	return u.String(), nil
+}
+
+func upload(ctx context.Context, cfg aws.Config, r io.Reader, target string, done func() error) error {
+	// The payload must be hashed for the signature, so buffer to a temporary file first
+	tmp, err := os.CreateTemp("", "acorn-backup-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), r)
+	if err != nil {
+		return err
+	}
+	if err := done(); err != nil {
+		return err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = defaultRegion
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, target, tmp)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+
+	payloadHash := hex.EncodeToString(hash.Sum(nil))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if err := v4.NewSigner().SignHTTP(ctx, creds, req, payloadHash, "s3", region, time.Now()); err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("uploading to %s failed: %s: %s", target, resp.Status, body)
+	}
+	return nil
+}
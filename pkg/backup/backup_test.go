@@ -0,0 +1,89 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		to       string
+		region   string
+		endpoint string
+		want     string
+	}{
+		{
+			name: "bucket only",
+			to:   "s3://backups",
+			want: "https://s3.us-east-1.amazonaws.com/backups/data.tar",
+		},
+		{
+			name:   "bucket with prefix",
+			to:     "s3://backups/nightly/",
+			region: "eu-west-1",
+			want:   "https://s3.eu-west-1.amazonaws.com/backups/nightly/data.tar",
+		},
+		{
+			name: "bucket with key",
+			to:   "s3://backups/nightly/app.tar",
+			want: "https://s3.us-east-1.amazonaws.com/backups/nightly/app.tar",
+		},
+		{
+			name:     "custom endpoint",
+			to:       "s3://backups",
+			endpoint: "http://minio:9000",
+			want:     "http://minio:9000/backups/data.tar",
+		},
+		{
+			name: "s3-compatible url with bucket",
+			to:   "https://minio.example.com/backups",
+			want: "https://minio.example.com/backups/data.tar",
+		},
+		{
+			name: "s3-compatible url with key",
+			to:   "https://minio.example.com/backups/app.tar",
+			want: "https://minio.example.com/backups/app.tar",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := objectURL(tt.to, "data.tar", tt.region, tt.endpoint)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+
+	_, err := objectURL("s3:///key", "data.tar", "", "")
+	assert.Error(t, err)
+}
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "data.tar")
+
+	location, err := Write(context.Background(), strings.NewReader("complete"), dir, "data.tar", func() error { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, target, location)
+
+	_, err = Write(context.Background(), strings.NewReader("partial"), target, "data.tar", func() error {
+		return errors.New("tar failed")
+	})
+	assert.EqualError(t, err, "tar failed")
+
+	// The failed backup neither replaces the previous one nor leaves a temporary file behind
+	data, err := os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, "complete", string(data))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
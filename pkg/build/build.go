@@ -1,6 +1,7 @@
 package build
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/buildclient"
 	images2 "github.com/acorn-io/runtime/pkg/images"
 	"github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/sbom"
 	"github.com/google/go-containerregistry/pkg/authn"
 	imagename "github.com/google/go-containerregistry/pkg/name"
 	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
@@ -51,6 +53,15 @@ type buildContext struct {
 }
 
 func Build(ctx context.Context, messages buildclient.Messages, pushRepo, buildNamespace string, opts v1.AcornImageBuildInstanceSpec, keychain authn.Keychain, remoteOpts ...remote.Option) (*v1.AppImage, error) {
+	if opts.SBOM != "" {
+		if _, err := sbom.MediaType(opts.SBOM); err != nil {
+			return nil, err
+		}
+	}
+	if err := buildkit.ValidateCache(opts.Cache); err != nil {
+		return nil, err
+	}
+
 	remoteKc := NewRemoteKeyChain(messages, keychain)
 	buildContext := &buildContext{
 		ctx:            ctx,
@@ -114,10 +125,20 @@ func build(ctx *buildContext) (*v1.AppImage, error) {
 	appImage.ID = id
 	appImage.Digest = "sha256:" + id
 
+	if ctx.opts.SBOM != "" {
+		repo, err := imagename.NewRepository(ctx.pushRepo)
+		if err != nil {
+			return nil, err
+		}
+		if err := generateSBOMs(ctx, repo.Digest(appImage.Digest), appImage); err != nil {
+			return nil, fmt.Errorf("failed to generate SBOM: %w", err)
+		}
+	}
+
 	return appImage, nil
 }
 
-func buildContainers(ctx *buildContext, buildCache *buildCache, containers map[string]v1.ContainerImageBuilderSpec) (map[string]v1.ContainerData, []v1.BuildRecord, error) {
+func buildContainers(ctx *buildContext, buildCache *buildCache, containers map[string]v1.ContainerImageBuilderSpec, previous map[string]v1.ContainerData) (map[string]v1.ContainerData, []v1.BuildRecord, error) {
 	var builds []v1.BuildRecord
 	result := map[string]v1.ContainerData{}
 
@@ -135,9 +156,18 @@ func buildContainers(ctx *buildContext, buildCache *buildCache, containers map[s
 			}
 		}
 
-		id, err := fromBuild(ctx, buildCache, *container.Build)
-		if err != nil {
-			return nil, nil, err
+		record := v1.BuildRecord{
+			ContainerBuild: container.Normalize(),
+			ImageKey:       key,
+		}
+
+		id, ok := reusedImage(ctx, record, previous[key].Image)
+		if !ok {
+			var err error
+			id, err = fromBuild(ctx, buildCache, key, *container.Build)
+			if err != nil {
+				return nil, nil, err
+			}
 		}
 
 		result[key] = v1.ContainerData{
@@ -145,10 +175,7 @@ func buildContainers(ctx *buildContext, buildCache *buildCache, containers map[s
 			Sidecars: map[string]v1.ImageData{},
 		}
 
-		builds = append(builds, v1.BuildRecord{
-			ContainerBuild: container.Normalize(),
-			ImageKey:       key,
-		})
+		builds = append(builds, record)
 
 		var sidecarKeys []string
 		for k := range container.Sidecars {
@@ -169,17 +196,23 @@ func buildContainers(ctx *buildContext, buildCache *buildCache, containers map[s
 				}
 			}
 
-			id, err := fromBuild(ctx, buildCache, *sidecar.Build)
-			if err != nil {
-				return nil, nil, err
+			record := v1.BuildRecord{
+				ContainerBuild: sidecar.Normalize(),
+				ImageKey:       key + "." + sidecarKey,
+			}
+
+			id, ok := reusedImage(ctx, record, previous[key].Sidecars[sidecarKey].Image)
+			if !ok {
+				var err error
+				id, err = fromBuild(ctx, buildCache, record.ImageKey, *sidecar.Build)
+				if err != nil {
+					return nil, nil, err
+				}
 			}
 			result[key].Sidecars[sidecarKey] = v1.ImageData{
 				Image: id,
 			}
-			builds = append(builds, v1.BuildRecord{
-				ContainerBuild: sidecar.Normalize(),
-				ImageKey:       key + "." + sidecarKey,
-			})
+			builds = append(builds, record)
 		}
 	}
 
@@ -223,6 +256,7 @@ func buildAcorns(ctx *buildContext, acorns map[string]v1.AcornBuilderSpec) (map[
 			newCtx.opts.Profiles = nil
 			newCtx.opts.Args = acornImage.Build.BuildArgs
 			newCtx.opts.Acornfile = ""
+			newCtx.opts.Reuse = nil
 			newCtx.acornfilePath = filepath.Join(ctx.cwd, acornImage.Build.Acornfile)
 			newCtx.cwd = filepath.Join(ctx.cwd, acornImage.Build.Context)
 			appImage, err := build(&newCtx)
@@ -247,7 +281,7 @@ func buildAcorns(ctx *buildContext, acorns map[string]v1.AcornBuilderSpec) (map[
 	return result, builds, nil
 }
 
-func buildImages(ctx *buildContext, buildCache *buildCache, images map[string]v1.ImageBuilderSpec) (map[string]v1.ImageData, []v1.BuildRecord, error) {
+func buildImages(ctx *buildContext, buildCache *buildCache, images map[string]v1.ImageBuilderSpec, previous map[string]v1.ImageData) (map[string]v1.ImageData, []v1.BuildRecord, error) {
 	var builds []v1.BuildRecord
 	result := map[string]v1.ImageData{}
 	acornBuilds := map[string]v1.AcornBuilderSpec{}
@@ -266,18 +300,24 @@ func buildImages(ctx *buildContext, buildCache *buildCache, images map[string]v1
 				}
 			}
 
-			id, err := fromBuild(ctx, buildCache, *image.ContainerBuild)
-			if err != nil {
-				return nil, nil, err
+			record := v1.BuildRecord{
+				ImageBuild: image.Normalize(),
+				ImageKey:   key,
+			}
+
+			id, ok := reusedImage(ctx, record, previous[key].Image)
+			if !ok {
+				var err error
+				id, err = fromBuild(ctx, buildCache, key, *image.ContainerBuild)
+				if err != nil {
+					return nil, nil, err
+				}
 			}
 
 			result[key] = v1.ImageData{
 				Image: id,
 			}
-			builds = append(builds, v1.BuildRecord{
-				ImageBuild: image.Normalize(),
-				ImageKey:   key,
-			})
+			builds = append(builds, record)
 		}
 	}
 
@@ -296,23 +336,28 @@ func fromSpec(ctx *buildContext, spec v1.BuilderSpec) (v1.ImagesData, error) {
 			Images: map[string]v1.ImageData{},
 		}
 		builds []v1.BuildRecord
+		reuse  v1.ImagesData
 	)
 
+	if ctx.opts.Reuse != nil {
+		reuse = *ctx.opts.Reuse
+	}
+
 	buildCache := &buildCache{}
 
-	data.Containers, builds, err = buildContainers(ctx, buildCache, spec.Containers)
+	data.Containers, builds, err = buildContainers(ctx, buildCache, spec.Containers, reuse.Containers)
 	if err != nil {
 		return data, err
 	}
 	data.Builds = append(data.Builds, builds...)
 
-	data.Jobs, builds, err = buildContainers(ctx, buildCache, spec.Jobs)
+	data.Jobs, builds, err = buildContainers(ctx, buildCache, spec.Jobs, reuse.Jobs)
 	if err != nil {
 		return data, err
 	}
 	data.Builds = append(data.Builds, builds...)
 
-	data.Images, builds, err = buildImages(ctx, buildCache, spec.Images)
+	data.Images, builds, err = buildImages(ctx, buildCache, spec.Images, reuse.Images)
 	if err != nil {
 		return data, err
 	}
@@ -327,6 +372,30 @@ func fromSpec(ctx *buildContext, spec v1.BuilderSpec) (v1.ImagesData, error) {
 	return data, nil
 }
 
+// reusedImage returns the image of the previous build, if the previous build of the image key is the same build from a
+// Dockerfile. Images that are pulled are always resolved again, and only images of the push repository are reused.
+func reusedImage(ctx *buildContext, record v1.BuildRecord, image string) (string, bool) {
+	if ctx.opts.Reuse == nil || !strings.HasPrefix(image, ctx.pushRepo+"@") {
+		return "", false
+	}
+	if (record.ContainerBuild == nil || record.ContainerBuild.Build == nil) &&
+		(record.ImageBuild == nil || record.ImageBuild.ContainerBuild == nil) {
+		return "", false
+	}
+
+	current, err := json.Marshal(record)
+	if err != nil {
+		return "", false
+	}
+	for _, build := range ctx.opts.Reuse.Builds {
+		previous, err := json.Marshal(build)
+		if err == nil && bytes.Equal(previous, current) {
+			return image, true
+		}
+	}
+	return "", false
+}
+
 func pullImage(ctx *buildContext, image string) (id string, err error) {
 	ref, err := images2.ParseReferenceNoDefault(image)
 	if err != nil {
@@ -493,7 +562,7 @@ func printImageProgress(ctx *buildContext, id, name string, progress chan ggcrv1
 	}
 }
 
-func fromBuild(ctx *buildContext, buildCache *buildCache, build v1.Build) (id string, err error) {
+func fromBuild(ctx *buildContext, buildCache *buildCache, key string, build v1.Build) (id string, err error) {
 	id, err = buildCache.Get(build, ctx.opts.Platforms)
 	if err != nil || id != "" {
 		return id, err
@@ -514,22 +583,23 @@ func fromBuild(ctx *buildContext, buildCache *buildCache, build v1.Build) (id st
 	}
 
 	if build.BaseImage != "" || len(build.ContextDirs) > 0 {
-		return buildWithContext(ctx, build)
+		return buildWithContext(ctx, key, build)
 	}
 
-	return buildImageAndManifest(ctx, build)
+	return buildImageAndManifest(ctx, key, build)
 }
 
 func buildImageNoManifest(ctx *buildContext, cwd string, build v1.Build) (string, error) {
-	_, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, true, cwd, nil, build, ctx.messages, ctx.keychain)
+	_, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, true, cwd, nil, build, "", ctx.messages, ctx.keychain)
 	if err != nil {
 		return "", err
 	}
 	return ids[0], nil
 }
 
-func buildImageAndManifest(ctx *buildContext, build v1.Build) (string, error) {
-	platforms, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, false, ctx.cwd, ctx.opts.Platforms, build, ctx.messages, ctx.keychain)
+func buildImageAndManifest(ctx *buildContext, key string, build v1.Build) (string, error) {
+	build = withCache(ctx, build)
+	platforms, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, false, ctx.cwd, ctx.opts.Platforms, build, key, ctx.messages, ctx.keychain)
 	if err != nil {
 		return "", err
 	}
@@ -541,26 +611,47 @@ func buildImageAndManifest(ctx *buildContext, build v1.Build) (string, error) {
 	return createManifest(ids, platforms, ctx.remoteOpts)
 }
 
-func buildWithContext(ctx *buildContext, build v1.Build) (string, error) {
+func buildWithContext(ctx *buildContext, key string, build v1.Build) (string, error) {
 	var (
 		baseImage = build.BaseImage
 	)
 
 	if baseImage == "" {
-		newImage, err := buildImageAndManifest(ctx, build.BaseBuild())
+		newImage, err := buildImageAndManifest(ctx, key, build.BaseBuild())
 		if err != nil {
 			return "", err
 		}
 		baseImage = newImage
 	}
 
-	return buildImageAndManifest(ctx, v1.Build{
+	// The layers that copy the context dirs are cached separately from the base image
+	return buildImageAndManifest(ctx, key+".contextdirs", v1.Build{
 		Context:            ".",
 		Dockerfile:         "Dockerfile",
 		DockerfileContents: toContextCopyDockerFile(baseImage, build.ContextDirs),
+		Cache:              build.Cache,
 	})
 }
 
+// withCache adds the cache configuration of the build options to the build. The imports are added to the imports of
+// the build, the export replaces the export of the build.
+func withCache(ctx *buildContext, build v1.Build) v1.Build {
+	if ctx.opts.Cache == nil {
+		return build
+	}
+
+	cache := v1.BuildCache{}
+	if build.Cache != nil {
+		cache = *build.Cache
+	}
+	cache.From = append(append([]string{}, cache.From...), ctx.opts.Cache.From...)
+	if ctx.opts.Cache.To != "" {
+		cache.To = ctx.opts.Cache.To
+	}
+	build.Cache = &cache
+	return build
+}
+
 func toContextCopyDockerFile(baseImage string, contextDirs map[string]string) string {
 	buf := strings.Builder{}
 	buf.WriteString("FROM ")
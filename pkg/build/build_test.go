@@ -3,6 +3,7 @@ package build
 import (
 	"testing"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	vcs2 "github.com/acorn-io/runtime/pkg/vcs"
 	"github.com/stretchr/testify/assert"
 )
@@ -43,3 +44,53 @@ COPY --link "./files2" "/var/tmp2/"
 		})
 	}
 }
+
+func TestReusedImage(t *testing.T) {
+	web := v1.BuildRecord{
+		ContainerBuild: &v1.ContainerImageBuilderSpec{
+			Build: &v1.Build{Context: "web", Dockerfile: "web/Dockerfile"},
+		},
+		ImageKey: "web",
+	}
+	ctx := &buildContext{
+		pushRepo: "registry/app",
+		opts: v1.AcornImageBuildInstanceSpec{
+			Reuse: &v1.ImagesData{
+				Builds: []v1.BuildRecord{web},
+			},
+		},
+	}
+
+	id, ok := reusedImage(ctx, web, "registry/app@sha256:web")
+	assert.True(t, ok)
+	assert.Equal(t, "registry/app@sha256:web", id)
+
+	// The build changed
+	changed := *web.DeepCopy()
+	changed.ContainerBuild.Build.Target = "dev"
+	_, ok = reusedImage(ctx, changed, "registry/app@sha256:web")
+	assert.False(t, ok)
+
+	// Another image key
+	other := *web.DeepCopy()
+	other.ImageKey = "api"
+	_, ok = reusedImage(ctx, other, "registry/app@sha256:web")
+	assert.False(t, ok)
+
+	// The image is not in the push repository
+	_, ok = reusedImage(ctx, web, "registry/other@sha256:web")
+	assert.False(t, ok)
+
+	// Pulled images are not reused
+	pulled := v1.BuildRecord{
+		ContainerBuild: &v1.ContainerImageBuilderSpec{Image: "nginx"},
+		ImageKey:       "nginx",
+	}
+	ctx.opts.Reuse.Builds = append(ctx.opts.Reuse.Builds, pulled)
+	_, ok = reusedImage(ctx, pulled, "registry/app@sha256:nginx")
+	assert.False(t, ok)
+
+	ctx.opts.Reuse = nil
+	_, ok = reusedImage(ctx, web, "registry/app@sha256:web")
+	assert.False(t, ok)
+}
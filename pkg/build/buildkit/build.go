@@ -3,20 +3,35 @@ package buildkit
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/build/authprovider"
 	"github.com/acorn-io/runtime/pkg/buildclient"
+	"github.com/acorn-io/runtime/pkg/system"
 	cplatforms "github.com/containerd/containerd/platforms"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/uuid"
 	buildkit "github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/session"
+	"github.com/opencontainers/go-digest"
 	ocispecs "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
-func Build(ctx context.Context, pushRepo string, local bool, cwd string, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain) ([]v1.Platform, []string, error) {
+// Build builds the image for the platforms. The cache key is the key of the image in the Acornfile, it scopes the build
+// cache of the image.
+func Build(ctx context.Context, pushRepo string, local bool, cwd string, platforms []v1.Platform, build v1.Build, cacheKey string, messages buildclient.Messages, keychain authn.Keychain) ([]v1.Platform, []string, error) {
+	if usesLocalCache(build.Cache) {
+		if _, err := os.Stat(system.BuildCacheDir); os.IsNotExist(err) {
+			return nil, nil, fmt.Errorf("local build caches require a cache volume for the builder, it is created if acorn is installed with --builder-cache-size")
+		} else if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	bkc, err := buildkit.New(ctx, "")
 	if err != nil {
 		return nil, nil, err
@@ -88,7 +103,12 @@ func Build(ctx context.Context, pushRepo string, local bool, cwd string, platfor
 			options.FrontendAttrs["build-arg:"+key] = value
 		}
 
-		ch, progressDone := progress(messages)
+		options.CacheImports, options.CacheExports, err = cacheOptions(build.Cache, cacheKey, platform)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ch, progressDone := progress(messages, build.Cache != nil)
 		defer func() { <-progressDone }()
 
 		res, err := bkc.Solve(ctx, nil, options, ch)
@@ -103,7 +123,7 @@ func Build(ctx context.Context, pushRepo string, local bool, cwd string, platfor
 	return platforms, result, nil
 }
 
-func progress(messages buildclient.Messages) (chan *buildkit.SolveStatus, chan struct{}) {
+func progress(messages buildclient.Messages, cacheStats bool) (chan *buildkit.SolveStatus, chan struct{}) {
 	var (
 		done      = make(chan struct{})
 		ch        = make(chan *buildkit.SolveStatus, 1)
@@ -111,14 +131,69 @@ func progress(messages buildclient.Messages) (chan *buildkit.SolveStatus, chan s
 	)
 
 	go func() {
+		stats := newCacheStats()
 		for status := range ch {
+			stats.add(status)
 			_ = messages.Send(&buildclient.Message{
 				StatusSessionID: sessionid,
 				Status:          status,
 			})
 		}
+		if cacheStats {
+			_ = messages.Send(&buildclient.Message{
+				StatusSessionID: sessionid,
+				Status:          stats.status(sessionid),
+			})
+		}
 		close(done)
 	}()
 
 	return ch, done
 }
+
+// cacheStats counts the steps of a build that were cached
+type cacheStats struct {
+	steps map[digest.Digest]bool
+}
+
+func newCacheStats() *cacheStats {
+	return &cacheStats{
+		steps: map[digest.Digest]bool{},
+	}
+}
+
+func (c *cacheStats) add(status *buildkit.SolveStatus) {
+	for _, vertex := range status.Vertexes {
+		// Only the steps of the Dockerfile are counted, like "[2/4] RUN make", not internal steps like loading the
+		// Dockerfile or exporting the image and cache
+		if vertex.Completed == nil || !strings.HasPrefix(vertex.Name, "[") ||
+			strings.HasPrefix(vertex.Name, "[internal]") || strings.HasPrefix(vertex.Name, "[auth]") {
+			continue
+		}
+		c.steps[vertex.Digest] = vertex.Cached
+	}
+}
+
+func (c *cacheStats) hits() (hits int) {
+	for _, cached := range c.steps {
+		if cached {
+			hits++
+		}
+	}
+	return
+}
+
+func (c *cacheStats) status(sessionid string) *buildkit.SolveStatus {
+	now := time.Now()
+	hits := c.hits()
+	return &buildkit.SolveStatus{
+		Vertexes: []*buildkit.Vertex{
+			{
+				Digest:    digest.FromString("cache-stats-" + sessionid),
+				Name:      fmt.Sprintf("[cache] %d hits, %d misses", hits, len(c.steps)-hits),
+				Started:   &now,
+				Completed: &now,
+			},
+		},
+	}
+}
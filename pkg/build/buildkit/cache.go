@@ -0,0 +1,178 @@
+package buildkit
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/system"
+	cplatforms "github.com/containerd/containerd/platforms"
+	"github.com/google/go-containerregistry/pkg/name"
+	buildkit "github.com/moby/buildkit/client"
+	ocispecs "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	CacheTypeRegistry = "registry"
+	CacheTypeLocal    = "local"
+)
+
+// ValidateCache returns an error if an import or the export of the cache can not be used
+func ValidateCache(cache *v1.BuildCache) error {
+	if cache == nil {
+		return nil
+	}
+	for _, from := range cache.From {
+		if _, err := parseCacheEntry(from, false); err != nil {
+			return err
+		}
+	}
+	if cache.To != "" {
+		if _, err := parseCacheEntry(cache.To, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseCacheEntry parses an entry in the buildx syntax, like "type=registry,ref=ghcr.io/acme/app:cache". A value
+// without attributes is the ref of a registry cache.
+func parseCacheEntry(value string, export bool) (buildkit.CacheOptionsEntry, error) {
+	entry := buildkit.CacheOptionsEntry{
+		Attrs: map[string]string{},
+	}
+
+	if !strings.Contains(value, "=") {
+		entry.Type = CacheTypeRegistry
+		entry.Attrs["ref"] = value
+	} else {
+		fields, err := csv.NewReader(strings.NewReader(value)).Read()
+		if err != nil {
+			return entry, fmt.Errorf("invalid cache %q: %w", value, err)
+		}
+		for _, field := range fields {
+			k, v, ok := strings.Cut(field, "=")
+			if !ok {
+				return entry, fmt.Errorf("invalid cache %q: %q is not a key=value pair", value, field)
+			}
+			k = strings.TrimSpace(strings.ToLower(k))
+			if k == "type" {
+				entry.Type = v
+			} else {
+				entry.Attrs[k] = v
+			}
+		}
+	}
+
+	switch entry.Type {
+	case CacheTypeRegistry:
+		if entry.Attrs["ref"] == "" {
+			return entry, fmt.Errorf("invalid cache %q: registry cache requires ref", value)
+		}
+		if _, err := name.NewTag(entry.Attrs["ref"]); err != nil {
+			return entry, fmt.Errorf("invalid cache %q: %w", value, err)
+		}
+	case CacheTypeLocal:
+		if export && entry.Attrs["dest"] == "" {
+			return entry, fmt.Errorf("invalid cache %q: local cache export requires dest", value)
+		} else if !export && entry.Attrs["src"] == "" {
+			return entry, fmt.Errorf("invalid cache %q: local cache import requires src", value)
+		}
+	case "":
+		return entry, fmt.Errorf("invalid cache %q: type is required", value)
+	default:
+		return entry, fmt.Errorf("invalid cache %q: unsupported type %q, must be %s or %s", value, entry.Type, CacheTypeRegistry, CacheTypeLocal)
+	}
+
+	return entry, nil
+}
+
+// usesLocalCache returns true if the cache imports or exports a local cache
+func usesLocalCache(cache *v1.BuildCache) bool {
+	if cache == nil {
+		return false
+	}
+	for _, from := range cache.From {
+		if entry, err := parseCacheEntry(from, false); err == nil && entry.Type == CacheTypeLocal {
+			return true
+		}
+	}
+	entry, err := parseCacheEntry(cache.To, true)
+	return err == nil && entry.Type == CacheTypeLocal
+}
+
+// cacheOptions returns the cache imports and exports of a build for one platform. All builds of an Acornfile can
+// share the same cache configuration, so the cache of every image key and platform is stored separately: registry
+// caches get a tag suffix and local caches a sub directory of the cache volume of the builder.
+func cacheOptions(cache *v1.BuildCache, key string, platform v1.Platform) (imports, exports []buildkit.CacheOptionsEntry, _ error) {
+	if cache == nil {
+		return nil, nil, nil
+	}
+
+	scope := cacheScope(key, platform)
+
+	for _, from := range cache.From {
+		entry, err := parseCacheEntry(from, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		scoped, err := scopeCacheEntry(entry, "src", scope)
+		if err != nil {
+			return nil, nil, err
+		}
+		imports = append(imports, scoped)
+		if entry.Type == CacheTypeRegistry {
+			// also use caches that were exported by other tools, like docker buildx
+			imports = append(imports, entry)
+		}
+	}
+
+	if cache.To != "" {
+		entry, err := parseCacheEntry(cache.To, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		scoped, err := scopeCacheEntry(entry, "dest", scope)
+		if err != nil {
+			return nil, nil, err
+		}
+		exports = append(exports, scoped)
+	}
+
+	return imports, exports, nil
+}
+
+func scopeCacheEntry(entry buildkit.CacheOptionsEntry, dirAttr, scope string) (buildkit.CacheOptionsEntry, error) {
+	result := buildkit.CacheOptionsEntry{
+		Type:  entry.Type,
+		Attrs: map[string]string{},
+	}
+	for k, v := range entry.Attrs {
+		result.Attrs[k] = v
+	}
+
+	switch entry.Type {
+	case CacheTypeRegistry:
+		tag, err := name.NewTag(entry.Attrs["ref"])
+		if err != nil {
+			return result, err
+		}
+		result.Attrs["ref"] = tag.Context().Tag(tag.TagStr() + "-" + scope).Name()
+	case CacheTypeLocal:
+		// cleaning the path as an absolute path keeps it in the cache directory
+		result.Attrs[dirAttr] = filepath.Join(system.BuildCacheDir, filepath.Clean("/"+entry.Attrs[dirAttr]), scope)
+	}
+
+	return result, nil
+}
+
+// cacheScope returns a short ID that is stable for the same image key and platform. Changes to the build, like its
+// build args or Dockerfile, keep the scope, so the next build can use the layers that did not change.
+func cacheScope(key string, platform v1.Platform) string {
+	hash := sha256.Sum256([]byte(key + "/" + cplatforms.Format(ocispecs.Platform(platform))))
+	return hex.EncodeToString(hash[:])[:12]
+}
@@ -0,0 +1,134 @@
+package buildkit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/system"
+	buildkit "github.com/moby/buildkit/client"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCacheEntry(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		export  bool
+		want    buildkit.CacheOptionsEntry
+		wantErr string
+	}{
+		{
+			name:  "image reference",
+			value: "ghcr.io/acme/app:cache",
+			want:  buildkit.CacheOptionsEntry{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/acme/app:cache"}},
+		},
+		{
+			name:   "registry with mode",
+			value:  "type=registry,ref=ghcr.io/acme/app:cache,mode=max",
+			export: true,
+			want:   buildkit.CacheOptionsEntry{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/acme/app:cache", "mode": "max"}},
+		},
+		{
+			name:  "local import",
+			value: "type=local,src=app",
+			want:  buildkit.CacheOptionsEntry{Type: "local", Attrs: map[string]string{"src": "app"}},
+		},
+		{
+			name:    "local export without dest",
+			value:   "type=local,src=app",
+			export:  true,
+			wantErr: `invalid cache "type=local,src=app": local cache export requires dest`,
+		},
+		{
+			name:    "registry without ref",
+			value:   "type=registry,mode=max",
+			wantErr: `invalid cache "type=registry,mode=max": registry cache requires ref`,
+		},
+		{
+			name:    "unsupported type",
+			value:   "type=gha,scope=app",
+			wantErr: `invalid cache "type=gha,scope=app": unsupported type "gha", must be registry or local`,
+		},
+		{
+			name:    "missing type",
+			value:   "ref=ghcr.io/acme/app:cache",
+			wantErr: `invalid cache "ref=ghcr.io/acme/app:cache": type is required`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCacheEntry(tt.value, tt.export)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCacheOptions(t *testing.T) {
+	cache := &v1.BuildCache{
+		From: []string{"ghcr.io/acme/app:cache", "type=local,src=../../app"},
+		To:   "type=registry,ref=ghcr.io/acme/app:cache,mode=max",
+	}
+	amd64 := v1.Platform{OS: "linux", Architecture: "amd64"}
+
+	imports, exports, err := cacheOptions(cache, "web", amd64)
+	require.NoError(t, err)
+	scope := cacheScope("web", amd64)
+	assert.Len(t, scope, 12)
+
+	assert.Equal(t, []buildkit.CacheOptionsEntry{
+		{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/acme/app:cache-" + scope}},
+		{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/acme/app:cache"}},
+		{Type: "local", Attrs: map[string]string{"src": filepath.Join(system.BuildCacheDir, "app", scope)}},
+	}, imports)
+	assert.Equal(t, []buildkit.CacheOptionsEntry{
+		{Type: "registry", Attrs: map[string]string{"ref": "ghcr.io/acme/app:cache-" + scope, "mode": "max"}},
+	}, exports)
+
+	// Other images and platforms do not share the cache
+	assert.NotEqual(t, scope, cacheScope("web", v1.Platform{OS: "linux", Architecture: "arm64"}))
+	assert.NotEqual(t, scope, cacheScope("api", amd64))
+
+	imports, exports, err = cacheOptions(nil, "web", amd64)
+	require.NoError(t, err)
+	assert.Nil(t, imports)
+	assert.Nil(t, exports)
+}
+
+func TestUsesLocalCache(t *testing.T) {
+	assert.False(t, usesLocalCache(nil))
+	assert.False(t, usesLocalCache(&v1.BuildCache{From: []string{"ghcr.io/acme/app:cache"}, To: "ghcr.io/acme/app:cache"}))
+	assert.True(t, usesLocalCache(&v1.BuildCache{From: []string{"type=local,src=app"}}))
+	assert.True(t, usesLocalCache(&v1.BuildCache{To: "type=local,dest=app"}))
+}
+
+func TestCacheStats(t *testing.T) {
+	now := time.Now()
+	stats := newCacheStats()
+	stats.add(&buildkit.SolveStatus{
+		Vertexes: []*buildkit.Vertex{
+			{Digest: digest.FromString("1"), Name: "[internal] load build definition from Dockerfile", Completed: &now},
+			{Digest: digest.FromString("2"), Name: "[1/3] FROM docker.io/library/alpine", Cached: true, Completed: &now},
+			{Digest: digest.FromString("3"), Name: "[2/3] COPY . .", Cached: true, Completed: &now},
+			{Digest: digest.FromString("4"), Name: "[3/3] RUN make"},
+		},
+	})
+	stats.add(&buildkit.SolveStatus{
+		Vertexes: []*buildkit.Vertex{
+			{Digest: digest.FromString("4"), Name: "[3/3] RUN make", Completed: &now},
+			{Digest: digest.FromString("5"), Name: "exporting cache", Completed: &now},
+		},
+	})
+
+	status := stats.status("session")
+	require.Len(t, status.Vertexes, 1)
+	assert.Equal(t, "[cache] 2 hits, 1 misses", status.Vertexes[0].Name)
+}
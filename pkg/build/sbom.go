@@ -0,0 +1,116 @@
+package build
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/sbom"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// generateSBOMs stores a SBOM for every container, job and image of the app image and one for the Acornfile next
+// to the app image
+func generateSBOMs(ctx *buildContext, subject name.Digest, appImage *v1.AppImage) error {
+	targets := sbomTargets(appImage.ImageData)
+
+	for _, target := range typed.Sorted(targets) {
+		ref, err := name.NewDigest(target.Value)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", target.Value, err)
+		}
+
+		pkgs, err := scanImage(ref, ctx.remoteOpts)
+		if err != nil {
+			return fmt.Errorf("failed to scan %s for SBOM: %w", target.Key, err)
+		}
+
+		if err := attachSBOM(ctx, subject, target.Key, ref.Name(), pkgs); err != nil {
+			return err
+		}
+	}
+
+	sum := sha256.Sum256([]byte(appImage.Acornfile))
+	pkgs := []sbom.Package{{
+		Name:   "Acornfile",
+		Type:   sbom.PackageTypeAcornfile,
+		Digest: "sha256:" + hex.EncodeToString(sum[:]),
+	}}
+	for _, target := range typed.Sorted(typed.Concat(targets, acornTargets(appImage.ImageData))) {
+		ref, err := name.NewDigest(target.Value)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", target.Value, err)
+		}
+		pkgs = append(pkgs, sbom.Package{
+			Name:   target.Key,
+			Type:   sbom.PackageTypeOCI,
+			Digest: ref.DigestStr(),
+		})
+	}
+
+	return attachSBOM(ctx, subject, sbom.TargetAcornfile, subject.Name(), pkgs)
+}
+
+func attachSBOM(ctx *buildContext, subject name.Digest, target, name string, pkgs []sbom.Package) error {
+	doc, err := sbom.Encode(ctx.opts.SBOM, name, pkgs)
+	if err != nil {
+		return err
+	}
+	if _, err := sbom.Attach(subject, target, ctx.opts.SBOM, doc, ctx.remoteOpts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func scanImage(ref name.Digest, opts []remote.Option) ([]sbom.Package, error) {
+	desc, err := remote.Get(ref, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if desc.MediaType.IsIndex() {
+		index, err := desc.ImageIndex()
+		if err != nil {
+			return nil, err
+		}
+		return sbom.ScanIndex(index)
+	}
+
+	img, err := desc.Image()
+	if err != nil {
+		return nil, err
+	}
+	return sbom.Scan(img)
+}
+
+// sbomTargets returns the images of the app image that are scanned, keyed by the target name of their SBOM
+func sbomTargets(data v1.ImagesData) map[string]string {
+	result := map[string]string{}
+	for prefix, containers := range map[string]map[string]v1.ContainerData{
+		"containers": data.Containers,
+		"jobs":       data.Jobs,
+	} {
+		for key, container := range containers {
+			result[prefix+"/"+key] = container.Image
+			for sidecarKey, sidecar := range container.Sidecars {
+				result[prefix+"/"+key+"/sidecars/"+sidecarKey] = sidecar.Image
+			}
+		}
+	}
+	for key, image := range data.Images {
+		result["images/"+key] = image.Image
+	}
+	return result
+}
+
+// acornTargets returns the nested app images, they are listed in the SBOM of the Acornfile but not scanned
+func acornTargets(data v1.ImagesData) map[string]string {
+	result := map[string]string{}
+	for key, image := range data.Acorns {
+		result["acorns/"+key] = image.Image
+	}
+	return result
+}
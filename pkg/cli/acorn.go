@@ -43,6 +43,7 @@ func New() *cobra.Command {
 		NewController(cmdContext),
 		NewCredential(cmdContext),
 		NewDev(cmdContext),
+		NewDiff(cmdContext),
 		NewRender(cmdContext),
 		NewExec(cmdContext),
 		NewPortForward(cmdContext),
@@ -53,7 +54,9 @@ func New() *cobra.Command {
 		NewOfferings(cmdContext),
 		NewUninstall(cmdContext),
 		NewInfo(cmdContext),
+		NewJob(cmdContext),
 		NewLogs(cmdContext),
+		NewLogForwarder(cmdContext),
 		NewCredentialLogin(true, cmdContext),
 		NewCredentialLogout(true, cmdContext),
 		NewProject(cmdContext),
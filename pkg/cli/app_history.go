@@ -0,0 +1,53 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewAppHistory(c CommandContext) *cobra.Command {
+	return cli.Command(&AppHistory{client: c.ClientFactory}, cobra.Command{
+		Use: "history [flags] APP_NAME",
+		Example: `
+acorn app history my-app`,
+		SilenceUsage:      true,
+		Short:             "List the revisions of an app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppHistory struct {
+	Quiet  bool   `usage:"Output only revisions" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *AppHistory) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.AppRevision, a.Quiet, a.Output)
+	if a.Quiet {
+		out = table.NewWriter([][]string{
+			{"Revision", "Revision"},
+		}, a.Quiet, a.Output)
+	}
+
+	// Newest revision first
+	for i := len(app.Status.Revisions) - 1; i >= 0; i-- {
+		revision := app.Status.Revisions[i]
+		out.WriteFormatted(&revision, nil)
+	}
+
+	return out.Err()
+}
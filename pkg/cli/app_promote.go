@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewAppPromote(c CommandContext) *cobra.Command {
+	return cli.Command(&AppPromote{client: c.ClientFactory}, cobra.Command{
+		Use: "promote [flags] APP_NAME",
+		Example: `
+# Move a canary rollout to the next step, or switch a blue/green rollout to the new revision
+acorn app promote my-app
+
+# Finish the rollout without going through the remaining steps
+acorn app promote --full my-app`,
+		SilenceUsage:      true,
+		Short:             "Promote the rollout of an app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppPromote struct {
+	Full   bool `usage:"Promote the new revision without going through the remaining rollout steps"`
+	client ClientFactory
+}
+
+func (a *AppPromote) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	if err := c.AppPromote(cmd.Context(), args[0], &client.AppPromoteOptions{
+		Full: a.Full,
+	}); err != nil {
+		return fmt.Errorf("promoting %s: %w", args[0], err)
+	}
+
+	fmt.Println(args[0])
+	return nil
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewAppRollback(c CommandContext) *cobra.Command {
+	return cli.Command(&AppRollback{client: c.ClientFactory}, cobra.Command{
+		Use: "rollback [flags] APP_NAME [REVISION]",
+		Example: `
+# Abort the rollout in progress, or go back to the previous revision
+acorn app rollback my-app
+
+# Go back to revision 3 listed by "acorn app history my-app"
+acorn app rollback my-app 3`,
+		SilenceUsage:      true,
+		Short:             "Roll an app back to a previous revision",
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppRollback struct {
+	client ClientFactory
+}
+
+func (a *AppRollback) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	var revision int64
+	if len(args) > 1 {
+		revision, err = strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid revision [%s]: %w", args[1], err)
+		}
+		if _, ok := app.Status.GetRevision(revision); !ok || revision == 0 {
+			return fmt.Errorf("revision %d of app %s does not exist", revision, args[0])
+		}
+	} else if !app.Status.Rollout.InProgress(app.Status.AppImage) {
+		if len(app.Status.Revisions) < 2 {
+			return fmt.Errorf("app %s has no previous revision", args[0])
+		}
+		revision = app.Status.Revisions[len(app.Status.Revisions)-2].Revision
+	}
+
+	if err := c.AppRollback(cmd.Context(), args[0], &client.AppRollbackOptions{
+		Revision: revision,
+	}); err != nil {
+		if revision == 0 {
+			return fmt.Errorf("rolling back %s: %w", args[0], err)
+		}
+		return fmt.Errorf("rolling back %s to revision %d: %w", args[0], revision, err)
+	}
+
+	fmt.Println(args[0])
+	return nil
+}
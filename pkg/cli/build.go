@@ -3,10 +3,13 @@ package cli
 import (
 	"fmt"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/build/buildkit"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/imagesource"
 	"github.com/acorn-io/runtime/pkg/progressbar"
+	"github.com/acorn-io/runtime/pkg/sbom"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/rancher/wrangler/pkg/merr"
 	"github.com/spf13/cobra"
@@ -17,7 +20,13 @@ func NewBuild(c CommandContext) *cobra.Command {
 		Use: "build [flags] DIRECTORY",
 		Example: `
 # Build from Acornfile file in the local directory
-acorn build .`,
+acorn build .
+
+# Build and store a SPDX SBOM for each image and the Acornfile
+acorn build --sbom .
+
+# Build with the cache of the last build and store the new cache in a registry
+acorn build --cache-from ghcr.io/acme/app:cache --cache-to type=registry,ref=ghcr.io/acme/app:cache,mode=max .`,
 		SilenceUsage: true,
 		Short:        "Build an app from a Acornfile file",
 		Long:         "Build all dependent container and app images from your Acornfile file",
@@ -27,12 +36,16 @@ acorn build .`,
 }
 
 type Build struct {
-	Push     bool     `usage:"Push image after build"`
-	File     string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
-	Tag      []string `short:"t" usage:"Apply a tag to the final build"`
-	Platform []string `short:"p" usage:"Target platforms (form os/arch[/variant][:osversion] example linux/amd64)"`
-	Profile  []string `usage:"Profile to assign default values"`
-	client   ClientFactory
+	Push       bool     `usage:"Push image after build"`
+	File       string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
+	Tag        []string `short:"t" usage:"Apply a tag to the final build"`
+	Platform   []string `short:"p" usage:"Target platforms (form os/arch[/variant][:osversion] example linux/amd64)"`
+	Profile    []string `usage:"Profile to assign default values"`
+	SBOM       bool     `name:"sbom" usage:"Store a SBOM for each image and the Acornfile next to the app image"`
+	SBOMFormat string   `name:"sbom-format" usage:"Format of the SBOM (spdx, cyclonedx)" default:"spdx"`
+	CacheFrom  []string `name:"cache-from" usage:"Import build cache from a registry (type=registry,ref=IMAGE or IMAGE) or a local directory of the builder (type=local,src=DIR)"`
+	CacheTo    string   `name:"cache-to" usage:"Export build cache to a registry (type=registry,ref=IMAGE[,mode=max] or IMAGE) or a local directory of the builder (type=local,dest=DIR)"`
+	client     ClientFactory
 }
 
 func (s *Build) Run(cmd *cobra.Command, args []string) error {
@@ -45,6 +58,17 @@ func (s *Build) Run(cmd *cobra.Command, args []string) error {
 	}
 
 	helper := imagesource.NewImageSource(s.File, args, s.Profile, s.Platform)
+	if s.SBOM {
+		if _, err := sbom.MediaType(s.SBOMFormat); err != nil {
+			return err
+		}
+		helper.SBOM = s.SBOMFormat
+	}
+	if err := buildkit.ValidateCache(&v1.BuildCache{From: s.CacheFrom, To: s.CacheTo}); err != nil {
+		return err
+	}
+	helper.CacheFrom = s.CacheFrom
+	helper.CacheTo = s.CacheTo
 	image, _, err := helper.GetImageAndDeployArgs(cmd.Context(), c)
 	if err != nil {
 		return err
@@ -12,6 +12,7 @@ import (
 	"github.com/rancher/wrangler/pkg/signals"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var (
@@ -87,6 +88,7 @@ func Command(obj Runnable, cmd cobra.Command) *cobra.Command {
 		maps       = map[string]reflect.Value{}
 		boolmaps   = map[string]reflect.Value{}
 		quantities = map[string]reflect.Value{}
+		milliQtys  = map[string]reflect.Value{}
 		optString  = map[string]reflect.Value{}
 		optBool    = map[string]reflect.Value{}
 		optInt     = map[string]reflect.Value{}
@@ -157,11 +159,16 @@ func Command(obj Runnable, cmd cobra.Command) *cobra.Command {
 				flags.IntP(name, alias, defInt, usage)
 			case reflect.Int64:
 				// In the case that a quantity tag is found and set to true, we want to create a string flag
-				// for it that will get parsed into an *int64. See assignQuantities().
-				if fieldType.Tag.Get("quantity") == "true" {
+				// for it that will get parsed into an *int64. See assignQuantities(). A quantity tag set to milli
+				// is parsed into milli units instead (used for cpu). See assignMilliQuantities().
+				switch fieldType.Tag.Get("quantity") {
+				case "true":
 					quantities[name] = v
 					flags.StringP(name, alias, defValue, usage)
-				} else {
+				case "milli":
+					milliQtys[name] = v
+					flags.StringP(name, alias, defValue, usage)
+				default:
 					flags.Int64P(name, alias, int64(defInt), usage)
 				}
 			case reflect.String:
@@ -197,9 +204,9 @@ func Command(obj Runnable, cmd cobra.Command) *cobra.Command {
 	}
 
 	c.RunE = obj.Run
-	c.PersistentPreRunE = bind(c.PersistentPreRunE, arrays, slices, maps, boolmaps, optInt, optBool, optString, quantities, envs)
-	c.PreRunE = bind(c.PreRunE, arrays, slices, maps, boolmaps, optInt, optBool, optString, quantities, envs)
-	c.RunE = bind(c.RunE, arrays, slices, maps, boolmaps, optInt, optBool, optString, quantities, envs)
+	c.PersistentPreRunE = bind(c.PersistentPreRunE, arrays, slices, maps, boolmaps, optInt, optBool, optString, quantities, milliQtys, envs)
+	c.PreRunE = bind(c.PreRunE, arrays, slices, maps, boolmaps, optInt, optBool, optString, quantities, milliQtys, envs)
+	c.RunE = bind(c.RunE, arrays, slices, maps, boolmaps, optInt, optBool, optString, quantities, milliQtys, envs)
 
 	cust, ok := obj.(customizer)
 	if ok {
@@ -247,6 +254,30 @@ func assignQuantities(app *cobra.Command, maps map[string]reflect.Value) error {
 	return nil
 }
 
+func assignMilliQuantities(app *cobra.Command, maps map[string]reflect.Value) error {
+	for k, v := range maps {
+		k = contextKey(k)
+
+		i, err := app.Flags().GetString(k)
+		if err != nil {
+			return err
+		}
+
+		if i == "" {
+			continue
+		}
+
+		quantity, err := resource.ParseQuantity(i)
+		if err != nil {
+			return err
+		}
+
+		milliValue := quantity.MilliValue()
+		v.Set(reflect.ValueOf(&milliValue))
+	}
+	return nil
+}
+
 func assignOptString(app *cobra.Command, maps map[string]reflect.Value) error {
 	for k, v := range maps {
 		k = contextKey(k)
@@ -394,6 +425,7 @@ func bind(next func(*cobra.Command, []string) error,
 	optBool map[string]reflect.Value,
 	optString map[string]reflect.Value,
 	quantites map[string]reflect.Value,
+	milliQuantities map[string]reflect.Value,
 	envs []func()) func(*cobra.Command, []string) error {
 	if next == nil {
 		return nil
@@ -426,6 +458,9 @@ func bind(next func(*cobra.Command, []string) error,
 		if err := assignQuantities(cmd, quantites); err != nil {
 			return err
 		}
+		if err := assignMilliQuantities(cmd, milliQuantities); err != nil {
+			return err
+		}
 
 		if next != nil {
 			return next(cmd, args)
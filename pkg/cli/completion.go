@@ -241,6 +241,22 @@ func volumesCompletion(ctx context.Context, c client.Client, toComplete string)
 	return result, nil
 }
 
+func volumeSnapshotsCompletion(ctx context.Context, c client.Client, toComplete string) ([]string, error) {
+	snapshots, err := c.VolumeSnapshotList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, snapshot := range snapshots {
+		if strings.HasPrefix(snapshot.Name, toComplete) {
+			result = append(result, snapshot.Name)
+		}
+	}
+
+	return result, nil
+}
+
 func secretsCompletion(ctx context.Context, c client.Client, toComplete string) ([]string, error) {
 	secrets, err := c.SecretList(ctx)
 	if err != nil {
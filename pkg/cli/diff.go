@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/acorn-io/runtime/pkg/appdiff"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/imagesource"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+func NewDiff(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&Diff{client: c.ClientFactory}, cobra.Command{
+		Use:          "diff [flags] APP_NAME [IMAGE|DIRECTORY] [deploy flags]",
+		SilenceUsage: true,
+		Short:        "Show the changes an update would make to a deployed app",
+		Long: "Show the changes an update would make to a deployed app, without updating it. The containers, jobs, ports, " +
+			"secrets, volumes and permissions of the app are compared to the ones the image or Acornfile and the deploy " +
+			"flags evaluate to. Changes that redeploy containers are marked with the reason, like a changed image, " +
+			"command, environment variable or sidecar, or a changed secret that is used with onChange: redeploy, and permissions that must be approved are marked " +
+			"with (needs approval). The values of secrets and deploy args are never shown. The app's image is used if " +
+			"no image or directory is given.",
+		Example: `# Show the changes that updating the app my-app to a new image would make
+  acorn diff my-app ghcr.io/acorn-io/library/hello-world:v2
+
+  # Show the changes that updating my-app with the Acornfile in the current directory would make
+  acorn diff my-app .
+
+  # Show the changes a new deploy arg would make
+  acorn diff my-app --replicas 3`,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	cmd.Flags().SetInterspersed(false)
+	return cmd
+}
+
+type Diff struct {
+	File    string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
+	Profile []string `usage:"Profile to assign default values"`
+	Volume  []string `usage:"Bind an existing volume (format existing:vol-name,field=value) (ex: pvc-name:app-data)" short:"v" split:"false"`
+	Secret  []string `usage:"Bind an existing secret (format existing:sec-name) (ex: sec-name:app-secret)" short:"s"`
+	Publish []string `usage:"Publish port of application (format [public:]private) (ex 81:80)" short:"p"`
+	Env     []string `usage:"Environment variables to set on running containers" short:"e"`
+	Output  string   `usage:"Output the changes as JSON or YAML (json, yaml)" short:"o"`
+	client  ClientFactory
+}
+
+func (s *Diff) Run(cmd *cobra.Command, args []string) error {
+	c, err := s.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	app, err := c.AppGet(cmd.Context(), name)
+	if err != nil {
+		return err
+	}
+	// ToAppUpdate changes the app it gets, keep the deployed app
+	app = app.DeepCopy()
+
+	imageSource := imagesource.NewImageSource(s.File, args[1:], s.Profile, nil)
+	if !imageSource.IsImageSet() {
+		imageSource = imageSource.WithImage(app.Spec.Image)
+	}
+	if len(imageSource.Profiles) == 0 {
+		imageSource.Profiles = app.Spec.Profiles
+	}
+
+	_, file, err := imageSource.ResolveImageAndFile()
+	if err != nil {
+		return err
+	}
+
+	appDef, deployArgs, err := imageSource.GetAppDefinition(cmd.Context(), c)
+	if err != nil {
+		return err
+	}
+
+	runArgs := RunArgs{
+		Name: name,
+		UpdateArgs: UpdateArgs{
+			Profile: s.Profile,
+			Volume:  s.Volume,
+			Secret:  s.Secret,
+			Publish: s.Publish,
+			Env:     s.Env,
+		},
+	}
+	opts, err := runArgs.ToOpts()
+	if err != nil {
+		return err
+	}
+
+	updateOpts := opts.ToUpdate()
+	updateOpts.DeployArgs = deployArgs
+	if file == "" {
+		// A directory isn't built, the app keeps its image and the containers built from source keep their images
+		updateOpts.Image = imageSource.Image
+	}
+
+	updated, err := client.ToAppUpdate(cmd.Context(), c, name, &updateOpts)
+	if err != nil {
+		return err
+	}
+
+	// The deploy args of the update are merged with the deploy args of the app
+	appDef, _, err = appDef.WithArgs(updated.Spec.DeployArgs, updated.Spec.Profiles)
+	if err != nil {
+		return err
+	}
+
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		return err
+	}
+
+	changes, err := appdiff.Compare(appdiff.App{
+		Spec:    app.Spec,
+		AppSpec: app.Status.AppSpec,
+	}, appdiff.App{
+		Spec:    updated.Spec,
+		AppSpec: *appSpec,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch s.Output {
+	case "":
+		if len(changes) == 0 {
+			fmt.Println("No changes")
+		}
+		for _, change := range changes {
+			fmt.Println(change.String())
+		}
+		return nil
+	case "json":
+		data, err := json.MarshalIndent(nonNil(changes), "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	case "yaml":
+		data, err := yaml.Marshal(nonNil(changes))
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(data))
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %s", s.Output)
+	}
+}
+
+// nonNil returns an empty list instead of nil, so that no changes are output as [] instead of null
+func nonNil(changes []appdiff.Change) []appdiff.Change {
+	if changes == nil {
+		return []appdiff.Change{}
+	}
+	return changes
+}
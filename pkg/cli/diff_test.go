@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiff(t *testing.T) {
+	app := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "found"},
+		Spec:       v1.AppInstanceSpec{Image: "found-image"},
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {
+						Image: "nginx",
+						Ports: v1.Ports{{Port: 80, Protocol: v1.ProtocolHTTP, Publish: true}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "acorn diff found ./testdata/diff/",
+			args:    []string{"found", "./testdata/diff/"},
+			wantOut: "./testdata/diff/diff_test.txt",
+		},
+		{
+			name:    "acorn diff -o json found ./testdata/diff/",
+			args:    []string{"-o", "json", "found", "./testdata/diff/"},
+			wantOut: "./testdata/diff/diff_json_test.txt",
+		},
+		{
+			name:    "acorn diff -o xml found ./testdata/diff/",
+			args:    []string{"-o", "xml", "found", "./testdata/diff/"},
+			wantErr: "unsupported output format xml",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewDiff(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{AppItem: app.DeepCopy()},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			w.Close()
+			out, _ := io.ReadAll(r)
+			testOut, _ := os.ReadFile(tt.wantOut)
+			assert.Equal(t, string(testOut), string(out))
+		})
+	}
+}
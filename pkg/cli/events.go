@@ -1,9 +1,15 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/log"
 	"github.com/acorn-io/runtime/pkg/tables"
 	"github.com/spf13/cobra"
 )
@@ -35,44 +41,99 @@ func NewEvent(c CommandContext) *cobra.Command {
   # By default, this field is elided from this command's output, but can be enabled via the '--details' flag.
   # This flag must be used in conjunction with a non-table output format, like '-o=yaml'.
   acorn events --details -o yaml
+
+  # List the warnings about an app in the last hour
+  acorn events --severity warn --source app/my-app --since 1h
+
+  # Collapse repeated events into the latest one with a count
+  acorn events --collapse
+
+  # Stream events as one JSON object per line
+  acorn events -f -o json | jq .description
 `})
 	return cmd
 }
 
 type Events struct {
-	Tail    int    `usage:"Return this number of latest events" short:"t"`
-	Follow  bool   `usage:"Follow the event log" short:"f"`
-	Details bool   `usage:"Don't strip event details from response" short:"d"`
-	Output  string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
-	client  ClientFactory
+	Tail     int    `usage:"Return this number of latest events" short:"t"`
+	Follow   bool   `usage:"Follow the event log" short:"f"`
+	Details  bool   `usage:"Don't strip event details from response" short:"d"`
+	Output   string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Type     string `usage:"Only return events of this type"`
+	Severity string `usage:"Only return events of this severity (info, warn)"`
+	Source   string `usage:"Only return events about this source, as KIND or KIND/NAME (e.g. app/my-app)"`
+	Actor    string `usage:"Only return events caused by this actor"`
+	Since    string `usage:"Only return events observed after this time, as a duration (e.g. 42m) or RFC3339 timestamp" short:"s"`
+	Until    string `usage:"Only return events observed before this time, as a duration (e.g. 42m) or RFC3339 timestamp" short:"u"`
+	Collapse bool   `usage:"Collapse repeated events into the latest one with a count"`
+	client   ClientFactory
 }
 
 func (e *Events) Run(cmd *cobra.Command, args []string) error {
-	c, err := e.client.CreateDefault()
-	if err != nil {
-		return err
+	opts := &client.EventStreamOptions{
+		Tail:     e.Tail,
+		Follow:   e.Follow,
+		Details:  e.Details,
+		Type:     e.Type,
+		Severity: e.Severity,
+		Actor:    e.Actor,
+		Collapse: e.Collapse,
 	}
 
-	opts := &client.EventStreamOptions{
-		Tail:    e.Tail,
-		Follow:  e.Follow,
-		Details: e.Details,
+	if e.Severity != "" && e.Severity != string(v1.EventSeverityInfo) && e.Severity != string(v1.EventSeverityWarn) {
+		return fmt.Errorf("invalid severity [%s], must be %s or %s", e.Severity, v1.EventSeverityInfo, v1.EventSeverityWarn)
+	}
+
+	if e.Source != "" {
+		opts.SourceKind, opts.SourceName, _ = strings.Cut(e.Source, "/")
+	}
+
+	// Resolve relative times once, so they are the same for all projects
+	now := time.Now()
+	for _, t := range []struct {
+		flag, value string
+		opt         *string
+	}{
+		{flag: "since", value: e.Since, opt: &opts.Since},
+		{flag: "until", value: e.Until, opt: &opts.Until},
+	} {
+		parsed, err := log.ParseTime(t.value, now)
+		if err != nil {
+			return fmt.Errorf("invalid --%s [%s]: %w", t.flag, t.value, err)
+		}
+		if !parsed.IsZero() {
+			*t.opt = parsed.Format(time.RFC3339)
+		}
 	}
 
 	if len(args) > 0 {
 		opts.Name = args[0]
 	}
 
+	c, err := e.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
 	events, err := c.EventStream(cmd.Context(), opts)
 	if err != nil {
 		return err
 	}
 
-	out := table.NewWriter(tables.Event, false, e.Output)
+	// Data formats are streamed one event at a time, so they can be piped to other tools
+	format, stream := e.Output, false
+	switch format {
+	case "json":
+		format, stream = "jsoncompact", true
+	case "yaml":
+		stream = true
+	}
+
+	out := table.NewWriter(tables.Event, false, format)
 	for event := range events {
 		out.Write(&event)
 
-		if !opts.Follow {
+		if !opts.Follow && !stream {
 			// Wait to flush until all events have been written.
 			// This ensures consistent column width for table formatting.
 			continue
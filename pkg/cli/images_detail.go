@@ -1,6 +1,8 @@
 package cli
 
 import (
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/client"
@@ -62,13 +64,23 @@ func (a *ImageDetails) Run(cmd *cobra.Command, args []string) error {
 	image, err := c.ImageDetails(cmd.Context(), args[0], &client.ImageDetailsOptions{
 		NestedDigest: nested,
 		Auth:         auth,
+		IncludeSBOM:  nested == "",
 	})
 	if err != nil {
 		return err
 	}
 
 	w := table.NewWriter(nil, false, a.Output)
-	w.WriteFormatted(image.AppImage, nil)
+	w.WriteFormatted(imageDetailsOutput{
+		AppImage: image.AppImage,
+		SBOMs:    image.SBOMs,
+	}, nil)
 
 	return w.Close()
 }
+
+// imageDetailsOutput is the app image with a summary of its SBOMs, if it has any
+type imageDetailsOutput struct {
+	v1.AppImage `json:",inline"`
+	SBOMs       []apiv1.ImageSBOM `json:"sboms,omitempty"`
+}
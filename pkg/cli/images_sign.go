@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewImageSign(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageSign{client: c.ClientFactory}, cobra.Command{
+		Use: "sign IMAGE_NAME [flags]",
+		Example: `# Sign the image with a cosign private key
+acorn image sign my-image --key ./cosign.key
+
+# Add annotations to the signature, which ImageAllowRules can match on
+acorn image sign my-image --key ./cosign.key -a tag=release -a team=platform`,
+		SilenceUsage:      true,
+		Short:             "Sign an Image",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+		Args:              cobra.ExactArgs(1),
+	})
+	return cmd
+}
+
+type ImageSign struct {
+	client      ClientFactory
+	Key         string   `usage:"Path or URI of the private key to sign the image with (password is read from COSIGN_PASSWORD or prompted)" short:"k" local:"true" default:"./cosign.key"`
+	Annotations []string `usage:"Annotations to add to the signature (format key=value)" short:"a" local:"true"`
+}
+
+func (a *ImageSign) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	annotations, err := parseSignatureAnnotations(a.Annotations)
+	if err != nil {
+		return err
+	}
+
+	image, err := c.ImageGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	ref, err := signatureReference(args[0], image)
+	if err != nil {
+		return err
+	}
+
+	payload, err := cosign.GeneratePayload(ref, annotations)
+	if err != nil {
+		return err
+	}
+
+	signature, publicKey, err := cosign.SignPayload(cmd.Context(), payload, a.Key, keyPassword)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.ImageSign(cmd.Context(), args[0], payload, signature, publicKey)
+	if err != nil {
+		return err
+	}
+
+	pterm.Success.Printf("Signed image %s (%s), signature %s\n", args[0], image.Digest, result.SignatureDigest)
+	return nil
+}
+
+// signatureReference returns the reference that is written into the signature payload. Only the digest is
+// verified, the repository is informational.
+func signatureReference(arg string, image *apiv1.Image) (name.Digest, error) {
+	repo := image.Name
+	if ref, err := name.ParseReference(arg, name.WithDefaultRegistry("")); err == nil {
+		repo = ref.Context().String()
+	}
+	return name.NewDigest(repo+"@"+image.Digest, name.WithDefaultRegistry(""))
+}
+
+func parseSignatureAnnotations(annotations []string) (map[string]interface{}, error) {
+	if len(annotations) == 0 {
+		return nil, nil
+	}
+
+	result := map[string]interface{}{}
+	for _, annotation := range annotations {
+		k, v, ok := strings.Cut(annotation, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid annotation %s, must be in the format key=value", annotation)
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+// keyPassword returns the password of the private key, like cosign does
+func keyPassword(bool) ([]byte, error) {
+	if pw, ok := os.LookupEnv("COSIGN_PASSWORD"); ok {
+		return []byte(pw), nil
+	}
+
+	var pw string
+	err := survey.AskOne(&survey.Password{Message: "Enter password for private key"}, &pw)
+	return []byte(pw), err
+}
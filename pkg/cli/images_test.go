@@ -350,6 +350,82 @@ func TestImage(t *testing.T) {
 			wantErr: false,
 			wantOut: "index.docker.io/subdir/test:v1@test-image-running-container\n",
 		},
+		{
+			name: "acorn image verify unsigned", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"verify", "unsigned"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "verification of image unsigned (sha256:1234567890) failed: image <unsigned> is not allowed by any ImageAllowRule in this project",
+		},
+		{
+			name: "acorn image verify --key --rule", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"verify", "--key", "cosign.pub", "--rule", "signed", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "only one of --key and --rule can be set",
+		},
+		{
+			name: "acorn image verify -a", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"verify", "-a", "tag=release", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "--annotation can only be used with --key",
+		},
+		{
+			name: "acorn image verify --key missing file", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"verify", "--key", "does-not-exist.pub", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "failed to read key: open does-not-exist.pub: no such file or directory",
+		},
 		{
 			name: "acorn image rm dne-image", fields: fields{
 				All:    false,
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/credentials"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewImageVerify(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageVerify{client: c.ClientFactory}, cobra.Command{
+		Use: "verify IMAGE_NAME [flags]",
+		Example: `# Verify the image against all ImageAllowRules of the project
+acorn image verify my-image
+
+# Verify the image against a single ImageAllowRule
+acorn image verify my-image --rule signed-by-release
+
+# Verify that the image is signed with a key and has the annotations
+acorn image verify my-image --key ./cosign.pub -a tag=release`,
+		SilenceUsage:      true,
+		Short:             "Verify the signatures of an Image",
+		ValidArgsFunction: newCompletion(c.ClientFactory, imagesCompletion(true)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+		Args:              cobra.ExactArgs(1),
+	})
+	return cmd
+}
+
+type ImageVerify struct {
+	client      ClientFactory
+	Key         string   `usage:"Path or PEM encoded content of the public key to verify the signature with" short:"k" local:"true"`
+	Annotations []string `usage:"Annotations the signature must have, only with --key (format key=value)" short:"a" local:"true"`
+	Rule        []string `usage:"Name of an ImageAllowRule to verify the image against, defaults to all rules of the project (can be repeated)" short:"r" local:"true"`
+	Output      string   `usage:"Output format (json, yaml)" short:"o" local:"true"`
+}
+
+func (a *ImageVerify) Run(cmd *cobra.Command, args []string) error {
+	if a.Key != "" && len(a.Rule) > 0 {
+		return fmt.Errorf("only one of --key and --rule can be set")
+	}
+	if a.Key == "" && len(a.Annotations) > 0 {
+		return fmt.Errorf("--annotation can only be used with --key")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	opts := &client.ImageVerifyOptions{
+		Rules: a.Rule,
+	}
+
+	if a.Key != "" {
+		opts.Key = a.Key
+		// A key that is a local file is sent by content, as the server only accepts PEM encoded keys
+		if !strings.HasPrefix(strings.TrimSpace(a.Key), "-----BEGIN PUBLIC KEY-----") {
+			data, err := os.ReadFile(a.Key)
+			if err != nil {
+				return fmt.Errorf("failed to read key: %w", err)
+			}
+			opts.Key = string(data)
+		}
+
+		annotations, err := parseSignatureAnnotations(a.Annotations)
+		if err != nil {
+			return err
+		}
+		if len(annotations) > 0 {
+			opts.Annotations = v1.SignatureAnnotations{Match: map[string]string{}}
+			for k, v := range annotations {
+				opts.Annotations.Match[k] = v.(string)
+			}
+		}
+	}
+
+	if ref, err := name.ParseReference(args[0]); err == nil {
+		cfg, err := config.ReadCLIConfig()
+		if err != nil {
+			return err
+		}
+
+		creds, err := credentials.NewStore(cfg, c)
+		if err != nil {
+			return err
+		}
+
+		opts.Auth, _, err = creds.Get(cmd.Context(), ref.Context().RegistryStr())
+		if err != nil {
+			return err
+		}
+	}
+
+	result, err := c.ImageVerify(cmd.Context(), args[0], opts)
+	if err != nil {
+		return err
+	}
+
+	if a.Output != "" {
+		w := table.NewWriter(nil, false, a.Output)
+		w.WriteFormatted(result, nil)
+		if err := w.Close(); err != nil {
+			return err
+		}
+	} else {
+		for _, rule := range result.Results {
+			if rule.Allowed {
+				pterm.Success.Printf("%s: %s\n", rule.Rule, rule.Reason)
+			} else {
+				pterm.Error.Printf("%s: %s\n", rule.Rule, rule.Reason)
+			}
+		}
+	}
+
+	if !result.Verified {
+		return fmt.Errorf("verification of image %s (%s) failed: %s", args[0], result.Digest, result.Message)
+	}
+	if a.Output == "" {
+		pterm.Success.Printf("Verified image %s (%s): %s\n", args[0], result.Digest, result.Message)
+	}
+	return nil
+}
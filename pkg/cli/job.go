@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewJob(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&Job{client: c.ClientFactory}, cobra.Command{
+		Use:     "job [flags] [APP_NAME|APP_NAME.JOB_NAME...]",
+		Aliases: []string{"jobs"},
+		Example: `
+# List the runs of all jobs
+acorn job
+
+# List the runs of the jobs of an app
+acorn job my-app
+
+# List the runs of one job
+acorn job my-app.backup`,
+		SilenceUsage:      true,
+		Short:             "List the runs of jobs",
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
+	})
+	cmd.AddCommand(NewJobRun(c))
+	return cmd
+}
+
+type Job struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+// JobRun is a run of a job of an app, as it is printed by acorn job
+type JobRun struct {
+	App       string `json:"app"`
+	Job       string `json:"job"`
+	v1.JobRun `json:",inline"`
+	Duration  string `json:"duration,omitempty"`
+}
+
+func (a *Job) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	apps, err := c.AppList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	out := table.NewWriter(tables.JobRun, a.Quiet, a.Output)
+	for _, app := range apps {
+		for _, job := range typed.Sorted(app.Status.AppStatus.Jobs) {
+			if len(args) > 0 && !slices.Contains(args, app.Name) && !slices.Contains(args, app.Name+"."+job.Key) {
+				continue
+			}
+			for _, run := range job.Value.Runs {
+				out.WriteFormatted(&JobRun{
+					App:      app.Name,
+					Job:      job.Key,
+					JobRun:   run,
+					Duration: runDuration(run, now),
+				}, nil)
+			}
+		}
+	}
+
+	return out.Err()
+}
+
+func runDuration(run v1.JobRun, now time.Time) string {
+	if run.StartTime == nil {
+		return ""
+	}
+	if run.CompletionTime != nil {
+		now = run.CompletionTime.Time
+	}
+	return duration.HumanDuration(now.Sub(run.StartTime.Time))
+}
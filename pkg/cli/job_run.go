@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewJobRun(c CommandContext) *cobra.Command {
+	return cli.Command(&JobRunNow{client: c.ClientFactory}, cobra.Command{
+		Use: "run [flags] APP_NAME.JOB_NAME",
+		Example: `
+# Start a run of the scheduled job backup of the app my-app now
+acorn job run my-app.backup`,
+		SilenceUsage: true,
+		Short:        "Start a run of a scheduled job now",
+		Args:         cobra.ExactArgs(1),
+	})
+}
+
+type JobRunNow struct {
+	client ClientFactory
+}
+
+func (a *JobRunNow) Run(cmd *cobra.Command, args []string) error {
+	i := strings.LastIndex(args[0], ".")
+	if i < 1 || i == len(args[0])-1 {
+		return fmt.Errorf("invalid job [%s], must be APP_NAME.JOB_NAME", args[0])
+	}
+	appName, jobName := args[0][:i], args[0][i+1:]
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	runName, err := c.AppRunJob(cmd.Context(), appName, jobName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(runName)
+	return nil
+}
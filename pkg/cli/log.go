@@ -2,6 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
@@ -12,19 +15,29 @@ import (
 func NewLogs(c CommandContext) *cobra.Command {
 	logs := &Logs{client: c.ClientFactory}
 	return cli.Command(logs, cobra.Command{
-		Use:               "logs [flags] [APP_NAME|CONTAINER_REPLICA_NAME]",
+		Use: "logs [flags] [APP_NAME|CONTAINER_REPLICA_NAME...]",
+		Example: `
+# Follow the errors of two apps that log JSON lines
+acorn logs -f --where level=error frontend backend
+
+# Show the lines of the last hour that contain "timeout" as JSON
+acorn logs --since 1h --grep timeout -o json my-app`,
 		SilenceUsage:      true,
 		Short:             "Log all workloads from an app",
-		Args:              cobra.MaximumNArgs(1),
 		ValidArgsFunction: newCompletion(c.ClientFactory, appsThenContainersCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
 	})
 }
 
 type Logs struct {
-	Follow    bool   `short:"f" usage:"Follow log output"`
-	Since     string `short:"s" usage:"Show logs since timestamp (e.g. 42m for 42 minutes)"`
-	Tail      int64  `short:"n" usage:"Number of lines in log output"`
-	Container string `short:"c" usage:"Container name or Job name within app to follow"`
+	Follow    bool     `short:"f" usage:"Follow log output"`
+	Since     string   `short:"s" usage:"Show logs since timestamp (e.g. 42m for 42 minutes)"`
+	Tail      int64    `short:"n" usage:"Number of lines in log output"`
+	Container string   `short:"c" usage:"Container name or Job name within app to follow"`
+	Until     string   `short:"u" usage:"Show logs until timestamp (e.g. 2006-01-02T15:04:05Z) or relative time (e.g. 10m for 10 minutes ago), can not be used with --follow"`
+	Grep      string   `short:"g" usage:"Only show lines that match the regular expression"`
+	Exclude   string   `short:"x" usage:"Do not show lines that match the regular expression"`
+	Where     []string `short:"w" usage:"Only show JSON log lines with a matching field (e.g. level=error or level!=debug)"`
+	Output    string   `short:"o" usage:"Output format (json)"`
 	client    ClientFactory
 }
 
@@ -51,10 +64,25 @@ func (s *Logs) Run(cmd *cobra.Command, args []string) error {
 	} else {
 		tailLines = &s.Tail
 	}
-	return log.Output(cmd.Context(), c, args[0], &client.LogOptions{
+
+	if s.Output != "" && s.Output != log.OutputFormatJSON {
+		return fmt.Errorf("invalid output format [%s]: only json is supported", s.Output)
+	}
+
+	opts := &client.LogOptions{
 		Follow:    s.Follow,
 		Container: s.Container,
 		Tail:      tailLines,
 		Since:     s.Since,
-	})
+		Until:     s.Until,
+		Grep:      s.Grep,
+		Exclude:   s.Exclude,
+		Where:     s.Where,
+	}
+	// The server applies the filters, they are validated here to fail before connecting
+	if _, err := log.NewFilter((*apiv1.LogOptions)(opts), time.Now()); err != nil {
+		return err
+	}
+
+	return log.OutputApps(cmd.Context(), c, args, s.Output, opts)
 }
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/log"
+	"github.com/acorn-io/runtime/pkg/logsink"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewLogForwarder(c CommandContext) *cobra.Command {
+	return cli.Command(&LogForwarder{}, cobra.Command{
+		Use:          "forward-logs [flags] NAMESPACE",
+		SilenceUsage: true,
+		Hidden:       true,
+		Short:        "Forward the logs of the containers in an app namespace to a log sink",
+		Args:         cobra.ExactArgs(1),
+	})
+}
+
+type LogForwarder struct {
+	Sink      string `usage:"Log sink in JSON" env:"ACORN_LOG_SINK"`
+	VolumeDir string `usage:"Directory the volume of a file log sink is mounted at" default:"/run/acorn/log-sink"`
+}
+
+func (l *LogForwarder) Run(cmd *cobra.Command, args []string) error {
+	var spec v1.LogSink
+	if err := json.Unmarshal([]byte(l.Sink), &spec); err != nil {
+		return fmt.Errorf("invalid log sink: %w", err)
+	}
+
+	sink, err := logsink.New(spec, l.VolumeDir)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := k8sclient.DefaultConfig()
+	if err != nil {
+		return err
+	}
+
+	// Only lines that are logged after the start are forwarded, so lines aren't sent again when the forwarder restarts
+	return logsink.Forward(cmd.Context(), args[0], sink, &log.Options{
+		RestConfig: cfg,
+		Since:      &metav1.Time{Time: time.Now()},
+	})
+}
@@ -107,6 +107,82 @@ func TestLog(t *testing.T) {
 			wantErr: false,
 			wantOut: "",
 		},
+		{
+			name: "acorn logs found found", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"found", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "",
+		},
+		{
+			name: "acorn logs found -o json", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"-o", "json", "--grep", "error", "--where", "level=error", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "",
+		},
+		{
+			name: "acorn logs found -o yaml", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"-o", "yaml", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "invalid output format [yaml]: only json is supported",
+		},
+		{
+			name: "acorn logs found --grep", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--grep", "(", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "invalid grep [(]: error parsing regexp: missing closing ): `(`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
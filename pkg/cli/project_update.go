@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/project"
 	"github.com/spf13/cobra"
@@ -34,6 +35,7 @@ type ProjectUpdate struct {
 	client           ClientFactory
 	DefaultRegion    string   `usage:"Default region for project resources"`
 	SupportedRegions []string `name:"supported-region" usage:"Supported regions for the created project"`
+	LogSink          string   `usage:"Send the logs of all apps in the project that don't have their own log sink to a sink (format http(s)://host/path, secret://name for a URL in the url key of a secret, syslog://host:port or syslog+tcp://host:port), \"none\" removes the log sink"`
 }
 
 func (a *ProjectUpdate) Run(cmd *cobra.Command, args []string) error {
@@ -41,6 +43,22 @@ func (a *ProjectUpdate) Run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if a.LogSink != "" {
+		if projectsDetails[0].Project == nil {
+			return projectsDetails[0].Err
+		}
+		sink, err := parseLogSink(a.LogSink)
+		if err != nil {
+			return err
+		}
+		if sink.File != nil {
+			return fmt.Errorf("file log sinks can only be set on apps")
+		}
+		if *sink == (v1.LogSink{}) {
+			sink = nil
+		}
+		projectsDetails[0].Project.Spec.LogSink = sink
+	}
 	if err := project.Update(cmd.Context(), a.client.Options(), projectsDetails[0], a.DefaultRegion, a.SupportedRegions); err != nil {
 		return err
 	} else {
@@ -1,12 +1,22 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/acorn-io/aml/pkg/cue"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/appdefinition"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/imagesource"
+	"github.com/acorn-io/runtime/pkg/manifests"
+	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 func NewRender(c CommandContext) *cobra.Command {
@@ -14,31 +24,73 @@ func NewRender(c CommandContext) *cobra.Command {
 		Use:          "render [flags] DIRECTORY [acorn args]",
 		SilenceUsage: true,
 		Short:        "Evaluate and display an Acornfile with args",
+		Long: "Evaluate and display an Acornfile with args. With --manifests the Kubernetes objects Acorn creates for the app are " +
+			"rendered instead, without a cluster. The images of containers built from source are build://CONTAINER. " +
+			"The values of generated token and basic secrets are derived from --seed, so they are the same every time " +
+			"the app is rendered with the same seed. The seed is required if the app has generated secrets and anyone " +
+			"who knows it can derive their values, so keep it secret. Nested acorns are not rendered.",
+		Example: `# Render the Acornfile in the current directory
+  acorn render .
+
+  # Render the Kubernetes manifests of the app in the current directory
+  acorn render --manifests .
+
+  # Render the manifests of an app named blog, including its NetworkPolicies
+  acorn render --manifests --name blog --network-policies .
+
+  # Render the manifests with all ports published, like acorn run -P
+  acorn render --manifests -P .
+
+  # Render the manifests of an app with generated secrets, reading the seed from the environment
+  ACORN_RENDER_SEED=$(cat seed.txt) acorn render --manifests .`,
 	})
 	cmd.Flags().SetInterspersed(false)
 	return cmd
 }
 
 type Render struct {
-	File    string   `short:"f" usage:"Name of the dev file (default \"DIRECTORY/Acornfile\")"`
-	Profile []string `usage:"Profile to assign default values"`
-	Output  string   `usage:"Output in JSON or YAML" default:"aml" short:"o"`
-	client  ClientFactory
+	File            string   `short:"f" usage:"Name of the dev file (default \"DIRECTORY/Acornfile\")"`
+	Profile         []string `usage:"Profile to assign default values"`
+	Output          string   `usage:"Output in JSON or YAML" default:"aml" short:"o"`
+	Manifests       bool     `usage:"Render the Kubernetes manifests of the app instead of the app definition"`
+	Name            string   `usage:"Name of the app to render the manifests for" default:"app" short:"n"`
+	TargetNamespace string   `usage:"Namespace of the rendered manifests (default: the name of the app)"`
+	NetworkPolicies bool     `usage:"Render the NetworkPolicies of the app with the manifests"`
+	PublishAll      *bool    `usage:"Publish all (true) or none (false) of the defined ports of application" short:"P"`
+	Publish         []string `usage:"Publish port of application (format [public:]private) (ex 81:80)" short:"p"`
+	Seed            string   `usage:"Secret seed the values of generated secrets are derived from" env:"ACORN_RENDER_SEED"`
+	client          ClientFactory
 }
 
 func (s *Render) Run(cmd *cobra.Command, args []string) error {
-	c, err := s.client.CreateDefault()
+	imageAndArgs := imagesource.NewImageSource(s.File, args, s.Profile, nil)
+
+	image, file, err := imageAndArgs.ResolveImageAndFile()
 	if err != nil {
 		return err
 	}
 
-	imageAndArgs := imagesource.NewImageSource(s.File, args, s.Profile, nil)
+	// The client is only needed to look up images, Acornfiles are rendered without a cluster
+	var c client.Client
+	if file == "" {
+		c, err = s.client.CreateDefault()
+		if err != nil {
+			return err
+		}
+	}
 
 	appDef, _, err := imageAndArgs.GetAppDefinition(cmd.Context(), c)
 	if err != nil {
 		return err
 	}
 
+	if s.Manifests {
+		if file != "" {
+			image = ""
+		}
+		return s.renderManifests(cmd.Context(), image, appDef)
+	}
+
 	var v string
 	switch s.Output {
 	case "yaml":
@@ -65,3 +117,78 @@ func (s *Render) Run(cmd *cobra.Command, args []string) error {
 	fmt.Print(v)
 	return nil
 }
+
+func (s *Render) renderManifests(ctx context.Context, image string, appDef *appdefinition.AppDefinition) error {
+	appSpec, err := appDef.AppSpec()
+	if err != nil {
+		return err
+	}
+
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.Name,
+			Namespace: system.DefaultUserNamespace,
+		},
+		Spec: v1.AppInstanceSpec{
+			Image:           image,
+			Profiles:        s.Profile,
+			TargetNamespace: s.TargetNamespace,
+		},
+		Status: v1.AppInstanceStatus{
+			AppImage: v1.AppImage{
+				ID: image,
+			},
+			AppSpec: *appSpec,
+		},
+	}
+	if app.Spec.TargetNamespace == "" {
+		app.Spec.TargetNamespace = s.Name
+	}
+
+	app.Spec.Publish, err = v1.ParsePortBindings(s.Publish)
+	if err != nil {
+		return err
+	}
+	if s.PublishAll != nil && *s.PublishAll {
+		app.Spec.PublishMode = v1.PublishModeAll
+	} else if s.PublishAll != nil && !*s.PublishAll {
+		app.Spec.PublishMode = v1.PublishModeNone
+	}
+
+	objs, err := manifests.Render(ctx, app, manifests.Options{
+		Config: &apiv1.Config{
+			NetworkPolicies: &s.NetworkPolicies,
+		},
+		Seed: s.Seed,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch s.Output {
+	case "aml", "yaml":
+		for i, obj := range objs {
+			data, err := yaml.Marshal(obj)
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				fmt.Println("---")
+			}
+			fmt.Print(string(data))
+		}
+	case "json":
+		data, err := json.MarshalIndent(map[string]any{
+			"apiVersion": "v1",
+			"kind":       "List",
+			"items":      objs,
+		}, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	default:
+		return fmt.Errorf("unsupported output format %s", s.Output)
+	}
+	return nil
+}
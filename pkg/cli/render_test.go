@@ -69,6 +69,44 @@ func TestRender(t *testing.T) {
 			wantErr: false,
 			wantOut: "./testdata/render/render_test.txt",
 		},
+		{
+			name: "acorn render --manifests .", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--manifests", "./testdata/render/"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "./testdata/render/render_manifests_test.txt",
+		},
+		{
+			name: "acorn render --manifests -P=false .", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--manifests", "-P=false", "./testdata/render/"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "./testdata/render/render_manifests_unpublished_test.txt",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
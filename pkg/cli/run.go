@@ -125,8 +125,8 @@ More Usages:
      - Bind the acorn volume named "mydata" into the current app, replacing the volume named "data", See "acorn volumes --help for more info"
         acorn run --volume mydata:data .`
 
-var hideRunFlags = []string{"dangerous", "memory", "target-namespace", "secret", "volume", "region", "publish-all",
-	"publish", "link", "label", "interval", "env", "compute-class", "annotation", "update", "replace"}
+var hideRunFlags = []string{"dangerous", "memory", "cpu", "target-namespace", "secret", "volume", "region", "publish-all",
+	"publish", "link", "label", "interval", "env", "compute-class", "annotation", "update", "replace", "log-sink"}
 
 type Run struct {
 	RunArgs
@@ -166,6 +166,11 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 		return opts, err
 	}
 
+	opts.CPU, err = v1.ParseCPU(s.CPU)
+	if err != nil {
+		return opts, err
+	}
+
 	opts.ComputeClasses, err = v1.ParseComputeClass(s.ComputeClass)
 	if err != nil {
 		return opts, err
@@ -203,6 +208,11 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 		return opts, err
 	}
 
+	opts.LogSink, err = parseLogSink(s.LogSink)
+	if err != nil {
+		return opts, err
+	}
+
 	if s.PublishAll != nil && *s.PublishAll {
 		opts.PublishMode = v1.PublishModeAll
 	} else if s.PublishAll != nil && !*s.PublishAll {
@@ -212,6 +222,14 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 	return opts, nil
 }
 
+// parseLogSink parses the log sink flag, "none" returns an empty log sink that removes the log sink of the app
+func parseLogSink(s string) (*v1.LogSink, error) {
+	if s == "none" {
+		return &v1.LogSink{}, nil
+	}
+	return v1.ParseLogSink(s)
+}
+
 func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 	if s.HelpAdvanced {
 		setAdvancedHelp(cmd, hideRunFlags, AdvancedHelp)
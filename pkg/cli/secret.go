@@ -22,6 +22,8 @@ acorn secret`,
 	cmd.AddCommand(NewSecretDelete(c))
 	cmd.AddCommand(NewSecretReveal(c))
 	cmd.AddCommand(NewSecretEncrypt(c))
+	cmd.AddCommand(NewSecretDecrypt(c))
+	cmd.AddCommand(NewSecretRotate(c))
 	return cmd
 }
 
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/encryption"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/spf13/cobra"
+)
+
+func NewSecretDecrypt(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&Decrypt{}, cobra.Command{
+		Use: "decrypt [flags] STRING",
+		Example: `
+# Verify a value of an Acornfile that was encrypted for an age recipient
+acorn secret decrypt --key ~/.config/age/key.txt ACORNENC:...
+
+# Decrypt with a PGP private key
+acorn secret decrypt --key ./ops-private.asc ACORNENC:...`,
+		SilenceUsage: true,
+		Short:        "Decrypt a string that was encrypted for an age or PGP key",
+		Args:         cobra.MaximumNArgs(1),
+	})
+	return cmd
+}
+
+type Decrypt struct {
+	CiphertextStdin bool   `usage:"Take the encrypted string from stdin"`
+	Key             string `usage:"File with an age identity or PGP private key" short:"k"`
+}
+
+func (d *Decrypt) Run(cmd *cobra.Command, args []string) error {
+	if d.Key == "" {
+		return fmt.Errorf("--key is required")
+	}
+
+	if d.CiphertextStdin && len(args) > 0 {
+		return fmt.Errorf("no args can be provided if using stdin")
+	} else if d.CiphertextStdin {
+		contents, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		args = append(args, string(contents))
+	} else if len(args) == 0 {
+		return fmt.Errorf("an encrypted string or --ciphertext-stdin is required")
+	}
+
+	ciphertext := strings.TrimSpace(args[0])
+	if !nacl.IsAcornEncryptedData([]byte(ciphertext)) {
+		return fmt.Errorf("the string is not encrypted, it must start with %s", nacl.EncPrefix)
+	}
+
+	key, err := os.ReadFile(d.Key)
+	if err != nil {
+		return err
+	}
+
+	identities, err := encryption.ParseIdentities(key, func() ([]byte, error) {
+		var passphrase string
+		err := survey.AskOne(&survey.Password{Message: "Passphrase of " + d.Key}, &passphrase)
+		return []byte(passphrase), err
+	})
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := encryption.Decrypt([]byte(ciphertext), identities)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(plaintext))
+	return nil
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
-	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/encryption"
+	"github.com/acorn-io/runtime/pkg/project"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -20,9 +22,19 @@ const (
 
 func NewSecretEncrypt(c CommandContext) *cobra.Command {
 	cmd := cli.Command(&Encrypt{client: c.ClientFactory}, cobra.Command{
-		Use:          "encrypt [flags] STRING",
+		Use: "encrypt [flags] STRING",
+		Example: `
+# Encrypt for the current project
+acorn secret encrypt my-password
+
+# Encrypt for the staging and prod projects and an offline age key, so the same Acornfile deploys to both
+acorn secret encrypt --for-project staging --for-project prod --public-key age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p my-password
+
+# Encrypt for a PGP key
+acorn secret encrypt --pgp-key ./ops.asc my-password`,
 		SilenceUsage: true,
 		Short:        "Encrypt string information with clusters public key",
+		Long:         "Encrypt string information with the public keys of one or more projects, age recipients and PGP keys. Any of the recipients can decrypt the result.",
 		Args:         cobra.MaximumNArgs(1),
 	})
 	return cmd
@@ -30,7 +42,9 @@ func NewSecretEncrypt(c CommandContext) *cobra.Command {
 
 type Encrypt struct {
 	PlaintextStdin bool     `usage:"Take the plaintext from stdin"`
-	PublicKey      []string `usage:"Pass one or more cluster publicKey values"`
+	PublicKey      []string `usage:"Pass one or more cluster publicKey values or age recipients (age1...)"`
+	PGPKey         []string `name:"pgp-key" usage:"Pass one or more files with PGP public keys"`
+	ForProject     []string `usage:"Encrypt for the public keys of one or more projects"`
 	client         ClientFactory
 }
 
@@ -38,10 +52,6 @@ func (e *Encrypt) Run(cmd *cobra.Command, args []string) error {
 	out := table.NewWriter([][]string{
 		{"Name", "{{.}}"},
 	}, true, "")
-	c, err := e.client.CreateDefault()
-	if err != nil {
-		return err
-	}
 
 	if e.PlaintextStdin && len(args) == 0 {
 		contents, err := io.ReadAll(os.Stdin)
@@ -77,26 +87,50 @@ func (e *Encrypt) Run(cmd *cobra.Command, args []string) error {
 
 	args = append(args, plaintext)
 
-	if len(e.PublicKey) == 0 {
-		fullInfo, err := c.Info(cmd.Context())
+	recipients := encryption.Recipients{
+		PublicKeys: e.PublicKey,
+	}
+
+	for _, file := range e.PGPKey {
+		data, err := os.ReadFile(file)
 		if err != nil {
 			return err
 		}
-		for _, info := range fullInfo {
-			for _, region := range info.Regions {
-				for _, key := range region.PublicKeys {
-					e.PublicKey = append(e.PublicKey, key.KeyID)
-				}
-			}
+		entities, err := encryption.ReadPGPKeys(data)
+		if err != nil {
+			return fmt.Errorf("reading PGP keys from %s: %w", file, err)
 		}
+		recipients.PGPKeys = append(recipients.PGPKeys, entities...)
 	}
 
-	encData, err := nacl.MultipleKeyEncrypt(args[0], e.PublicKey)
-	if err != nil {
-		return err
+	for _, projectName := range e.ForProject {
+		opts := e.client.Options()
+		opts.Project = projectName
+		opts.AllProjects = false
+		pc, err := project.Client(cmd.Context(), opts)
+		if err != nil {
+			return err
+		}
+		keys, err := publicKeys(cmd, pc)
+		if err != nil {
+			return fmt.Errorf("getting public keys of project %s: %w", projectName, err)
+		}
+		recipients.PublicKeys = append(recipients.PublicKeys, keys...)
 	}
 
-	output, err := encData.Marshal()
+	if len(e.PublicKey) == 0 && len(e.PGPKey) == 0 && len(e.ForProject) == 0 {
+		c, err := e.client.CreateDefault()
+		if err != nil {
+			return err
+		}
+		keys, err := publicKeys(cmd, c)
+		if err != nil {
+			return err
+		}
+		recipients.PublicKeys = keys
+	}
+
+	output, err := encryption.Encrypt(args[0], recipients)
 	if err != nil {
 		return err
 	}
@@ -105,3 +139,18 @@ func (e *Encrypt) Run(cmd *cobra.Command, args []string) error {
 
 	return out.Err()
 }
+
+func publicKeys(cmd *cobra.Command, c client.Client) (result []string, _ error) {
+	fullInfo, err := c.Info(cmd.Context())
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range fullInfo {
+		for _, region := range info.Regions {
+			for _, key := range region.PublicKeys {
+				result = append(result, key.KeyID)
+			}
+		}
+	}
+	return result, nil
+}
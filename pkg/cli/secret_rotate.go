@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewSecretRotate(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&SecretRotate{client: c.ClientFactory}, cobra.Command{
+		Use: "rotate [SECRET_NAME...]",
+		Example: `
+acorn secret rotate my-app.db-password`,
+		SilenceUsage:      true,
+		Short:             "Rotate a generated or token secret",
+		Long:              "Rotate a generated or token secret. The previous value is kept under the previous key for the grace period of the secret and containers that use the secret are redeployed unless they reference it with onchange=no-action.",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, secretsCompletion).complete,
+	})
+	return cmd
+}
+
+type SecretRotate struct {
+	client ClientFactory
+}
+
+func (a *SecretRotate) Run(cmd *cobra.Command, args []string) error {
+	client, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range args {
+		if _, err := client.SecretRotate(cmd.Context(), secret); err != nil {
+			return fmt.Errorf("rotating %s: %w", secret, err)
+		}
+		fmt.Println(secret)
+	}
+
+	return nil
+}
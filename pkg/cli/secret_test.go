@@ -164,6 +164,44 @@ func TestSecret(t *testing.T) {
 			wantErr: true,
 			wantOut: "Error: No such secret: dne\n",
 		},
+		{
+			name: "acorn secret rotate found.secret", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"rotate", "found.secret"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "found.secret\n",
+		},
+		{
+			name: "acorn secret rotate dne", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader("y\n"),
+			},
+			args: args{
+				args:   []string{"rotate", "dne"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "rotating dne: error: Secret dne does not exist",
+		},
 		{
 			name: "acorn secret reveal found.secret", fields: fields{
 				All:    false,
@@ -221,6 +259,25 @@ func TestSecret(t *testing.T) {
 			wantErr: false,
 			wantOut: "ACORNENC:e30::\n",
 		},
+		{
+			name: "acorn secret decrypt without key", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"decrypt", "ACORNENC:e30::"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "--key is required",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -156,6 +156,18 @@ func (m *MockClient) AppIgnoreDeleteCleanup(ctx context.Context, name string) er
 	return nil
 }
 
+func (m *MockClient) AppPromote(ctx context.Context, name string, opts *client.AppPromoteOptions) error {
+	return nil
+}
+
+func (m *MockClient) AppRollback(ctx context.Context, name string, opts *client.AppRollbackOptions) error {
+	return nil
+}
+
+func (m *MockClient) AppRunJob(ctx context.Context, name, jobName string) (string, error) {
+	return jobName + "-manual-1", nil
+}
+
 func (m *MockClient) AppGet(ctx context.Context, name string) (*apiv1.App, error) {
 	if m.AppItem != nil {
 		return m.AppItem, nil
@@ -390,6 +402,22 @@ func (m *MockClient) SecretUpdate(ctx context.Context, name string, data map[str
 	return nil, nil
 }
 
+func (m *MockClient) SecretRotate(ctx context.Context, name string) (*apiv1.Secret, error) {
+	if m.SecretItem != nil {
+		return m.SecretItem, nil
+	}
+	switch name {
+	case "dne":
+		return nil, fmt.Errorf("error: Secret %s does not exist", name)
+	case "found.secret":
+		return &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "found.secret"},
+			Type:       "token",
+		}, nil
+	}
+	return nil, nil
+}
+
 func (m *MockClient) SecretDelete(ctx context.Context, name string) (*apiv1.Secret, error) {
 	if m.SecretItem != nil {
 		return m.SecretItem, nil
@@ -536,6 +564,35 @@ func (m *MockClient) VolumeDelete(ctx context.Context, name string) (*apiv1.Volu
 	return nil, nil
 }
 
+func (m *MockClient) VolumeArchive(ctx context.Context, name string, opts *client.VolumeArchiveOptions) (*term.ExecIO, error) {
+	return nil, nil
+}
+
+func (m *MockClient) VolumeSnapshotCreate(ctx context.Context, volumeName string, opts *client.VolumeSnapshotCreateOptions) (*apiv1.VolumeSnapshot, error) {
+	snapshot := &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "snapshot",
+		},
+	}
+	if opts != nil && opts.Name != "" {
+		snapshot.Name = opts.Name
+	}
+	snapshot.Spec.VolumeName = volumeName
+	return snapshot, nil
+}
+
+func (m *MockClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return nil, nil
+}
+
+func (m *MockClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return nil, nil
+}
+
+func (m *MockClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return nil, nil
+}
+
 func (m *MockClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	if m.Images != nil {
 		return m.Images, nil
@@ -655,6 +712,41 @@ func (m *MockClient) ImageDetails(ctx context.Context, imageName string, opts *c
 	}, nil
 }
 
+func (m *MockClient) ImageSign(ctx context.Context, imageName string, payload []byte, signatureB64, publicKey string) (*apiv1.ImageSign, error) {
+	return &apiv1.ImageSign{
+		ObjectMeta:      metav1.ObjectMeta{Name: imageName},
+		SignatureDigest: "sha256:1234567890",
+	}, nil
+}
+
+func (m *MockClient) ImageVerify(ctx context.Context, imageName string, opts *client.ImageVerifyOptions) (*apiv1.ImageVerify, error) {
+	switch imageName {
+	case "dne":
+		return nil, fmt.Errorf("error: No such image: %s", imageName)
+	case "unsigned":
+		return &apiv1.ImageVerify{
+			ObjectMeta: metav1.ObjectMeta{Name: imageName},
+			Digest:     "sha256:1234567890",
+			Message:    "image <unsigned> is not allowed by any ImageAllowRule in this project",
+			Results: []apiv1.ImageAllowRuleResult{{
+				Rule:   "signed",
+				Reason: "signatures.rules.0: no signatures found",
+			}},
+		}, nil
+	}
+	return &apiv1.ImageVerify{
+		ObjectMeta: metav1.ObjectMeta{Name: imageName},
+		Digest:     "sha256:1234567890",
+		Verified:   true,
+		Message:    "image is allowed by ImageAllowRule signed",
+		Results: []apiv1.ImageAllowRuleResult{{
+			Rule:    "signed",
+			Allowed: true,
+			Reason:  "image is in scope of the rule and all signature rules passed",
+		}},
+	}, nil
+}
+
 func (m *MockClient) BuilderCreate(ctx context.Context) (*apiv1.Builder, error) { return nil, nil }
 
 func (m *MockClient) BuilderGet(ctx context.Context) (*apiv1.Builder, error) { return nil, nil }
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/runtime/pkg/backup"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewVolumeBackup(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolumeBackup{client: c.ClientFactory}, cobra.Command{
+		Use: "backup [flags] VOLUME_NAME",
+		Example: `
+# Write a tar archive of the volume to the local directory ./backups
+acorn volume backup --to ./backups my-app.data
+
+# Upload a tar archive of the volume to S3, credentials are read from the standard AWS environment and config files
+acorn volume backup --to s3://my-bucket/backups/ my-app.data
+
+# Upload to an S3-compatible service
+acorn volume backup --to https://minio.example.com/my-bucket/data.tar my-app.data`,
+		SilenceUsage:      true,
+		Short:             "Backup the contents of a volume to a local directory or S3-compatible storage",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	return cmd
+}
+
+type VolumeBackup struct {
+	To     string `usage:"Destination of the backup: a directory, a file, \"-\" for stdout, s3://BUCKET/KEY or an S3-compatible https://HOST/BUCKET/KEY URL"`
+	Path   string `usage:"Directory inside the volume to backup, defaults to the whole volume"`
+	client ClientFactory
+}
+
+func (a *VolumeBackup) Run(cmd *cobra.Command, args []string) error {
+	if a.To == "" {
+		return fmt.Errorf("--to is required")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	exec, err := c.VolumeArchive(cmd.Context(), args[0], &client.VolumeArchiveOptions{
+		Path: a.Path,
+	})
+	if err != nil {
+		return err
+	}
+
+	stderr, stderrDone := &bytes.Buffer{}, make(chan struct{})
+	go func() {
+		defer close(stderrDone)
+		_, _ = io.Copy(stderr, exec.Stderr)
+	}()
+
+	// exited waits for tar, the backup is only written to its destination if the archive is complete
+	exited := func() error {
+		exit := <-exec.ExitCode
+		<-stderrDone
+		if exit.Err != nil {
+			return fmt.Errorf("archiving volume %s: %w: %s", args[0], exit.Err, stderr.String())
+		} else if exit.Code != 0 {
+			return fmt.Errorf("archiving volume %s failed with exit code %d: %s", args[0], exit.Code, stderr.String())
+		}
+		return nil
+	}
+
+	var location string
+	if a.To == "-" {
+		if _, err := io.Copy(os.Stdout, exec.Stdout); err != nil {
+			return err
+		}
+		err = exited()
+	} else {
+		name := fmt.Sprintf("%s-%s.tar", strings.ReplaceAll(args[0], "/", "-"), time.Now().UTC().Format("20060102T150405Z"))
+		location, err = backup.Write(cmd.Context(), exec.Stdout, a.To, name, exited)
+	}
+	if err != nil {
+		return err
+	}
+
+	if location != "" {
+		fmt.Println(location)
+	}
+	return nil
+}
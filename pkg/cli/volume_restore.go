@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewVolumeRestore(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolumeRestore{client: c.ClientFactory}, cobra.Command{
+		Use: "restore [flags] SNAPSHOT_NAME",
+		Example: `
+# Restore a snapshot to the volume it was taken from
+acorn volume restore my-snapshot
+
+# Restore a snapshot to a different volume of the app
+acorn volume restore --volume data-copy my-snapshot`,
+		SilenceUsage:      true,
+		Short:             "Restore a volume from a snapshot",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumeSnapshotsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	return cmd
+}
+
+type VolumeRestore struct {
+	Volume string `usage:"Name of the volume in the app to restore, defaults to the volume the snapshot was taken from"`
+	client ClientFactory
+}
+
+func (a *VolumeRestore) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := c.VolumeSnapshotGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+	if !snapshot.Status.ReadyToUse {
+		return fmt.Errorf("volume snapshot %s is not ready", snapshot.Name)
+	}
+
+	target := a.Volume
+	if target == "" {
+		target = snapshot.Status.VolumeName
+	}
+
+	// Names are prefixed with the project when not in the default project
+	project, snapshotName := "", snapshot.Name
+	if i := strings.LastIndex(snapshotName, "/"); i != -1 {
+		project, snapshotName = snapshotName[:i+1], snapshotName[i+1:]
+	}
+
+	app, err := c.AppGet(cmd.Context(), project+snapshot.Status.AppName)
+	if err != nil {
+		return err
+	}
+
+	binding := v1.VolumeBinding{
+		Target: target,
+	}
+	for _, existing := range app.Spec.Volumes {
+		if existing.Target == target {
+			if existing.Volume != "" {
+				return fmt.Errorf("volume %s of app %s is bound to existing volume %s and can not be restored", target, app.Name, existing.Volume)
+			}
+			binding = existing
+		}
+	}
+	binding.From = v1.SnapshotFromPrefix + snapshotName
+
+	if _, err := c.AppUpdate(cmd.Context(), app.Name, &client.AppUpdateOptions{
+		Volumes: []v1.VolumeBinding{binding},
+	}); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s.%s\n", app.Name, target)
+	return nil
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewVolumeSnapshot(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolumeSnapshot{client: c.ClientFactory}, cobra.Command{
+		Use:     "snapshot [flags] [SNAPSHOT_NAME...]",
+		Aliases: []string{"snapshots"},
+		Example: `
+acorn volume snapshot`,
+		SilenceUsage:      true,
+		Short:             "Manage volume snapshots",
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumeSnapshotsCompletion).complete,
+	})
+	cmd.AddCommand(NewVolumeSnapshotCreate(c))
+	cmd.AddCommand(NewVolumeSnapshotDelete(c))
+	return cmd
+}
+
+type VolumeSnapshot struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *VolumeSnapshot) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.VolumeSnapshot, a.Quiet, a.Output)
+
+	if len(args) == 1 {
+		snapshot, err := c.VolumeSnapshotGet(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		out.Write(snapshot)
+		return out.Err()
+	}
+
+	snapshots, err := c.VolumeSnapshotList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots {
+		if len(args) == 0 || slices.Contains(args, snapshot.Name) {
+			out.Write(&snapshot)
+		}
+	}
+
+	return out.Err()
+}
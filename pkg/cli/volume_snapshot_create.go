@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+func NewVolumeSnapshotCreate(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolumeSnapshotCreate{client: c.ClientFactory}, cobra.Command{
+		Use: "create [flags] VOLUME_NAME",
+		Example: `
+# Snapshot the "data" volume of the app "my-app"
+acorn volume snapshot create my-app.data
+
+# Snapshot a volume with a specific snapshot name
+acorn volume snapshot create --name nightly my-app.data`,
+		SilenceUsage:      true,
+		Short:             "Create a snapshot of a volume",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	return cmd
+}
+
+type VolumeSnapshotCreate struct {
+	Name   string `usage:"Name of the snapshot, generated if not set" short:"n"`
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotCreate) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := c.VolumeSnapshotCreate(cmd.Context(), args[0], &client.VolumeSnapshotCreateOptions{
+		Name: a.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(snapshot.Name)
+	return nil
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewVolumeSnapshotDelete(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolumeSnapshotDelete{client: c.ClientFactory}, cobra.Command{
+		Use:               "rm [SNAPSHOT_NAME...]",
+		Example:           `acorn volume snapshot rm my-snapshot`,
+		SilenceUsage:      true,
+		Short:             "Delete a volume snapshot",
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumeSnapshotsCompletion).complete,
+	})
+	return cmd
+}
+
+type VolumeSnapshotDelete struct {
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotDelete) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range args {
+		deleted, err := c.VolumeSnapshotDelete(cmd.Context(), snapshot)
+		if err != nil {
+			return fmt.Errorf("deleting %s: %w", snapshot, err)
+		}
+		if deleted != nil {
+			fmt.Println(snapshot)
+		} else {
+			fmt.Printf("Error: No such volume snapshot: %s\n", snapshot)
+		}
+	}
+
+	return nil
+}
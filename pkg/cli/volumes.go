@@ -19,6 +19,9 @@ acorn volume`,
 		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).complete,
 	})
 	cmd.AddCommand(NewVolumeDelete(c))
+	cmd.AddCommand(NewVolumeSnapshot(c))
+	cmd.AddCommand(NewVolumeRestore(c))
+	cmd.AddCommand(NewVolumeBackup(c))
 	return cmd
 }
 
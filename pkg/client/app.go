@@ -14,6 +14,7 @@ import (
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/imageallowrules"
 	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/run"
 	"github.com/acorn-io/runtime/pkg/scheme"
@@ -66,7 +67,9 @@ func ToApp(namespace, image string, opts *AppRunOptions) *apiv1.App {
 			NotifyUpgrade:       opts.NotifyUpgrade,
 			AutoUpgradeInterval: opts.AutoUpgradeInterval,
 			Memory:              opts.Memory,
+			CPU:                 opts.CPU,
 			ComputeClasses:      opts.ComputeClasses,
+			LogSink:             logSink(opts.LogSink),
 		},
 	}
 }
@@ -127,6 +130,7 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 		nApp.Name = app.Name
 		nApp.ObjectMeta.UID = app.ObjectMeta.UID
 		nApp.ObjectMeta.ResourceVersion = app.ObjectMeta.ResourceVersion
+		setChangeCause(nApp, opts.ChangeCause)
 		return nApp, nil
 	}
 
@@ -139,9 +143,14 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 	app.Spec.Environment = mergeEnv(app.Spec.Environment, opts.Env)
 	app.Spec.Labels = mergeLabels(app.Spec.Labels, opts.Labels)
 	app.Spec.Annotations = mergeLabels(app.Spec.Annotations, opts.Annotations)
-	app.Spec.DeployArgs = typed.Concat(app.Spec.DeployArgs, opts.DeployArgs)
-	if len(opts.Profiles) > 0 {
+	if opts.ReplaceDeployArgs {
+		app.Spec.DeployArgs = opts.DeployArgs
 		app.Spec.Profiles = opts.Profiles
+	} else {
+		app.Spec.DeployArgs = typed.Concat(app.Spec.DeployArgs, opts.DeployArgs)
+		if len(opts.Profiles) > 0 {
+			app.Spec.Profiles = opts.Profiles
+		}
 	}
 	if opts.Stop != nil {
 		app.Spec.Stop = opts.Stop
@@ -167,16 +176,43 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 	if len(opts.Memory) != 0 {
 		app.Spec.Memory = opts.Memory
 	}
+	if len(opts.CPU) != 0 {
+		app.Spec.CPU = opts.CPU
+	}
 	if len(opts.ComputeClasses) != 0 {
 		app.Spec.ComputeClasses = opts.ComputeClasses
 	}
 	if opts.Region != "" {
 		app.Spec.Region = opts.Region
 	}
+	if opts.LogSink != nil {
+		app.Spec.LogSink = logSink(opts.LogSink)
+	}
+	setChangeCause(app, opts.ChangeCause)
 
 	return app, nil
 }
 
+// logSink returns nil for an empty log sink, which is used to remove the log sink of an app
+func logSink(sink *v1.LogSink) *v1.LogSink {
+	if sink == nil || *sink == (v1.LogSink{}) {
+		return nil
+	}
+	return sink
+}
+
+// setChangeCause sets the change cause annotation of the app, or removes the one of a previous update
+func setChangeCause(app *apiv1.App, cause string) {
+	if cause == "" {
+		delete(app.Annotations, labels.AcornAppChangeCause)
+		return
+	}
+	if app.Annotations == nil {
+		app.Annotations = map[string]string{}
+	}
+	app.Annotations[labels.AcornAppChangeCause] = cause
+}
+
 func (c *DefaultClient) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	devSession := &apiv1.DevSession{}
 	if err := c.Client.Get(ctx, router.Key(c.Namespace, name), devSession); err != nil {
@@ -610,3 +646,44 @@ func (c *DefaultClient) AppIgnoreDeleteCleanup(ctx context.Context, name string)
 		SubResource("ignorecleanup").
 		Body(&apiv1.IgnoreCleanup{}).Do(ctx).Error()
 }
+
+func (c *DefaultClient) AppPromote(ctx context.Context, name string, opts *AppPromoteOptions) error {
+	if opts == nil {
+		opts = &AppPromoteOptions{}
+	}
+	return c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("apps").
+		Name(name).
+		SubResource("promote").
+		Body(&apiv1.AppPromote{
+			Full: opts.Full,
+		}).Do(ctx).Error()
+}
+
+func (c *DefaultClient) AppRollback(ctx context.Context, name string, opts *AppRollbackOptions) error {
+	if opts == nil {
+		opts = &AppRollbackOptions{}
+	}
+	return c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("apps").
+		Name(name).
+		SubResource("rollback").
+		Body(&apiv1.AppRollback{
+			Revision: opts.Revision,
+		}).Do(ctx).Error()
+}
+
+func (c *DefaultClient) AppRunJob(ctx context.Context, name, jobName string) (string, error) {
+	result := &apiv1.AppRunJob{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("apps").
+		Name(name).
+		SubResource("runjob").
+		Body(&apiv1.AppRunJob{
+			JobName: jobName,
+		}).Do(ctx).Into(result)
+	return result.RunName, err
+}
@@ -60,6 +60,14 @@ func (c *DefaultClient) AcornImageBuild(ctx context.Context, file string, opts *
 	}
 	opts.BuilderName = builder.Name
 
+	var cache *v1.BuildCache
+	if len(opts.CacheFrom) > 0 || opts.CacheTo != "" {
+		cache = &v1.BuildCache{
+			From: opts.CacheFrom,
+			To:   opts.CacheTo,
+		}
+	}
+
 	build := &apiv1.AcornImageBuild{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: "bld-",
@@ -72,6 +80,9 @@ func (c *DefaultClient) AcornImageBuild(ctx context.Context, file string, opts *
 			Args:        opts.Args,
 			Profiles:    opts.Profiles,
 			VCS:         vcs,
+			SBOM:        opts.SBOM,
+			Cache:       cache,
+			Reuse:       opts.Reuse,
 		},
 	}
 
@@ -100,9 +100,25 @@ type AppUpdateOptions struct {
 	NotifyUpgrade       *bool
 	AutoUpgradeInterval string
 	Memory              v1.MemoryMap
+	CPU                 v1.CPUMap
 	ComputeClasses      v1.ComputeClassMap
 	Region              string
 	DevSessionClient    *v1.DevSessionInstanceClient
+	// ChangeCause is recorded as the trigger of the revision created by the update, like "rollback"
+	ChangeCause string
+	// ReplaceDeployArgs replaces the deploy args and profiles of the app instead of merging them
+	ReplaceDeployArgs bool
+	// LogSink replaces the log sink of the app, an empty log sink removes it
+	LogSink *v1.LogSink
+}
+
+type AppPromoteOptions struct {
+	Full bool
+}
+
+type AppRollbackOptions struct {
+	// Revision is the revision of the app history to go back to, the rollout in progress is aborted if it is not set
+	Revision int64
 }
 
 type LogOptions apiv1.LogOptions
@@ -127,7 +143,9 @@ type AppRunOptions struct {
 	NotifyUpgrade       *bool
 	AutoUpgradeInterval string
 	Memory              v1.MemoryMap
+	CPU                 v1.CPUMap
 	ComputeClasses      v1.ComputeClassMap
+	LogSink             *v1.LogSink
 }
 
 func (a AppRunOptions) ToUpdate() AppUpdateOptions {
@@ -149,7 +167,9 @@ func (a AppRunOptions) ToUpdate() AppUpdateOptions {
 		NotifyUpgrade:       a.NotifyUpgrade,
 		AutoUpgradeInterval: a.AutoUpgradeInterval,
 		Memory:              a.Memory,
+		CPU:                 a.CPU,
 		ComputeClasses:      a.ComputeClasses,
+		LogSink:             a.LogSink,
 		Region:              a.Region,
 	}
 }
@@ -172,7 +192,9 @@ func (a AppUpdateOptions) ToRun() AppRunOptions {
 		NotifyUpgrade:       a.NotifyUpgrade,
 		AutoUpgradeInterval: a.AutoUpgradeInterval,
 		Memory:              a.Memory,
+		CPU:                 a.CPU,
 		ComputeClasses:      a.ComputeClasses,
+		LogSink:             a.LogSink,
 	}
 }
 
@@ -183,10 +205,11 @@ type ImageProgress struct {
 }
 
 type ImageDetails struct {
-	AppImage   v1.AppImage   `json:"appImage,omitempty"`
-	AppSpec    *v1.AppSpec   `json:"appSpec,omitempty"`
-	Params     *v1.ParamSpec `json:"params,omitempty"`
-	ParseError string        `json:"parseError,omitempty"`
+	AppImage   v1.AppImage       `json:"appImage,omitempty"`
+	AppSpec    *v1.AppSpec       `json:"appSpec,omitempty"`
+	Params     *v1.ParamSpec     `json:"params,omitempty"`
+	ParseError string            `json:"parseError,omitempty"`
+	SBOMs      []apiv1.ImageSBOM `json:"sboms,omitempty"`
 }
 
 type PortForwardDialer func(ctx context.Context) (net.Conn, error)
@@ -203,6 +226,9 @@ type Client interface {
 	AppConfirmUpgrade(ctx context.Context, name string) error
 	AppPullImage(ctx context.Context, name string) error
 	AppIgnoreDeleteCleanup(ctx context.Context, name string) error
+	AppPromote(ctx context.Context, name string, opts *AppPromoteOptions) error
+	AppRollback(ctx context.Context, name string, opts *AppRollbackOptions) error
+	AppRunJob(ctx context.Context, name, jobName string) (string, error)
 
 	DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error
 	DevSessionRelease(ctx context.Context, name string) error
@@ -218,6 +244,7 @@ type Client interface {
 	SecretGet(ctx context.Context, name string) (*apiv1.Secret, error)
 	SecretReveal(ctx context.Context, name string) (*apiv1.Secret, error)
 	SecretUpdate(ctx context.Context, name string, data map[string][]byte) (*apiv1.Secret, error)
+	SecretRotate(ctx context.Context, name string) (*apiv1.Secret, error)
 	SecretDelete(ctx context.Context, name string) (*apiv1.Secret, error)
 
 	ContainerReplicaList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplica, error)
@@ -229,6 +256,12 @@ type Client interface {
 	VolumeList(ctx context.Context) ([]apiv1.Volume, error)
 	VolumeGet(ctx context.Context, name string) (*apiv1.Volume, error)
 	VolumeDelete(ctx context.Context, name string) (*apiv1.Volume, error)
+	VolumeArchive(ctx context.Context, name string, opts *VolumeArchiveOptions) (*term.ExecIO, error)
+
+	VolumeSnapshotCreate(ctx context.Context, volumeName string, opts *VolumeSnapshotCreateOptions) (*apiv1.VolumeSnapshot, error)
+	VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error)
+	VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error)
+	VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error)
 
 	ImageList(ctx context.Context) ([]apiv1.Image, error)
 	ImageGet(ctx context.Context, name string) (*apiv1.Image, error)
@@ -237,6 +270,8 @@ type Client interface {
 	ImagePull(ctx context.Context, name string, opts *ImagePullOptions) (<-chan ImageProgress, error)
 	ImageTag(ctx context.Context, image, tag string) error
 	ImageDetails(ctx context.Context, imageName string, opts *ImageDetailsOptions) (*ImageDetails, error)
+	ImageSign(ctx context.Context, imageName string, payload []byte, signatureB64, publicKey string) (*apiv1.ImageSign, error)
+	ImageVerify(ctx context.Context, imageName string, opts *ImageVerifyOptions) (*apiv1.ImageVerify, error)
 
 	AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error)
 	AcornImageBuildList(ctx context.Context) ([]apiv1.AcornImageBuild, error)
@@ -277,6 +312,11 @@ type AcornImageBuildOptions struct {
 	Args        map[string]any
 	Profiles    []string
 	Streams     *streams.Output
+	SBOM        string
+	CacheFrom   []string
+	CacheTo     string
+	// Reuse are the images of a previous build of the Acornfile, the builds that are unchanged use them again
+	Reuse *v1.ImagesData
 }
 
 func (a *AcornImageBuildOptions) complete() (_ *AcornImageBuildOptions, err error) {
@@ -309,6 +349,14 @@ type ImageDetailsOptions struct {
 	Profiles     []string
 	DeployArgs   map[string]any
 	Auth         *apiv1.RegistryAuth
+	IncludeSBOM  bool
+}
+
+type ImageVerifyOptions struct {
+	Key         string
+	Annotations v1.SignatureAnnotations
+	Rules       []string
+	Auth        *apiv1.RegistryAuth
 }
 
 type ImageDeleteOptions struct {
@@ -319,6 +367,14 @@ type ContainerReplicaExecOptions struct {
 	DebugImage string `json:"debugImage,omitempty"`
 }
 
+type VolumeArchiveOptions struct {
+	Path string `json:"path,omitempty"`
+}
+
+type VolumeSnapshotCreateOptions struct {
+	Name string `json:"name,omitempty"`
+}
+
 type ContainerReplicaListOptions struct {
 	App string `json:"app,omitempty"`
 }
@@ -329,6 +385,15 @@ type EventStreamOptions struct {
 	Details         bool   `json:"details,omitempty"`
 	Name            string `json:"name,omitempty"`
 	ResourceVersion string `json:"resourceVersion,omitempty"`
+	Type            string `json:"type,omitempty"`
+	Severity        string `json:"severity,omitempty"`
+	SourceKind      string `json:"sourceKind,omitempty"`
+	SourceName      string `json:"sourceName,omitempty"`
+	Actor           string `json:"actor,omitempty"`
+	// Since and Until are RFC3339 timestamps or durations like 42m that are subtracted from the current time
+	Since    string `json:"since,omitempty"`
+	Until    string `json:"until,omitempty"`
+	Collapse bool   `json:"collapse,omitempty"`
 }
 
 func (o EventStreamOptions) ListOptions() *kclient.ListOptions {
@@ -339,6 +404,22 @@ func (o EventStreamOptions) ListOptions() *kclient.ListOptions {
 	if o.Details {
 		fieldSet["details"] = strconv.FormatBool(o.Details)
 	}
+	for field, value := range map[string]string{
+		"type":        o.Type,
+		"severity":    o.Severity,
+		"source.kind": o.SourceKind,
+		"source.name": o.SourceName,
+		"actor":       o.Actor,
+		"since":       o.Since,
+		"until":       o.Until,
+	} {
+		if value != "" {
+			fieldSet[field] = value
+		}
+	}
+	if o.Collapse {
+		fieldSet["collapse"] = strconv.FormatBool(o.Collapse)
+	}
 
 	return &kclient.ListOptions{
 		Limit:         int64(o.Tail),
@@ -108,6 +108,27 @@ func (d *DeferredClient) AppIgnoreDeleteCleanup(ctx context.Context, name string
 	return d.Client.AppIgnoreDeleteCleanup(ctx, name)
 }
 
+func (d *DeferredClient) AppPromote(ctx context.Context, name string, opts *AppPromoteOptions) error {
+	if err := d.create(); err != nil {
+		return err
+	}
+	return d.Client.AppPromote(ctx, name, opts)
+}
+
+func (d *DeferredClient) AppRollback(ctx context.Context, name string, opts *AppRollbackOptions) error {
+	if err := d.create(); err != nil {
+		return err
+	}
+	return d.Client.AppRollback(ctx, name, opts)
+}
+
+func (d *DeferredClient) AppRunJob(ctx context.Context, name, jobName string) (string, error) {
+	if err := d.create(); err != nil {
+		return "", err
+	}
+	return d.Client.AppRunJob(ctx, name, jobName)
+}
+
 func (d *DeferredClient) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	if err := d.create(); err != nil {
 		return err
@@ -185,6 +206,13 @@ func (d *DeferredClient) SecretReveal(ctx context.Context, name string) (*apiv1.
 	return d.Client.SecretReveal(ctx, name)
 }
 
+func (d *DeferredClient) SecretRotate(ctx context.Context, name string) (*apiv1.Secret, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.SecretRotate(ctx, name)
+}
+
 func (d *DeferredClient) SecretUpdate(ctx context.Context, name string, data map[string][]byte) (*apiv1.Secret, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -255,6 +283,41 @@ func (d *DeferredClient) VolumeDelete(ctx context.Context, name string) (*apiv1.
 	return d.Client.VolumeDelete(ctx, name)
 }
 
+func (d *DeferredClient) VolumeArchive(ctx context.Context, name string, opts *VolumeArchiveOptions) (*term.ExecIO, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeArchive(ctx, name, opts)
+}
+
+func (d *DeferredClient) VolumeSnapshotCreate(ctx context.Context, volumeName string, opts *VolumeSnapshotCreateOptions) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotCreate(ctx, volumeName, opts)
+}
+
+func (d *DeferredClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotList(ctx)
+}
+
+func (d *DeferredClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotGet(ctx, name)
+}
+
+func (d *DeferredClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotDelete(ctx, name)
+}
+
 func (d *DeferredClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -304,6 +367,20 @@ func (d *DeferredClient) ImageDetails(ctx context.Context, imageName string, opt
 	return d.Client.ImageDetails(ctx, imageName, opts)
 }
 
+func (d *DeferredClient) ImageSign(ctx context.Context, imageName string, payload []byte, signatureB64, publicKey string) (*apiv1.ImageSign, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageSign(ctx, imageName, payload, signatureB64, publicKey)
+}
+
+func (d *DeferredClient) ImageVerify(ctx context.Context, imageName string, opts *ImageVerifyOptions) (*apiv1.ImageVerify, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ImageVerify(ctx, imageName, opts)
+}
+
 func (d *DeferredClient) AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -131,6 +131,18 @@ func (c *IgnoreUninstalled) AppSkipDeleteCleanup(ctx context.Context, name strin
 	return c.Client.AppIgnoreDeleteCleanup(ctx, name)
 }
 
+func (c *IgnoreUninstalled) AppPromote(ctx context.Context, name string, opts *AppPromoteOptions) error {
+	return c.Client.AppPromote(ctx, name, opts)
+}
+
+func (c *IgnoreUninstalled) AppRollback(ctx context.Context, name string, opts *AppRollbackOptions) error {
+	return c.Client.AppRollback(ctx, name, opts)
+}
+
+func (c *IgnoreUninstalled) AppRunJob(ctx context.Context, name, jobName string) (string, error) {
+	return c.Client.AppRunJob(ctx, name, jobName)
+}
+
 func (c *IgnoreUninstalled) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	return c.Client.DevSessionRenew(ctx, name, client)
 }
@@ -171,6 +183,28 @@ func (c IgnoreUninstalled) VolumeDelete(ctx context.Context, name string) (*apiv
 	return ignoreUninstalled(c.Client.VolumeDelete(ctx, name))
 }
 
+func (c IgnoreUninstalled) VolumeArchive(ctx context.Context, name string, opts *VolumeArchiveOptions) (*term.ExecIO, error) {
+	return c.Client.VolumeArchive(ctx, name, opts)
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotCreate(ctx context.Context, volumeName string, opts *VolumeSnapshotCreateOptions) (*apiv1.VolumeSnapshot, error) {
+	return promptInstall(ctx, func() (*apiv1.VolumeSnapshot, error) {
+		return c.Client.VolumeSnapshotCreate(ctx, volumeName, opts)
+	})
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return ignoreUninstalled(c.Client.VolumeSnapshotList(ctx))
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return c.Client.VolumeSnapshotGet(ctx, name)
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return ignoreUninstalled(c.Client.VolumeSnapshotDelete(ctx, name))
+}
+
 func (c IgnoreUninstalled) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	return ignoreUninstalled(c.Client.ImageList(ctx))
 }
@@ -265,6 +299,10 @@ func (c IgnoreUninstalled) SecretReveal(ctx context.Context, name string) (*apiv
 	return c.Client.SecretReveal(ctx, name)
 }
 
+func (c IgnoreUninstalled) SecretRotate(ctx context.Context, name string) (*apiv1.Secret, error) {
+	return c.Client.SecretRotate(ctx, name)
+}
+
 func (c IgnoreUninstalled) SecretUpdate(ctx context.Context, name string, data map[string][]byte) (*apiv1.Secret, error) {
 	return c.Client.SecretUpdate(ctx, name, data)
 }
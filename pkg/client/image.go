@@ -42,6 +42,7 @@ func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts
 		detailsResult.Profiles = opts.Profiles
 		detailsResult.NestedDigest = opts.NestedDigest
 		detailsResult.Auth = opts.Auth
+		detailsResult.IncludeSBOM = opts.IncludeSBOM
 	}
 
 	err := c.RESTClient.Post().
@@ -60,9 +61,51 @@ func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts
 		AppSpec:    detailsResult.AppSpec,
 		Params:     detailsResult.Params,
 		ParseError: detailsResult.ParseError,
+		SBOMs:      detailsResult.SBOMs,
 	}, nil
 }
 
+func (c *DefaultClient) ImageSign(ctx context.Context, imageName string, payload []byte, signatureB64, publicKey string) (*apiv1.ImageSign, error) {
+	result := &apiv1.ImageSign{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name(strings.ReplaceAll(imageName, "/", "+")).
+		SubResource("sign").
+		Body(&apiv1.ImageSign{
+			Payload:   payload,
+			Signature: signatureB64,
+			PublicKey: publicKey,
+		}).Do(ctx).Into(result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *DefaultClient) ImageVerify(ctx context.Context, imageName string, opts *ImageVerifyOptions) (*apiv1.ImageVerify, error) {
+	body := &apiv1.ImageVerify{}
+	if opts != nil {
+		body.Key = opts.Key
+		body.Annotations = opts.Annotations
+		body.Rules = opts.Rules
+		body.Auth = opts.Auth
+	}
+
+	result := &apiv1.ImageVerify{}
+	err := c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("images").
+		Name(strings.ReplaceAll(imageName, "/", "+")).
+		SubResource("verify").
+		Body(body).
+		Do(ctx).Into(result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *DefaultClient) ImagePull(ctx context.Context, imageName string, opts *ImagePullOptions) (<-chan ImageProgress, error) {
 	body := &apiv1.ImagePull{}
 	if opts != nil {
@@ -219,6 +219,29 @@ func (m *MultiClient) AppIgnoreDeleteCleanup(ctx context.Context, name string) e
 	return err
 }
 
+func (m *MultiClient) AppPromote(ctx context.Context, name string, opts *AppPromoteOptions) error {
+	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		return &apiv1.App{}, c.AppPromote(ctx, name, opts)
+	})
+	return err
+}
+
+func (m *MultiClient) AppRollback(ctx context.Context, name string, opts *AppRollbackOptions) error {
+	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		return &apiv1.App{}, c.AppRollback(ctx, name, opts)
+	})
+	return err
+}
+
+func (m *MultiClient) AppRunJob(ctx context.Context, name, jobName string) (string, error) {
+	var runName string
+	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (_ *apiv1.App, err error) {
+		runName, err = c.AppRunJob(ctx, name, jobName)
+		return &apiv1.App{}, err
+	})
+	return runName, err
+}
+
 func (m *MultiClient) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
 		return &apiv1.App{}, c.DevSessionRenew(ctx, name, client)
@@ -287,6 +310,12 @@ func (m *MultiClient) SecretReveal(ctx context.Context, name string) (*apiv1.Sec
 	})
 }
 
+func (m *MultiClient) SecretRotate(ctx context.Context, name string) (*apiv1.Secret, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.Secret, error) {
+		return c.SecretRotate(ctx, name)
+	})
+}
+
 func (m *MultiClient) SecretUpdate(ctx context.Context, name string, data map[string][]byte) (*apiv1.Secret, error) {
 	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.Secret, error) {
 		return c.SecretUpdate(ctx, name, data)
@@ -357,6 +386,38 @@ func (m *MultiClient) VolumeDelete(ctx context.Context, name string) (*apiv1.Vol
 	})
 }
 
+func (m *MultiClient) VolumeArchive(ctx context.Context, name string, opts *VolumeArchiveOptions) (exec *term.ExecIO, err error) {
+	_, err = onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.Volume, error) {
+		exec, err = c.VolumeArchive(ctx, name, opts)
+		return &apiv1.Volume{}, err
+	})
+	return exec, err
+}
+
+func (m *MultiClient) VolumeSnapshotCreate(ctx context.Context, volumeName string, opts *VolumeSnapshotCreateOptions) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, volumeName, func(volumeName string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotCreate(ctx, volumeName, opts)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotList(ctx)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotGet(ctx, name)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotDelete(ctx, name)
+	})
+}
+
 func (m *MultiClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -413,6 +474,22 @@ func (m *MultiClient) ImageDetails(ctx context.Context, imageName string, opts *
 	return c.ImageDetails(ctx, imageName, opts)
 }
 
+func (m *MultiClient) ImageSign(ctx context.Context, imageName string, payload []byte, signatureB64, publicKey string) (*apiv1.ImageSign, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageSign(ctx, imageName, payload, signatureB64, publicKey)
+}
+
+func (m *MultiClient) ImageVerify(ctx context.Context, imageName string, opts *ImageVerifyOptions) (*apiv1.ImageVerify, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.ImageVerify(ctx, imageName, opts)
+}
+
 func (m *MultiClient) AcornImageBuildGet(ctx context.Context, name string) (*apiv1.AcornImageBuild, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
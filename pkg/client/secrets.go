@@ -2,10 +2,13 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -60,6 +63,27 @@ func (c *DefaultClient) SecretUpdate(ctx context.Context, name string, data map[
 	return secret, c.Client.Update(ctx, secret)
 }
 
+func (c *DefaultClient) SecretRotate(ctx context.Context, name string) (*apiv1.Secret, error) {
+	secret := &apiv1.Secret{}
+	err := c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret.Type != "generated" && secret.Type != "token" {
+		return nil, fmt.Errorf("secret %s of type [%s] can not be rotated, only generated and token secrets can be rotated", name, secret.Type)
+	}
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[labels.AcornSecretRotateRequested] = time.Now().UTC().Format(time.RFC3339)
+	return secret, c.Client.Update(ctx, secret)
+}
+
 func (c *DefaultClient) SecretList(ctx context.Context) ([]apiv1.Secret, error) {
 	result := &apiv1.SecretList{}
 	err := c.Client.List(ctx, result, &kclient.ListOptions{
@@ -5,6 +5,9 @@ import (
 	"sort"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/client/term"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/sirupsen/logrus"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -75,3 +78,31 @@ func (c *DefaultClient) VolumeClassGet(ctx context.Context, name string) (*apiv1
 		Name:      name,
 	}, storage)
 }
+
+func (c *DefaultClient) VolumeArchive(ctx context.Context, name string, opts *VolumeArchiveOptions) (*term.ExecIO, error) {
+	if opts == nil {
+		opts = &VolumeArchiveOptions{}
+	}
+
+	vol, err := c.VolumeGet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	req := c.RESTClient.Get().
+		Namespace(vol.Namespace).
+		Resource("volumes").
+		Name(vol.Name).
+		SubResource("archive").
+		VersionedParams(&apiv1.VolumeArchiveOptions{
+			Path: opts.Path,
+		}, scheme.ParameterCodec)
+
+	logrus.Debugf("Volume archive URL: %s", req.URL().String())
+	conn, err := c.Dialer.DialContext(ctx, req.URL().String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.ToExecIO(false), nil
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"sort"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *DefaultClient) VolumeSnapshotCreate(ctx context.Context, volumeName string, opts *VolumeSnapshotCreateOptions) (*apiv1.VolumeSnapshot, error) {
+	if opts == nil {
+		opts = &VolumeSnapshotCreateOptions{}
+	}
+
+	snapshot := &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opts.Name,
+			Namespace: c.Namespace,
+		},
+	}
+	if snapshot.Name == "" {
+		snapshot.GenerateName = "snapshot-"
+	}
+	snapshot.Spec.VolumeName = volumeName
+
+	return snapshot, c.Client.Create(ctx, snapshot)
+}
+
+func (c *DefaultClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	snapshots := &apiv1.VolumeSnapshotList{}
+	err := c.Client.List(ctx, snapshots, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(snapshots.Items, func(i, j int) bool {
+		if snapshots.Items[i].CreationTimestamp.Time == snapshots.Items[j].CreationTimestamp.Time {
+			return snapshots.Items[i].Name < snapshots.Items[j].Name
+		}
+		return snapshots.Items[i].CreationTimestamp.After(snapshots.Items[j].CreationTimestamp.Time)
+	})
+
+	return snapshots.Items, nil
+}
+
+func (c *DefaultClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	snapshot := &apiv1.VolumeSnapshot{}
+	return snapshot, c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, snapshot)
+}
+
+func (c *DefaultClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	snapshot, err := c.VolumeSnapshotGet(ctx, name)
+	if apierror.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return snapshot, c.Client.Delete(ctx, snapshot)
+}
@@ -21,10 +21,11 @@ const gi = float64(1 << 30) // 1 Gibibyte. Note: this is not equivalent to 1 Gig
 
 var (
 	ErrInvalidMemoryForClass = errors.New("memory is invalid")
+	ErrInvalidCPUForClass    = errors.New("cpu is invalid")
 	ErrInvalidClass          = errors.New("compute class is invalid")
 )
 
-type memoryQuantities struct {
+type classQuantities struct {
 	Max    *resource.Quantity
 	Min    *resource.Quantity
 	Def    *resource.Quantity
@@ -38,32 +39,32 @@ func parseQuantity(memory string) (resource.Quantity, error) {
 	return resource.ParseQuantity(memory)
 }
 
-func ParseComputeClassMemory(memory internaladminv1.ComputeClassMemory) (memoryQuantities, error) {
-	var quantities memoryQuantities
+func parseClassQuantities(min, max, def string, values []string) (classQuantities, error) {
+	var quantities classQuantities
 
-	minInt, err := parseQuantity(memory.Min)
+	minInt, err := parseQuantity(min)
 	if err != nil {
-		return memoryQuantities{}, err
+		return classQuantities{}, err
 	}
 	quantities.Min = &minInt
 
-	maxInt, err := parseQuantity(memory.Max)
+	maxInt, err := parseQuantity(max)
 	if err != nil {
-		return memoryQuantities{}, err
+		return classQuantities{}, err
 	}
 	quantities.Max = &maxInt
 
-	defInt, err := parseQuantity(memory.Default)
+	defInt, err := parseQuantity(def)
 	if err != nil {
-		return memoryQuantities{}, err
+		return classQuantities{}, err
 	}
 	quantities.Def = &defInt
 
-	quantities.Values = make([]*resource.Quantity, len(memory.Values))
-	for i, value := range memory.Values {
+	quantities.Values = make([]*resource.Quantity, len(values))
+	for i, value := range values {
 		valueInt, err := parseQuantity(value)
 		if err != nil {
-			return memoryQuantities{}, err
+			return classQuantities{}, err
 		}
 		quantities.Values[i] = &valueInt
 	}
@@ -71,7 +72,15 @@ func ParseComputeClassMemory(memory internaladminv1.ComputeClassMemory) (memoryQ
 	return quantities, nil
 }
 
-func memoryInValues(parsedMemory memoryQuantities, memory resource.Quantity) bool {
+func ParseComputeClassMemory(memory internaladminv1.ComputeClassMemory) (classQuantities, error) {
+	return parseClassQuantities(memory.Min, memory.Max, memory.Default, memory.Values)
+}
+
+func ParseComputeClassCPU(cpu internaladminv1.ComputeClassCPU) (classQuantities, error) {
+	return parseClassQuantities(cpu.Min, cpu.Max, cpu.Default, cpu.Values)
+}
+
+func memoryInValues(parsedMemory classQuantities, memory resource.Quantity) bool {
 	value := memory.Value()
 	for _, allowedMemory := range parsedMemory.Values {
 		if allowedMemory != nil && value == allowedMemory.Value() {
@@ -81,6 +90,16 @@ func memoryInValues(parsedMemory memoryQuantities, memory resource.Quantity) boo
 	return len(parsedMemory.Values) == 0
 }
 
+func cpuInValues(parsedCPU classQuantities, cpu resource.Quantity) bool {
+	value := cpu.MilliValue()
+	for _, allowedCPU := range parsedCPU.Values {
+		if allowedCPU != nil && value == allowedCPU.MilliValue() {
+			return true
+		}
+	}
+	return len(parsedCPU.Values) == 0
+}
+
 func Validate(cc apiv1.ComputeClass, memory resource.Quantity, memDefault *int64) error {
 	parsedMemory, err := ParseComputeClassMemory(cc.Memory)
 	if err != nil {
@@ -117,6 +136,44 @@ func Validate(cc apiv1.ComputeClass, memory resource.Quantity, memDefault *int64
 	return nil
 }
 
+// ValidateCPU checks that the cpu, in milli CPUs, is allowed by the ComputeClass.
+func ValidateCPU(cc apiv1.ComputeClass, cpu resource.Quantity, cpuDefault *int64) error {
+	parsedCPU, err := ParseComputeClassCPU(cc.CPU)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidClass, err)
+	}
+
+	if cc.CPU.Default != "" {
+		wcDefault := parsedCPU.Def.MilliValue()
+		cpuDefault = &wcDefault
+	}
+	if !cpuInValues(parsedCPU, cpu) {
+		return fmt.Errorf("%w: defined cpu %v is not an allowed value for the ComputeClass %v. allowed values: %v",
+			ErrInvalidCPUForClass, cpu.String(), cc.Name, cc.CPU.Values)
+	}
+
+	isDefault := cpuDefault != nil && cpu.MilliValue() == *cpuDefault
+	milliCPU := cpu.MilliValue()
+	if max := parsedCPU.Max.MilliValue(); max != 0 && milliCPU > max {
+		if isDefault {
+			return fmt.Errorf("%w: default cpu %v exceeds the maximum cpu of %v for the ComputeClass %v",
+				ErrInvalidCPUForClass, cpu.String(), parsedCPU.Max.String(), cc.Name)
+		}
+		return fmt.Errorf("%w: defined cpu %v exceeds the maximum cpu for the ComputeClass %v of %v",
+			ErrInvalidCPUForClass, cpu.String(), cc.Name, parsedCPU.Max.String())
+	}
+	if min := parsedCPU.Min.MilliValue(); milliCPU != 0 && milliCPU < min {
+		if isDefault {
+			return fmt.Errorf("%w: default cpu %v is below the minimum cpu of %v for the ComputeClass %v",
+				ErrInvalidCPUForClass, cpu.String(), parsedCPU.Min.String(), cc.Name)
+		}
+		return fmt.Errorf("%w: defined cpu %v is below the minimum cpu for the ComputeClass %v of %v",
+			ErrInvalidCPUForClass, cpu.String(), cc.Name, parsedCPU.Min.String())
+	}
+
+	return nil
+}
+
 func CalculateCPU(cc internaladminv1.ProjectComputeClassInstance, memDefault *int64, memory resource.Quantity) (resource.Quantity, error) {
 	if err := ValidateProjectComputeClass(cc, memory, memDefault); err != nil {
 		return resource.Quantity{}, err
@@ -131,15 +188,24 @@ func CalculateCPU(cc internaladminv1.ProjectComputeClassInstance, memDefault *in
 }
 
 func ValidateProjectComputeClass(cc internaladminv1.ProjectComputeClassInstance, memory resource.Quantity, memDefault *int64) error {
-	return Validate(apiv1.ComputeClass{
+	return Validate(toComputeClass(cc), memory, memDefault)
+}
+
+func ValidateProjectComputeClassCPU(cc internaladminv1.ProjectComputeClassInstance, cpu resource.Quantity, cpuDefault *int64) error {
+	return ValidateCPU(toComputeClass(cc), cpu, cpuDefault)
+}
+
+func toComputeClass(cc internaladminv1.ProjectComputeClassInstance) apiv1.ComputeClass {
+	return apiv1.ComputeClass{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: cc.Name,
 		},
 		Memory:           cc.Memory,
+		CPU:              cc.CPU,
 		Description:      cc.Description,
 		Default:          cc.Default,
 		SupportedRegions: cc.SupportedRegions,
-	}, memory, memDefault)
+	}
 }
 
 func GetComputeClassNameForWorkload(workload string, container internalv1.Container, computeClasses internalv1.ComputeClassMap) string {
@@ -85,6 +85,12 @@ func complete(ctx context.Context, c *apiv1.Config, getter kclient.Reader) error
 	if c.WorkloadMemoryMaximum == nil {
 		c.WorkloadMemoryMaximum = new(int64)
 	}
+	if c.WorkloadCPUDefault == nil {
+		c.WorkloadCPUDefault = new(int64)
+	}
+	if c.WorkloadCPUMaximum == nil {
+		c.WorkloadCPUMaximum = new(int64)
+	}
 	if c.InternalRegistryPrefix == nil {
 		c.InternalRegistryPrefix = new(string)
 	}
@@ -118,6 +124,9 @@ func complete(ctx context.Context, c *apiv1.Config, getter kclient.Reader) error
 	if c.CertManagerIssuer == nil {
 		c.CertManagerIssuer = new(string)
 	}
+	if c.BuilderCacheSize == nil {
+		c.BuilderCacheSize = new(string)
+	}
 	return nil
 }
 
@@ -327,6 +336,12 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 	if newConfig.WorkloadMemoryMaximum != nil {
 		mergedConfig.WorkloadMemoryMaximum = newConfig.WorkloadMemoryMaximum
 	}
+	if newConfig.WorkloadCPUDefault != nil {
+		mergedConfig.WorkloadCPUDefault = newConfig.WorkloadCPUDefault
+	}
+	if newConfig.WorkloadCPUMaximum != nil {
+		mergedConfig.WorkloadCPUMaximum = newConfig.WorkloadCPUMaximum
+	}
 	if newConfig.UseCustomCABundle != nil {
 		mergedConfig.UseCustomCABundle = newConfig.UseCustomCABundle
 	}
@@ -379,6 +394,10 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 		mergedConfig.CertManagerIssuer = newConfig.CertManagerIssuer
 	}
 
+	if newConfig.BuilderCacheSize != nil {
+		mergedConfig.BuilderCacheSize = newConfig.BuilderCacheSize
+	}
+
 	return &mergedConfig
 }
 
@@ -56,6 +56,7 @@ func toAcorns(appInstance *v1.AppInstance, tag name.Reference, pullSecrets *Pull
 			NotifyUpgrade:       service.NotifyUpgrade,
 			AutoUpgradeInterval: service.AutoUpgradeInterval,
 			Memory:              service.Memory,
+			CPU:                 service.CPU,
 		}))
 	}
 	return result
@@ -140,6 +141,7 @@ func toAcorn(appInstance *v1.AppInstance, tag name.Reference, pullSecrets *PullS
 			AutoUpgrade:         acorn.AutoUpgrade,
 			AutoUpgradeInterval: acorn.AutoUpgradeInterval,
 			NotifyUpgrade:       acorn.NotifyUpgrade,
+			LogSink:             childLogSink(appInstance.Spec.LogSink),
 		},
 	}
 
@@ -170,3 +172,12 @@ func appInstanceScoped(acornName string, globalLabels map[string]string, appSpec
 	labelMap = labels.Merge(labelMap, labels.GatherScoped(acornName, v1.LabelTypeAcorn, globalLabels, labelMap, appSpecLabels))
 	return labels.ExcludeAcornKey(labelMap)
 }
+
+// childLogSink returns the log sink for nested acorns. Nested acorns don't have the volumes of the parent, so file log
+// sinks are not passed to them.
+func childLogSink(sink *v1.LogSink) *v1.LogSink {
+	if sink == nil || sink.File != nil {
+		return nil
+	}
+	return sink
+}
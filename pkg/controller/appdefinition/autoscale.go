@@ -0,0 +1,75 @@
+package appdefinition
+
+import (
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func isAutoscaled(appInstance *v1.AppInstance, container v1.Container) bool {
+	return container.Autoscale != nil && !isStateful(appInstance, container)
+}
+
+func toHorizontalPodAutoscaler(dep *appsv1.Deployment, autoscale v1.Autoscale) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	var metrics []autoscalingv2.MetricSpec
+
+	if autoscale.TargetCPU != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceCPU, *autoscale.TargetCPU))
+	}
+	if autoscale.TargetMemory != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceMemory, *autoscale.TargetMemory))
+	}
+	if autoscale.Metric != nil {
+		target, err := resource.ParseQuantity(autoscale.Metric.TargetAverageValue)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: autoscale.Metric.Name,
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &target,
+				},
+			},
+		})
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        dep.Name,
+			Namespace:   dep.Namespace,
+			Labels:      dep.Labels,
+			Annotations: dep.Annotations,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       dep.Name,
+			},
+			MinReplicas: &[]int32{autoscale.GetMin()}[0],
+			MaxReplicas: autoscale.Max,
+			Metrics:     metrics,
+		},
+	}, nil
+}
+
+func resourceMetric(name corev1.ResourceName, averageUtilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &averageUtilization,
+			},
+		},
+	}
+}
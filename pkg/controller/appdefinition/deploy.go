@@ -18,6 +18,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/condition"
 	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/pdb"
 	"github.com/acorn-io/runtime/pkg/ports"
@@ -102,6 +103,9 @@ func DeploySpec(req router.Request, resp router.Response) (err error) {
 	if err := addPVCs(req, appInstance, resp); err != nil {
 		return err
 	}
+	if err := addLogForwarder(req, appInstance, resp); err != nil {
+		return err
+	}
 	addAcorns(req, appInstance, tag, pullSecrets, resp)
 
 	resp.Objects(pullSecrets.Objects()...)
@@ -110,7 +114,15 @@ func DeploySpec(req router.Request, resp router.Response) (err error) {
 }
 
 func addDeployments(req router.Request, appInstance *v1.AppInstance, tag name.Reference, pullSecrets *PullSecrets, secrets *secrets.Interpolator, resp router.Response) error {
-	deps, err := ToDeployments(req, appInstance, tag, pullSecrets, secrets)
+	var (
+		deps []kclient.Object
+		err  error
+	)
+	if appInstance.Status.Rollout.InProgress(appInstance.Status.AppImage) {
+		deps, err = toRolloutDeployments(req, appInstance, tag, pullSecrets, secrets)
+	} else {
+		deps, err = ToDeployments(req, appInstance, tag, pullSecrets, secrets)
+	}
 	if err != nil {
 		return err
 	}
@@ -188,13 +200,31 @@ func hasContextDir(container v1.Container) bool {
 	return false
 }
 
+// hasDevSync returns true if dev mode syncs source changes into the container or one of its sidecars
+func hasDevSync(container v1.Container) bool {
+	if syncsOnChange(container) {
+		return true
+	}
+	for _, sidecar := range container.Sidecars {
+		if hasDevSync(sidecar) {
+			return true
+		}
+	}
+	return false
+}
+
+func syncsOnChange(container v1.Container) bool {
+	onChange := container.Dev.GetOnChange()
+	return onChange != nil && len(onChange.Sync) > 0
+}
+
 func toContainers(app *v1.AppInstance, tag name.Reference, name string, container v1.Container, interpolator *secrets.Interpolator) ([]corev1.Container, []corev1.Container) {
 	var (
 		containers     []corev1.Container
 		initContainers []corev1.Container
 	)
 
-	if app.Status.GetDevMode() && hasContextDir(container) {
+	if app.Status.GetDevMode() && (hasContextDir(container) || hasDevSync(container)) {
 		initContainers = append(initContainers, corev1.Container{
 			Name:            "acorn-helper",
 			Image:           system.DefaultImage(),
@@ -280,6 +310,12 @@ func toMounts(app *v1.AppInstance, container v1.Container, interpolation *secret
 			})
 		}
 	}
+	if !helperMounted && app.Status.GetDevMode() && syncsOnChange(container) {
+		result = append(result, corev1.VolumeMount{
+			Name:      sanitizeVolumeName(AcornHelper),
+			MountPath: AcornHelperPath,
+		})
+	}
 	return
 }
 
@@ -515,22 +551,7 @@ func addPrometheusAnnotations(annotations map[string]string, container v1.Contai
 }
 
 func isStateful(appInstance *v1.AppInstance, container v1.Container) bool {
-	for _, dir := range container.Dirs {
-		if dir.Secret.Name != "" {
-			continue
-		}
-		for volName, vol := range appInstance.Status.AppSpec.Volumes {
-			if vol.Class == "ephemeral" {
-				continue
-			}
-			if dir.Volume == volName {
-				if len(vol.AccessModes) == 0 || (len(vol.AccessModes) == 1 && vol.AccessModes[0] == v1.AccessModeReadWriteOnce) {
-					return true
-				}
-			}
-		}
-	}
-	return false
+	return appInstance.Status.AppSpec.IsStateful(container)
 }
 
 func getRevision(req router.Request, namespace, secretName string) (string, error) {
@@ -608,6 +629,7 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 	if err != nil {
 		return nil, err
 	}
+	secretAnnotations = labels.Merge(secretAnnotations, restoreAnnotations(appInstance, volumes))
 
 	podLabels := containerLabels(appInstance, container, name, labels.AcornAppPublicName, publicname.Get(appInstance))
 	deploymentLabels := containerLabels(appInstance, container, name)
@@ -620,7 +642,7 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 			Name:        name,
 			Namespace:   appInstance.Status.Namespace,
 			Labels:      deploymentLabels,
-			Annotations: typed.Concat(deploymentAnnotations, getDependencyAnnotations(appInstance, name, container.Dependencies), secretAnnotations),
+			Annotations: typed.Concat(deploymentAnnotations, getDependencyAnnotations(appInstance, name, append(jobs.PreUpdateDependencies(appInstance, name), container.Dependencies...)), secretAnnotations),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: container.Scale,
@@ -652,6 +674,9 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 		dep.Spec.Replicas = &[]int32{1}[0]
 		dep.Spec.Template.Spec.Hostname = dep.Name
 		dep.Spec.Strategy.Type = appsv1.RecreateDeploymentStrategyType
+	} else if isAutoscaled(appInstance, container) {
+		// The replica count is owned by the HorizontalPodAutoscaler
+		dep.Spec.Replicas = nil
 	} else if dep.Spec.Replicas == nil || *dep.Spec.Replicas == 1 {
 		dep.Spec.Template.Spec.Hostname = dep.Name
 	}
@@ -682,6 +707,13 @@ func ToDeployments(req router.Request, appInstance *v1.AppInstance, tag name.Ref
 			result = append(result, toPermissions(perms, dep.GetLabels(), dep.GetAnnotations(), appInstance)...)
 		}
 		result = append(result, sa, dep, pdb.ToPodDisruptionBudget(dep))
+		if isAutoscaled(appInstance, entry.Value) && !appInstance.GetStopped() {
+			hpa, err := toHorizontalPodAutoscaler(dep, *entry.Value.Autoscale)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, hpa)
+		}
 	}
 	return result, nil
 }
@@ -64,6 +64,18 @@ func TestDeploySpecScale(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/scale", DeploySpec)
 }
 
+func TestDeploySpecAutoscale(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/autoscale", DeploySpec)
+}
+
+func TestDeploySpecRolloutCanary(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/rollout-canary", DeploySpec)
+}
+
+func TestDeploySpecRolloutBlueGreen(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/rollout-bluegreen", DeploySpec)
+}
+
 func TestDeploySpecStop(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/stop", DeploySpec)
 }
@@ -196,6 +208,32 @@ func TestSidecar(t *testing.T) {
 	assert.Equal(t, "sidecar2", dep.Spec.Template.Spec.Containers[1].Image)
 }
 
+func TestDevOnChangeSync(t *testing.T) {
+	dep := ToDeploymentsTest(t, &v1.AppInstance{
+		Status: v1.AppInstanceStatus{
+			DevSession: &v1.DevSessionInstanceSpec{},
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"test": {
+						Dev: &v1.DevConfig{
+							OnChange: &v1.DevOnChange{
+								Sync: []string{"./src:/app/src"},
+								Run:  "kill -HUP 1",
+							},
+						},
+					},
+				},
+			},
+		},
+	}, testTag, nil)[1].(*appsv1.Deployment)
+	assert.Equal(t, "acorn-helper", dep.Spec.Template.Spec.InitContainers[0].Name)
+	assert.Equal(t, []corev1.VolumeMount{{
+		Name:      sanitizeVolumeName(AcornHelper),
+		MountPath: AcornHelperPath,
+	}}, dep.Spec.Template.Spec.Containers[0].VolumeMounts)
+	assert.Equal(t, sanitizeVolumeName(AcornHelper), dep.Spec.Template.Spec.Volumes[0].Name)
+}
+
 func TestPorts(t *testing.T) {
 	dep := ToDeploymentsTest(t, &v1.AppInstance{
 		Status: v1.AppInstanceStatus{
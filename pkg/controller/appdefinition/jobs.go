@@ -112,10 +112,18 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 		return nil, err
 	}
 
+	// Rotating a generated secret changes these annotations, which replaces the job so it generates a new value
+	rotationAnnotations, err := secrets.JobRotationAnnotations(req, appInstance, name)
+	if err != nil {
+		return nil, err
+	}
+	secretAnnotations = labels.Merge(secretAnnotations, rotationAnnotations)
+
 	volumes, err := toVolumes(appInstance, container, interpolator)
 	if err != nil {
 		return nil, err
 	}
+	secretAnnotations = labels.Merge(secretAnnotations, restoreAnnotations(appInstance, volumes))
 
 	baseAnnotations := labels.Merge(secretAnnotations, labels.GatherScoped(name, v1.LabelTypeJob,
 		appInstance.Status.AppSpec.Annotations, container.Annotations, appInstance.Spec.Annotations))
@@ -156,6 +164,13 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 		},
 	}
 
+	jobSpec.ActiveDeadlineSeconds = container.DeadlineSeconds
+
+	dependencies := container.Dependencies
+	if jobEventName == "post-deploy" {
+		dependencies = append(jobs.PostDeployDependencies(appInstance, name), dependencies...)
+	}
+
 	interpolator.AddMissingAnnotations(baseAnnotations)
 
 	if container.Schedule == "" {
@@ -165,7 +180,7 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 				Name:        name,
 				Namespace:   appInstance.Status.Namespace,
 				Labels:      jobSpec.Template.Labels,
-				Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, container.Dependencies), baseAnnotations),
+				Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, dependencies), baseAnnotations),
 			},
 			Spec: jobSpec,
 		}
@@ -185,12 +200,13 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 			Name:        name,
 			Namespace:   appInstance.Status.Namespace,
 			Labels:      jobSpec.Template.Labels,
-			Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, container.Dependencies), baseAnnotations),
+			Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, dependencies), baseAnnotations),
 		},
 		Spec: batchv1.CronJobSpec{
-			FailedJobsHistoryLimit:     &[]int32{3}[0],
-			SuccessfulJobsHistoryLimit: &[]int32{1}[0],
-			ConcurrencyPolicy:          batchv1.ReplaceConcurrent,
+			FailedJobsHistoryLimit:     historyLimit(container.FailedHistory, 3),
+			SuccessfulJobsHistoryLimit: historyLimit(container.SuccessfulHistory, 1),
+			ConcurrencyPolicy:          toConcurrencyPolicy(container.Concurrency),
+			Suspend:                    &container.Suspend,
 			Schedule:                   toCronJobSchedule(container.Schedule),
 			JobTemplate: batchv1.JobTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
@@ -204,6 +220,24 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 	return cronJob, nil
 }
 
+func toConcurrencyPolicy(concurrency v1.JobConcurrency) batchv1.ConcurrencyPolicy {
+	switch concurrency {
+	case v1.JobConcurrencyForbid:
+		return batchv1.ForbidConcurrent
+	case v1.JobConcurrencyAllow:
+		return batchv1.AllowConcurrent
+	default:
+		return batchv1.ReplaceConcurrent
+	}
+}
+
+func historyLimit(limit *int32, def int32) *int32 {
+	if limit == nil {
+		return &def
+	}
+	return limit
+}
+
 func toCronJobSchedule(schedule string) string {
 	switch strings.TrimSpace(schedule) {
 	case "year":
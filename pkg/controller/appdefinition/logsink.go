@@ -0,0 +1,283 @@
+package appdefinition
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tolerations"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// logForwarderName is the name of the objects of the log forwarder in the app namespace. Names in an Acornfile
+	// can't have a dot, so it doesn't collide with a container, job or secret of the app.
+	logForwarderName      = "acorn.log-forwarder"
+	logForwarderVolumeDir = "/run/acorn/log-sink"
+	logForwarderSinkKey   = "sink"
+)
+
+func addLogForwarder(req router.Request, appInstance *v1.AppInstance, resp router.Response) error {
+	sink, err := getLogSink(req, appInstance)
+	if err != nil || sink == nil {
+		return err
+	}
+	sink, err = resolveLogSink(req, appInstance, sink)
+	if err != nil {
+		return err
+	}
+	objs, err := toLogForwarder(appInstance, sink)
+	if err != nil {
+		return err
+	}
+	resp.Objects(objs...)
+	return nil
+}
+
+// getLogSink returns the log sink of the app, or the one of the project if the app doesn't have one
+func getLogSink(req router.Request, appInstance *v1.AppInstance) (*v1.LogSink, error) {
+	if appInstance.Spec.LogSink != nil {
+		return appInstance.Spec.LogSink, nil
+	}
+
+	project := &corev1.Namespace{}
+	if err := req.Get(project, "", appInstance.Namespace); apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	data := project.Annotations[labels.AcornProjectLogSink]
+	if data == "" {
+		return nil, nil
+	}
+	sink := &v1.LogSink{}
+	if err := json.Unmarshal([]byte(data), sink); err != nil {
+		return nil, fmt.Errorf("invalid log sink of project %s: %w", appInstance.Namespace, err)
+	}
+	return sink, nil
+}
+
+// resolveLogSink returns the log sink with the URL of an HTTP sink read from the secret in the project it refers to.
+// The URL can have credentials in it, so it is only kept in secrets.
+func resolveLogSink(req router.Request, appInstance *v1.AppInstance, sink *v1.LogSink) (*v1.LogSink, error) {
+	if sink.HTTP == nil || sink.HTTP.Secret == "" {
+		return sink, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := req.Get(secret, appInstance.Namespace, sink.HTTP.Secret); err != nil {
+		return nil, fmt.Errorf("reading secret %s of the log sink: %w", sink.HTTP.Secret, err)
+	}
+	url := string(secret.Data[v1.LogSinkSecretURLKey])
+	if url == "" {
+		return nil, fmt.Errorf("secret %s of the log sink has no %s key", sink.HTTP.Secret, v1.LogSinkSecretURLKey)
+	}
+	return &v1.LogSink{
+		HTTP: &v1.HTTPLogSink{
+			URL: url,
+		},
+	}, nil
+}
+
+// logForwarderAffinity keeps the forwarder of a file log sink on the node of the containers that mount the volume,
+// because the volume can usually only be attached to one node at a time
+func logForwarderAffinity(appInstance *v1.AppInstance, volume string) *corev1.Affinity {
+	var containerNames []string
+	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Containers) {
+		if mountsVolume(entry.Value, volume) {
+			containerNames = append(containerNames, entry.Key)
+			continue
+		}
+		for _, sidecar := range entry.Value.Sidecars {
+			if mountsVolume(sidecar, volume) {
+				containerNames = append(containerNames, entry.Key)
+				break
+			}
+		}
+	}
+	if len(containerNames) == 0 {
+		return nil
+	}
+
+	return &corev1.Affinity{
+		PodAffinity: &corev1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+				{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							labels.AcornAppName:      appInstance.Name,
+							labels.AcornAppNamespace: appInstance.Namespace,
+						},
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      labels.AcornContainerName,
+								Operator: metav1.LabelSelectorOpIn,
+								Values:   containerNames,
+							},
+						},
+					},
+					TopologyKey: corev1.LabelHostname,
+				},
+			},
+		},
+	}
+}
+
+func mountsVolume(container v1.Container, volume string) bool {
+	for _, mount := range container.Dirs {
+		if mount.Volume == volume {
+			return true
+		}
+	}
+	return false
+}
+
+// toLogForwarder returns the deployment that sends the logs of all containers, jobs and sidecars in the app namespace
+// to the log sink, with the service account that can read them
+func toLogForwarder(appInstance *v1.AppInstance, sink *v1.LogSink) ([]kclient.Object, error) {
+	sinkData, err := json.Marshal(sink)
+	if err != nil {
+		return nil, err
+	}
+
+	// The log forwarder label keeps the forwarder from reading its own logs, see pkg/log
+	forwarderLabels := labels.Managed(appInstance, labels.AcornLogForwarder, "true")
+	sinkHash := sha256.Sum256(sinkData)
+
+	container := corev1.Container{
+		Name:  "log-forwarder",
+		Image: system.DefaultImage(),
+		Args:  []string{"forward-logs", appInstance.Status.Namespace},
+		Env: []corev1.EnvVar{
+			{
+				Name: "ACORN_LOG_SINK",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: logForwarderName,
+						},
+						Key: logForwarderSinkKey,
+					},
+				},
+			},
+		},
+		ImagePullPolicy: corev1.PullIfNotPresent,
+	}
+
+	var (
+		volumes  []corev1.Volume
+		affinity *corev1.Affinity
+	)
+	if sink.File != nil {
+		if _, ok := appInstance.Status.AppSpec.Volumes[sink.File.Volume]; !ok {
+			return nil, fmt.Errorf("volume %s of the log sink is not defined", sink.File.Volume)
+		}
+		if _, ephemeral := isEphemeral(appInstance, sink.File.Volume); ephemeral {
+			return nil, fmt.Errorf("volume %s of the log sink can not be ephemeral", sink.File.Volume)
+		}
+		claimName, _ := toVolumeName(appInstance, sink.File.Volume)
+		volumes = append(volumes, corev1.Volume{
+			Name: "log-sink",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+				},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      "log-sink",
+			MountPath: logForwarderVolumeDir,
+		})
+		affinity = logForwarderAffinity(appInstance, sink.File.Volume)
+	}
+
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      logForwarderName,
+			Namespace: appInstance.Status.Namespace,
+			Labels:    forwarderLabels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: forwarderLabels,
+			},
+			// Only one replica may run, otherwise every line is sent twice
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RecreateDeploymentStrategyType,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: forwarderLabels,
+					// Restart the forwarder when the sink changes, the environment isn't updated from the secret
+					Annotations: map[string]string{
+						labels.AcornSecretRevPrefix + logForwarderName: hex.EncodeToString(sinkHash[:]),
+					},
+				},
+				Spec: corev1.PodSpec{
+					TerminationGracePeriodSeconds: &[]int64{10}[0],
+					EnableServiceLinks:            new(bool),
+					Containers:                    []corev1.Container{container},
+					Volumes:                       volumes,
+					Affinity:                      affinity,
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      tolerations.WorkloadTolerationKey,
+							Operator: corev1.TolerationOpExists,
+						},
+					},
+					ServiceAccountName: logForwarderName,
+				},
+			},
+		},
+	}
+
+	if appInstance.GetStopped() {
+		dep.Spec.Replicas = new(int32)
+	}
+
+	result := []kclient.Object{
+		dep,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      logForwarderName,
+				Namespace: appInstance.Status.Namespace,
+				Labels:    forwarderLabels,
+			},
+			Data: map[string][]byte{
+				logForwarderSinkKey: sinkData,
+			},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      logForwarderName,
+				Namespace: appInstance.Status.Namespace,
+				Labels:    forwarderLabels,
+			},
+		},
+	}
+	return append(result, toRoleAndRoleBinding(logForwarderName, appInstance.Status.Namespace, logForwarderName,
+		appInstance.Status.Namespace, []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods/log"},
+				Verbs:     []string{"get"},
+			},
+		}, forwarderLabels, nil, appInstance)...), nil
+}
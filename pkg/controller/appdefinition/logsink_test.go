@@ -0,0 +1,20 @@
+package appdefinition
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	"github.com/acorn-io/runtime/pkg/scheme"
+)
+
+func TestLogSink(t *testing.T) {
+	dirs, err := os.ReadDir("testdata/logsink")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range dirs {
+		tester.DefaultTest(t, scheme.Scheme, filepath.Join("testdata/logsink", dir.Name()), DeploySpec)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/apppolicy"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	"github.com/acorn-io/runtime/pkg/condition"
 	"github.com/acorn-io/runtime/pkg/event"
@@ -21,10 +22,11 @@ import (
 
 func PullAppImage(transport http.RoundTripper, recorder event.Recorder) router.HandlerFunc {
 	return pullAppImage(transport, pullClient{
-		recorder: recorder,
-		resolve:  tags.ResolveLocal,
-		pull:     images.PullAppImage,
-		now:      metav1.NowMicro,
+		recorder:    recorder,
+		resolve:     tags.ResolveLocal,
+		pull:        images.PullAppImage,
+		checkPolicy: apppolicy.CheckAppImage,
+		now:         metav1.NowMicro,
 	})
 }
 
@@ -32,11 +34,14 @@ type resolveImageFunc func(ctx context.Context, c kclient.Client, namespace, ima
 
 type pullImageFunc func(ctx context.Context, c kclient.Reader, namespace, image, nestedDigest string, opts ...remote.Option) (*v1.AppImage, error)
 
+type checkPolicyFunc func(ctx context.Context, c kclient.Reader, app *v1.AppInstance, appImage *v1.AppImage) error
+
 type pullClient struct {
-	recorder event.Recorder
-	resolve  resolveImageFunc
-	pull     pullImageFunc
-	now      func() metav1.MicroTime
+	recorder    event.Recorder
+	resolve     resolveImageFunc
+	pull        pullImageFunc
+	checkPolicy checkPolicyFunc
+	now         func() metav1.MicroTime
 }
 
 func pullAppImage(transport http.RoundTripper, client pullClient) router.HandlerFunc {
@@ -54,6 +59,17 @@ func pullAppImage(transport http.RoundTripper, client pullClient) router.Handler
 			return nil
 		}
 
+		// A rollback deploys the image of the revision by its digest, under the image reference of the revision
+		pullTarget := target
+		if rev := appInstance.Status.RollbackRevision; rev != nil {
+			pinned, err := images.PinnedReference(rev.ImageID, rev.Digest)
+			if err != nil {
+				cond.Error(err)
+				return nil
+			}
+			pullTarget = pinned
+		}
+
 		// Skip the attempt to locally resolve if we already know that the image will be remote
 		var (
 			resolved string
@@ -61,17 +77,17 @@ func pullAppImage(transport http.RoundTripper, client pullClient) router.Handler
 			isLocal  bool
 		)
 		if !appInstance.Status.AvailableAppImageRemote {
-			resolved, isLocal, err = client.resolve(req.Ctx, req.Client, appInstance.Namespace, target)
+			resolved, isLocal, err = client.resolve(req.Ctx, req.Client, appInstance.Namespace, pullTarget)
 			if err != nil {
 				cond.Error(err)
 				return nil
 			}
 			if !isLocal {
 				// Force pull from remote, since the only local image we found was marked remote, and there might be a newer version
-				resolved = target
+				resolved = pullTarget
 			}
 		} else {
-			resolved = target
+			resolved = pullTarget
 		}
 
 		var (
@@ -94,7 +110,20 @@ func pullAppImage(transport http.RoundTripper, client pullClient) router.Handler
 			cond.Error(err)
 			return nil
 		}
+		if autoUpgradeOn {
+			// Images found by an auto-upgrade are not admitted by the API server, so check them against the app policy rules here
+			if err = client.checkPolicy(req.Ctx, req.Client, appInstance, targetImage); err != nil {
+				cond.Error(err)
+				return nil
+			}
+		}
 		targetImage.Name = target
+		if appInstance.Status.RollbackRevision != nil {
+			appInstance.Status.RollbackRevision = nil
+		} else if target != appInstance.Status.AvailableAppImage {
+			// The change cause only applies to the image that the auto-upgrade found
+			appInstance.Status.ChangeCause = ""
+		}
 		appInstance.Status.AvailableAppImage = ""
 		appInstance.Status.ConfirmUpgradeAppImage = ""
 		appInstance.Status.AppImage = *targetImage
@@ -105,6 +134,10 @@ func pullAppImage(transport http.RoundTripper, client pullClient) router.Handler
 }
 
 func determineTargetImage(appInstance *v1.AppInstance) (string, string) {
+	if rev := appInstance.Status.RollbackRevision; rev != nil {
+		return rev.Image, ""
+	}
+
 	_, on := autoupgrade.Mode(appInstance.Spec)
 	pattern, isPattern := autoupgrade.AutoUpgradePattern(appInstance.Spec.Image)
 
@@ -236,6 +236,44 @@ func pullImageTo(image *v1.AppImage, err error) pullImageFunc {
 	}
 }
 
+func TestPullAppImageRollbackRevision(t *testing.T) {
+	appInstance := app("acorn.io/img:1", "acorn.io/img:2", "", "", false, false)
+	appInstance.Status.ChangeCause = v1.AppRevisionTriggerRollback
+	appInstance.Status.RollbackRevision = &v1.AppRevision{
+		Revision: 1,
+		Image:    "acorn.io/img:1",
+		ImageID:  "acorn.io/img:1",
+		Digest:   "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+	}
+
+	var pulled string
+	handler := pullAppImage(nil, pullClient{
+		recorder: event.RecorderFunc(func(context.Context, *apiv1.Event) error { return nil }),
+		resolve: func(_ context.Context, _ kclient.Client, _, image string) (string, bool, error) {
+			return image, false, nil
+		},
+		pull: func(_ context.Context, _ kclient.Reader, _, image, _ string, _ ...remote.Option) (*v1.AppImage, error) {
+			pulled = image
+			return &v1.AppImage{Name: image, Digest: appInstance.Status.RollbackRevision.Digest}, nil
+		},
+		now: func() metav1.MicroTime {
+			return metav1.NowMicro()
+		},
+	})
+
+	req := tester.NewRequest(t, scheme.Scheme, appInstance)
+	require.NoError(t, handler(req, &tester.Response{Client: req.Client.(*tester.Client)}))
+
+	// The digest of the revision is pulled and deployed under the image reference of the revision
+	assert.Equal(t, "acorn.io/img@sha256:1111111111111111111111111111111111111111111111111111111111111111", pulled)
+	assert.Equal(t, "acorn.io/img:1", appInstance.Status.AppImage.Name)
+	assert.Nil(t, appInstance.Status.RollbackRevision)
+	assert.Equal(t, v1.AppRevisionTriggerRollback, appInstance.Status.ChangeCause)
+
+	target, _ := determineTargetImage(appInstance)
+	assert.Empty(t, target)
+}
+
 func testRecordPullEvent(t *testing.T, testName string, appInstance *v1.AppInstance, resolve resolveImageFunc, pull pullImageFunc, now v1.MicroTime, expect *apiv1.Event) {
 	t.Helper()
 	var recording []*apiv1.Event
@@ -248,6 +286,9 @@ func testRecordPullEvent(t *testing.T, testName string, appInstance *v1.AppInsta
 		recorder: event.RecorderFunc(fakeRecorder),
 		resolve:  resolve,
 		pull:     pull,
+		checkPolicy: func(context.Context, kclient.Reader, *v1.AppInstance, *v1.AppImage) error {
+			return nil
+		},
 		now: func() metav1.MicroTime {
 			return metav1.MicroTime(now)
 		},
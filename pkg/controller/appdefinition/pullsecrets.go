@@ -12,7 +12,11 @@ import (
 )
 
 type PullSecrets struct {
-	objects  []kclient.Object
+	objects []kclient.Object
+	// images and index track the images and position of each secret, so that containers that share a secret
+	// (like both revisions of a container during a rollout) get one secret for all of their images
+	images   map[string][]string
+	index    map[string]int
 	keychain authn.Keychain
 	app      *v1.AppInstance
 	errs     []error
@@ -25,6 +29,8 @@ func NewPullSecrets(req router.Request, appInstance *v1.AppInstance) (*PullSecre
 	}
 
 	return &PullSecrets{
+		images:   map[string][]string{},
+		index:    map[string]int{},
 		keychain: keychain,
 		app:      appInstance,
 	}, nil
@@ -64,13 +70,20 @@ func (p *PullSecrets) ForContainer(containerName string, containers []corev1.Con
 	}
 
 	secretName := name.SafeConcatName(containerName, "pull", p.app.ShortID())
+	images = append(p.images[secretName], images...)
 	secret, err := pullsecret.ForImages(secretName, p.app.Status.Namespace, p.keychain, images...)
 	if err != nil {
 		p.errs = append(p.errs, err)
 		return nil
 	}
 
-	p.objects = append(p.objects, secret)
+	p.images[secretName] = images
+	if i, ok := p.index[secretName]; ok {
+		p.objects[i] = secret
+	} else {
+		p.index[secretName] = len(p.objects)
+		p.objects = append(p.objects, secret)
+	}
 	return []corev1.LocalObjectReference{
 		{
 			Name: secretName,
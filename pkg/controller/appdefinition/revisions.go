@@ -0,0 +1,88 @@
+package appdefinition
+
+import (
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/strings/slices"
+)
+
+// MaxAppRevisions is the number of revisions kept in the history of an app
+const MaxAppRevisions = 10
+
+// RecordRevision adds a revision to the history of the app when the app image, deploy args or profiles of the app
+// change. The history is used by `acorn app history` and `acorn app rollback`.
+func RecordRevision(req router.Request, resp router.Response) error {
+	return recordRevision(req, metav1.Now)
+}
+
+func recordRevision(req router.Request, now func() metav1.Time) error {
+	appInstance := req.Object.(*v1.AppInstance)
+
+	if !appInstance.DeletionTimestamp.IsZero() ||
+		appInstance.Status.AppImage.ID == "" ||
+		!appInstance.Status.Condition(v1.AppInstanceConditionPulled).Success ||
+		!appInstance.Status.Condition(v1.AppInstanceConditionParsed).Success {
+		return nil
+	}
+
+	// Only record the revision once the image that the app spec asks for is deployed
+	if target, _ := determineTargetImage(appInstance); target != "" {
+		return nil
+	}
+
+	var (
+		profiles = appInstance.Spec.Profiles
+		digest   = appInstance.Status.AppImage.GetDigest()
+		trigger  = revisionTrigger(appInstance)
+	)
+	// The change cause only applies to the change of the app image that is deployed now
+	appInstance.Status.ChangeCause = ""
+
+	latest, hasLatest := appInstance.Status.GetRevision(0)
+	if hasLatest && latest.Digest == digest &&
+		equality.Semantic.DeepEqual(latest.DeployArgs, appInstance.Spec.DeployArgs) &&
+		slices.Equal(latest.Profiles, profiles) {
+		return nil
+	}
+
+	revision := v1.AppRevision{
+		Revision:   latest.Revision + 1,
+		Image:      appInstance.Status.AppImage.Name,
+		ImageID:    appInstance.Status.AppImage.ID,
+		Digest:     digest,
+		DeployArgs: appInstance.Spec.DeployArgs.DeepCopy(),
+		Profiles:   profiles,
+		Trigger:    trigger,
+		Created:    now(),
+	}
+	if revision.Trigger != v1.AppRevisionTriggerAutoUpgrade {
+		revision.UpdatedBy = appInstance.Annotations[labels.AcornAppUpdatedBy]
+	}
+
+	revisions := append(appInstance.Status.Revisions, revision)
+	if len(revisions) > MaxAppRevisions {
+		revisions = revisions[len(revisions)-MaxAppRevisions:]
+	}
+	appInstance.Status.Revisions = revisions
+	return nil
+}
+
+// revisionTrigger returns what caused the app to change. Auto-upgrades and rollbacks that change the app image without
+// an update of the app set the change cause in the status of the app. Clients can set the change cause annotation to
+// describe an update.
+func revisionTrigger(appInstance *v1.AppInstance) string {
+	switch {
+	case appInstance.Status.GetDevMode():
+		return v1.AppRevisionTriggerDev
+	case len(appInstance.Status.Revisions) == 0:
+		return v1.AppRevisionTriggerCreate
+	case appInstance.Status.ChangeCause != "":
+		return appInstance.Status.ChangeCause
+	case appInstance.Annotations[labels.AcornAppChangeCause] != "":
+		return appInstance.Annotations[labels.AcornAppChangeCause]
+	}
+	return v1.AppRevisionTriggerUpdate
+}
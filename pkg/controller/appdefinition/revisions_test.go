@@ -0,0 +1,160 @@
+package appdefinition
+
+import (
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func revisionApp(digest string) *v1.AppInstance {
+	return &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "app-name",
+			Namespace:  "app-namespace",
+			Generation: 1,
+			Annotations: map[string]string{
+				labels.AcornAppUpdatedBy: "admin",
+			},
+		},
+		Spec: v1.AppInstanceSpec{
+			Image:      "image-name",
+			DeployArgs: v1.GenericMap{"replicas": int64(1)},
+		},
+		Status: v1.AppInstanceStatus{
+			ObservedGeneration: 1,
+			AppImage: v1.AppImage{
+				Name:   "image-name",
+				ID:     "image-id",
+				Digest: digest,
+			},
+			Conditions: []v1.Condition{
+				{Type: v1.AppInstanceConditionPulled, Success: true},
+				{Type: v1.AppInstanceConditionParsed, Success: true},
+			},
+		},
+	}
+}
+
+func runRecordRevision(t *testing.T, app *v1.AppInstance) {
+	t.Helper()
+	req := tester.NewRequest(t, scheme.Scheme, app)
+	require.NoError(t, recordRevision(req, func() metav1.Time { return rolloutNow }))
+}
+
+func TestRecordRevisionCreate(t *testing.T) {
+	app := revisionApp("sha256:one")
+	runRecordRevision(t, app)
+
+	require.Len(t, app.Status.Revisions, 1)
+	assert.Equal(t, v1.AppRevision{
+		Revision:   1,
+		Image:      "image-name",
+		ImageID:    "image-id",
+		Digest:     "sha256:one",
+		DeployArgs: v1.GenericMap{"replicas": int64(1)},
+		Trigger:    v1.AppRevisionTriggerCreate,
+		UpdatedBy:  "admin",
+		Created:    rolloutNow,
+	}, app.Status.Revisions[0])
+
+	// Nothing changed, so no new revision
+	runRecordRevision(t, app)
+	assert.Len(t, app.Status.Revisions, 1)
+}
+
+func TestRecordRevisionTriggers(t *testing.T) {
+	app := revisionApp("sha256:one")
+	runRecordRevision(t, app)
+
+	// The auto-upgrade daemon sets the change cause of the new image
+	app.Status.AppImage.Digest = "sha256:two"
+	app.Status.ChangeCause = v1.AppRevisionTriggerAutoUpgrade
+	runRecordRevision(t, app)
+	require.Len(t, app.Status.Revisions, 2)
+	assert.Equal(t, int64(2), app.Status.Revisions[1].Revision)
+	assert.Equal(t, v1.AppRevisionTriggerAutoUpgrade, app.Status.Revisions[1].Trigger)
+	assert.Empty(t, app.Status.Revisions[1].UpdatedBy)
+	assert.Empty(t, app.Status.ChangeCause)
+
+	// A new image without a change cause is an update, even if the app spec was already observed
+	app.Status.AppImage.Digest = "sha256:three"
+	runRecordRevision(t, app)
+	require.Len(t, app.Status.Revisions, 3)
+	assert.Equal(t, v1.AppRevisionTriggerUpdate, app.Status.Revisions[2].Trigger)
+	assert.Equal(t, "admin", app.Status.Revisions[2].UpdatedBy)
+
+	// Changing the deploy args is an update
+	app.Generation = 2
+	app.Spec.DeployArgs = v1.GenericMap{"replicas": int64(2)}
+	runRecordRevision(t, app)
+	require.Len(t, app.Status.Revisions, 4)
+	assert.Equal(t, v1.AppRevisionTriggerUpdate, app.Status.Revisions[3].Trigger)
+	assert.Equal(t, "admin", app.Status.Revisions[3].UpdatedBy)
+
+	// Rollbacks set the change cause and keep the user that made them
+	app.Status.ChangeCause = v1.AppRevisionTriggerRollback
+	app.Status.AppImage.Digest = "sha256:one"
+	runRecordRevision(t, app)
+	require.Len(t, app.Status.Revisions, 5)
+	assert.Equal(t, v1.AppRevisionTriggerRollback, app.Status.Revisions[4].Trigger)
+	assert.Equal(t, "admin", app.Status.Revisions[4].UpdatedBy)
+
+	// The change cause of the client is used as the trigger
+	app.Generation = 3
+	app.Annotations[labels.AcornAppChangeCause] = "restore"
+	app.Status.AppImage.Digest = "sha256:two"
+	runRecordRevision(t, app)
+	require.Len(t, app.Status.Revisions, 6)
+	assert.Equal(t, "restore", app.Status.Revisions[5].Trigger)
+
+	// Changes in a dev session
+	app.Status.DevSession = &v1.DevSessionInstanceSpec{}
+	app.Spec.Profiles = []string{"dev"}
+	runRecordRevision(t, app)
+	require.Len(t, app.Status.Revisions, 7)
+	assert.Equal(t, v1.AppRevisionTriggerDev, app.Status.Revisions[6].Trigger)
+}
+
+func TestRecordRevisionWaitsForImage(t *testing.T) {
+	app := revisionApp("sha256:one")
+	app.Spec.Image = "new-image-name"
+	runRecordRevision(t, app)
+	assert.Empty(t, app.Status.Revisions)
+
+	app = revisionApp("sha256:one")
+	app.Status.Conditions[1].Success = false
+	runRecordRevision(t, app)
+	assert.Empty(t, app.Status.Revisions)
+
+	// The image of a rollback is not pulled yet
+	app = revisionApp("sha256:one")
+	app.Status.RollbackRevision = &v1.AppRevision{Image: "image-name", ImageID: "image-id", Digest: "sha256:zero"}
+	app.Status.ChangeCause = v1.AppRevisionTriggerRollback
+	runRecordRevision(t, app)
+	assert.Empty(t, app.Status.Revisions)
+	assert.Equal(t, v1.AppRevisionTriggerRollback, app.Status.ChangeCause)
+}
+
+func TestRecordRevisionLimit(t *testing.T) {
+	app := revisionApp("sha256:one")
+	for i := 0; i < MaxAppRevisions+5; i++ {
+		app.Spec.DeployArgs = v1.GenericMap{"replicas": int64(i)}
+		runRecordRevision(t, app)
+	}
+
+	require.Len(t, app.Status.Revisions, MaxAppRevisions)
+	assert.Equal(t, int64(6), app.Status.Revisions[0].Revision)
+	assert.Equal(t, int64(MaxAppRevisions+5), app.Status.Revisions[MaxAppRevisions-1].Revision)
+
+	revision, ok := app.Status.GetRevision(8)
+	assert.True(t, ok)
+	assert.Equal(t, v1.GenericMap{"replicas": int64(7)}, revision.DeployArgs)
+	_, ok = app.Status.GetRevision(1)
+	assert.False(t, ok)
+}
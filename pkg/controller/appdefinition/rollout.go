@@ -0,0 +1,321 @@
+package appdefinition
+
+import (
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/pdb"
+	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/runtime/pkg/secrets"
+	"github.com/google/go-containerregistry/pkg/name"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Rollout moves the rollout of a new app image through the steps of the rollout strategy of the app. While no rollout is
+// in progress the current app image and spec are recorded as the stable revision, so that the containers of the stable
+// revision can keep running next to the containers of a new app image until the rollout is promoted.
+func Rollout(req router.Request, resp router.Response) error {
+	return rollout(req, resp, metav1.Now)
+}
+
+func rollout(req router.Request, resp router.Response, now func() metav1.Time) error {
+	appInstance := req.Object.(*v1.AppInstance)
+	strategy := appInstance.Status.AppSpec.Rollout.GetStrategy()
+
+	if strategy == v1.RolloutStrategyRolling || appInstance.Status.GetDevMode() || appInstance.Status.AppImage.GetDigest() == "" {
+		appInstance.Status.Rollout = nil
+		return nil
+	}
+
+	if appInstance.Status.Rollout == nil {
+		appInstance.Status.Rollout = &v1.RolloutStatus{}
+	}
+	status := appInstance.Status.Rollout
+	status.Strategy = strategy
+
+	if !status.InProgress(appInstance.Status.AppImage) || appInstance.GetStopped() {
+		completeRollout(appInstance)
+		return nil
+	}
+
+	if digest := appInstance.Status.AppImage.GetDigest(); status.Digest != digest {
+		// A new rollout was started or the app image was changed again during the rollout, so start from the first step
+		*status = v1.RolloutStatus{
+			Strategy: status.Strategy,
+			Stable:   status.Stable,
+			Digest:   digest,
+		}
+	}
+
+	if status.PromoteFull {
+		completeRollout(appInstance)
+		return nil
+	}
+
+	steps := appInstance.Status.AppSpec.Rollout.Steps
+	if strategy == v1.RolloutStrategyCanary {
+		if status.Step >= len(steps) {
+			completeRollout(appInstance)
+			return nil
+		}
+		status.Weight = steps[status.Step].Weight
+	}
+
+	status.Ready = rolloutReady(appInstance)
+	if !status.Ready {
+		status.StepStartTime = nil
+		return nil
+	}
+
+	if status.StepStartTime == nil {
+		startTime := now()
+		status.StepStartTime = &startTime
+	}
+
+	advance := status.Promote
+	if !advance && strategy == v1.RolloutStrategyCanary && steps[status.Step].Pause != nil {
+		remaining := steps[status.Step].Pause.Duration - now().Sub(status.StepStartTime.Time)
+		if remaining > 0 {
+			resp.RetryAfter(remaining)
+		} else {
+			advance = true
+		}
+	}
+	if !advance {
+		return nil
+	}
+
+	status.Promote = false
+	status.Ready = false
+	status.StepStartTime = nil
+	status.Step++
+	if strategy == v1.RolloutStrategyBlueGreen || status.Step >= len(steps) {
+		completeRollout(appInstance)
+	} else {
+		status.Weight = steps[status.Step].Weight
+	}
+	return nil
+}
+
+// completeRollout makes the current app image the stable revision
+func completeRollout(appInstance *v1.AppInstance) {
+	appInstance.Status.Rollout = &v1.RolloutStatus{
+		Strategy: appInstance.Status.Rollout.Strategy,
+		Stable: v1.RolloutRevision{
+			AppImage: appInstance.Status.AppImage,
+			AppSpec:  appInstance.Status.AppSpec,
+		},
+		RolledBackImage:  appInstance.Status.Rollout.RolledBackImage,
+		RolledBackDigest: appInstance.Status.Rollout.RolledBackDigest,
+	}
+}
+
+// rolloutReady returns true if all containers of the new revision are ready with the replicas of the current step
+func rolloutReady(appInstance *v1.AppInstance) bool {
+	status := appInstance.Status.Rollout
+	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Containers) {
+		containerName, container := entry.Key, entry.Value
+		if ports.IsLinked(appInstance, containerName) {
+			continue
+		}
+
+		containerStatus := appInstance.Status.AppStatus.Containers[containerName]
+		if appInstance.Status.RolloutInPlace(containerName) {
+			// Containers with volumes that can only be attached once and new containers are updated in place
+			if !containerStatus.Ready {
+				return false
+			}
+			continue
+		}
+
+		_, replicas := rolloutReplicas(container, status)
+		if containerStatus.Rollout == nil ||
+			containerStatus.Rollout.Revision != status.Revision() ||
+			containerStatus.Rollout.DesiredReplicaCount != replicas ||
+			!containerStatus.Rollout.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// rolloutReplicas returns the replicas of the stable and the new revision of a container for the current step of the rollout.
+// The stable revision keeps all of its replicas until the rollout is complete. The traffic of a canary rollout is split by
+// the rollout proxy of the container, so the canary only needs its share of the replicas, and at least one.
+func rolloutReplicas(container v1.Container, status *v1.RolloutStatus) (stable, next int32) {
+	total := container.GetScale()
+	if status.Strategy == v1.RolloutStrategyBlueGreen || total == 0 {
+		return total, total
+	}
+
+	next = (total*status.Weight + 99) / 100
+	if next < 1 {
+		next = 1
+	}
+	return total, next
+}
+
+// toRolloutDeployments renders the deployments of the stable revision next to the deployments of the new revision of
+// the containers. Containers that can not run twice because of their volumes are updated in place.
+func toRolloutDeployments(req router.Request, appInstance *v1.AppInstance, tag name.Reference, pullSecrets *PullSecrets, interpolator *secrets.Interpolator) ([]kclient.Object, error) {
+	status := appInstance.Status.Rollout
+
+	// Apps with these names are rejected when they are created or updated, but not when they are auto-upgraded
+	for _, appSpec := range []v1.AppSpec{status.Stable.AppSpec, appInstance.Status.AppSpec} {
+		if objectName, containerName, ok := appSpec.RolloutNameConflict(); ok {
+			return nil, fmt.Errorf("name %s is reserved for the rollouts of container %s", objectName, containerName)
+		}
+	}
+
+	stableApp := appInstance.DeepCopy()
+	stableApp.Status.AppImage = status.Stable.AppImage
+	stableApp.Status.AppSpec = status.Stable.AppSpec
+
+	stableTag, err := images.GetRuntimePullableImageReference(req.Ctx, req.Client, appInstance.Namespace, status.Stable.AppImage.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	stableObjs, err := ToDeployments(req, stableApp, stableTag, pullSecrets, interpolator)
+	if err != nil {
+		return nil, err
+	}
+
+	nextObjs, err := ToDeployments(req, appInstance, tag, pullSecrets, interpolator)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		inPlace    = map[string]bool{}
+		stableDeps = map[string]*appsv1.Deployment{}
+		result     = newObjectList()
+	)
+	for containerName := range appInstance.Status.AppSpec.Containers {
+		if appInstance.Status.RolloutInPlace(containerName) {
+			inPlace[containerName] = true
+		}
+	}
+
+	for _, obj := range stableObjs {
+		containerName := obj.GetLabels()[labels.AcornContainerName]
+		if inPlace[containerName] {
+			continue
+		}
+		if dep, ok := obj.(*appsv1.Deployment); ok {
+			stableDeps[containerName] = dep
+		}
+		result.add(obj)
+	}
+
+	for _, obj := range nextObjs {
+		containerName := obj.GetLabels()[labels.AcornContainerName]
+		if inPlace[containerName] {
+			result.add(obj)
+			continue
+		}
+
+		switch obj := obj.(type) {
+		case *appsv1.Deployment:
+			dep := toRevisionDeployment(appInstance.Status.AppSpec.Containers[containerName], obj, status)
+			result.add(dep)
+			result.add(pdb.ToPodDisruptionBudget(dep))
+			if status.Revision() == v1.RolloutRevisionPreview {
+				if svc := toRevisionService(appInstance, containerName, dep.Name, v1.RolloutRevisionPreview, dep); svc != nil {
+					result.add(svc)
+				}
+			} else if stableDep := stableDeps[containerName]; stableDep != nil && appInstance.Status.RolloutProxied(containerName) {
+				for _, obj := range toRolloutProxy(appInstance, containerName, stableDep, dep) {
+					result.add(obj)
+				}
+			}
+		case *policyv1.PodDisruptionBudget, *autoscalingv2.HorizontalPodAutoscaler:
+			// The new revision is not autoscaled during the rollout and gets its own PodDisruptionBudget
+		default:
+			// The service account and permissions are shared by both revisions, use the ones of the new revision
+			result.add(obj)
+		}
+	}
+
+	return result.objects, nil
+}
+
+// toRevisionDeployment turns the deployment of a container into the deployment of the new revision. The pods are labeled
+// as a separate container, so that the services of the container only send them traffic through the rollout proxy during
+// a canary rollout, or through the preview service during a blue/green rollout.
+func toRevisionDeployment(container v1.Container, dep *appsv1.Deployment, status *v1.RolloutStatus) *appsv1.Deployment {
+	revision := status.Revision()
+	dep.Name = status.DeploymentName(dep.Name)
+
+	revisionLabels := map[string]string{
+		labels.AcornRolloutRevision: revision,
+		labels.AcornContainerName:   dep.Name,
+	}
+
+	dep.Labels = labels.Merge(dep.Labels, revisionLabels)
+	dep.Spec.Selector.MatchLabels = labels.Merge(dep.Spec.Selector.MatchLabels, revisionLabels)
+	dep.Spec.Template.Labels = labels.Merge(dep.Spec.Template.Labels, revisionLabels)
+
+	if dep.Spec.Template.Spec.Hostname != "" {
+		dep.Spec.Template.Spec.Hostname = dep.Name
+	}
+
+	_, replicas := rolloutReplicas(container, status)
+	dep.Spec.Replicas = &replicas
+	return dep
+}
+
+// toRevisionService returns a service for the ports of a container that only targets the pods of the given deployment
+func toRevisionService(appInstance *v1.AppInstance, containerName, serviceName, revision string, dep *appsv1.Deployment) *corev1.Service {
+	container := appInstance.Status.AppSpec.Containers[containerName]
+	containerPorts := ports.CollectContainerPorts(&container, appInstance.Status.GetDevMode())
+	if len(containerPorts) == 0 {
+		return nil
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: dep.Namespace,
+			Labels: labels.Managed(appInstance,
+				labels.AcornContainerName, serviceName,
+				labels.AcornRolloutRevision, revision),
+		},
+		Spec: corev1.ServiceSpec{
+			Ports:    ports.ToServicePorts(containerPorts),
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: dep.Spec.Selector.MatchLabels,
+		},
+	}
+}
+
+// objectList keeps the last object added for each kind and name, in the order the objects were first added
+type objectList struct {
+	objects []kclient.Object
+	index   map[string]int
+}
+
+func newObjectList() *objectList {
+	return &objectList{
+		index: map[string]int{},
+	}
+}
+
+func (o *objectList) add(obj kclient.Object) {
+	key := fmt.Sprintf("%T/%s", obj, obj.GetName())
+	if i, ok := o.index[key]; ok {
+		o.objects[i] = obj
+		return
+	}
+	o.index[key] = len(o.objects)
+	o.objects = append(o.objects, obj)
+}
@@ -0,0 +1,217 @@
+package appdefinition
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/pdb"
+	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tolerations"
+	name2 "github.com/rancher/wrangler/pkg/name"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// toRolloutProxy returns the proxy that splits the traffic of a container between the stable and the canary revision
+// during a canary rollout, with the services of both revisions it sends the traffic to. The service of the container
+// targets the proxy until the rollout is complete.
+func toRolloutProxy(appInstance *v1.AppInstance, containerName string, stableDep, canaryDep *appsv1.Deployment) []kclient.Object {
+	status := appInstance.Status.Rollout
+	container := appInstance.Status.AppSpec.Containers[containerName]
+	containerPorts := ports.CollectContainerPorts(&container, appInstance.Status.GetDevMode())
+	if len(containerPorts) == 0 {
+		return nil
+	}
+
+	stableName := status.ServiceName(containerName, v1.RolloutRevisionStable)
+	stableService := toRevisionService(appInstance, containerName, stableName, v1.RolloutRevisionStable, stableDep)
+	canaryService := toRevisionService(appInstance, containerName, canaryDep.Name, v1.RolloutRevisionCanary, canaryDep)
+
+	proxyName := status.ProxyName(containerName)
+	conf := toRolloutProxyConf(stableService.Name, canaryService.Name, containerPorts, status.Weight)
+	hash := sha256.Sum256([]byte(conf))
+	confName := name2.SafeConcatName(proxyName, hex.EncodeToString(hash[:])[:8])
+
+	proxyLabels := labels.Managed(appInstance, labels.AcornRolloutProxy, containerName)
+
+	var (
+		containerPortList []corev1.ContainerPort
+		readinessProbe    *corev1.Probe
+	)
+	for _, port := range containerPorts {
+		port = port.Complete()
+		protocol := corev1.ProtocolTCP
+		if port.Protocol == v1.ProtocolUDP {
+			protocol = corev1.ProtocolUDP
+		} else if readinessProbe == nil {
+			readinessProbe = &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{
+					TCPSocket: &corev1.TCPSocketAction{
+						Port: intstr.FromInt(int(port.TargetPort)),
+					},
+				},
+			}
+		}
+		containerPortList = append(containerPortList, corev1.ContainerPort{
+			ContainerPort: port.TargetPort,
+			Protocol:      protocol,
+		})
+	}
+
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      proxyName,
+			Namespace: appInstance.Status.Namespace,
+			Labels:    proxyLabels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: proxyLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: proxyLabels,
+				},
+				Spec: corev1.PodSpec{
+					TerminationGracePeriodSeconds: &[]int64{5}[0],
+					EnableServiceLinks:            new(bool),
+					AutomountServiceAccountToken:  new(bool),
+					Containers: []corev1.Container{
+						{
+							Name:    "nginx",
+							Image:   system.DefaultImage(),
+							Command: []string{"/docker-entrypoint.sh"},
+							Args: []string{
+								"nginx",
+								"-g",
+								"daemon off;",
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "conf",
+									ReadOnly:  true,
+									MountPath: "/etc/nginx/nginx.conf",
+									SubPath:   "config",
+								},
+							},
+							Ports:          containerPortList,
+							ReadinessProbe: readinessProbe,
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "conf",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: confName,
+									},
+								},
+							},
+						},
+					},
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      tolerations.WorkloadTolerationKey,
+							Operator: corev1.TolerationOpExists,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return []kclient.Object{
+		stableService,
+		canaryService,
+		dep,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      confName,
+				Namespace: dep.Namespace,
+				Labels:    proxyLabels,
+			},
+			Data: map[string]string{
+				"config": conf,
+			},
+		},
+		pdb.ToPodDisruptionBudget(dep),
+	}
+}
+
+// toRolloutProxyConf returns the nginx configuration of the rollout proxy. Each port of the container has an upstream that
+// sends weight percent of the requests, or of the connections for tcp and udp ports, to the canary service and the rest
+// to the stable service. The proxy listens on the target ports of the container, so the service of the container can
+// target it without changes.
+func toRolloutProxyConf(stableService, canaryService string, containerPorts []v1.PortDef, weight int32) string {
+	var (
+		httpBuf   = &strings.Builder{}
+		streamBuf = &strings.Builder{}
+	)
+
+	for _, port := range containerPorts {
+		port = port.Complete()
+		buf := streamBuf
+		if port.Protocol == v1.ProtocolHTTP {
+			buf = httpBuf
+		}
+
+		upstream := "port_" + strconv.Itoa(int(port.Port))
+		buf.WriteString("upstream " + upstream + " {\n")
+		writeProxyServer(buf, stableService, port.Port, 100-weight)
+		writeProxyServer(buf, canaryService, port.Port, weight)
+		buf.WriteString("}\n")
+
+		buf.WriteString("server {\n")
+		switch port.Protocol {
+		case v1.ProtocolHTTP:
+			buf.WriteString("  listen " + strconv.Itoa(int(port.TargetPort)) + ";\n")
+			buf.WriteString("  location / {\n")
+			buf.WriteString("    proxy_pass http://" + upstream + ";\n")
+			buf.WriteString("    proxy_http_version 1.1;\n")
+			buf.WriteString("    proxy_set_header Host $http_host;\n")
+			buf.WriteString("    proxy_set_header Upgrade $http_upgrade;\n")
+			buf.WriteString("    proxy_set_header Connection $connection_upgrade;\n")
+			buf.WriteString("  }\n")
+		case v1.ProtocolUDP:
+			buf.WriteString("  listen " + strconv.Itoa(int(port.TargetPort)) + " udp;\n")
+			buf.WriteString("  proxy_pass " + upstream + ";\n")
+		default:
+			buf.WriteString("  listen " + strconv.Itoa(int(port.TargetPort)) + ";\n")
+			buf.WriteString("  proxy_pass " + upstream + ";\n")
+		}
+		buf.WriteString("}\n")
+	}
+
+	buf := &strings.Builder{}
+	buf.WriteString("events {\n}\n")
+	if httpBuf.Len() > 0 {
+		buf.WriteString("http {\n")
+		buf.WriteString("map $http_upgrade $connection_upgrade {\n  default upgrade;\n  \"\" close;\n}\n")
+		buf.WriteString(httpBuf.String())
+		buf.WriteString("}\n")
+	}
+	if streamBuf.Len() > 0 {
+		buf.WriteString("stream {\n")
+		buf.WriteString(streamBuf.String())
+		buf.WriteString("}\n")
+	}
+	return buf.String()
+}
+
+func writeProxyServer(buf *strings.Builder, service string, port, weight int32) {
+	buf.WriteString("  server " + service + ":" + strconv.Itoa(int(port)))
+	if weight == 0 {
+		buf.WriteString(" down;\n")
+	} else {
+		buf.WriteString(" weight=" + strconv.Itoa(int(weight)) + ";\n")
+	}
+}
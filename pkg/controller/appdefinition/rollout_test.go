@@ -0,0 +1,237 @@
+package appdefinition
+
+import (
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var rolloutNow = metav1.NewTime(time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC))
+
+func rolloutApp(rollout *v1.Rollout, status *v1.RolloutStatus) *v1.AppInstance {
+	return &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-name",
+			Namespace: "app-namespace",
+		},
+		Status: v1.AppInstanceStatus{
+			AppImage: v1.AppImage{
+				ID:     "new",
+				Digest: "sha256:new",
+			},
+			AppSpec: v1.AppSpec{
+				Rollout: rollout,
+				Containers: map[string]v1.Container{
+					"web": {
+						Image: "new-image",
+						Scale: &[]int32{4}[0],
+					},
+				},
+			},
+			Rollout: status,
+		},
+	}
+}
+
+func setCanaryReady(app *v1.AppInstance, replicas int32) {
+	app.Status.AppStatus.Containers = map[string]v1.ContainerStatus{
+		"web": {
+			Rollout: &v1.ContainerRolloutStatus{
+				Revision:            app.Status.Rollout.Revision(),
+				ReadyReplicaCount:   replicas,
+				DesiredReplicaCount: replicas,
+				Ready:               true,
+			},
+		},
+	}
+}
+
+func runRollout(t *testing.T, app *v1.AppInstance, now metav1.Time) *tester.Response {
+	t.Helper()
+	req := tester.NewRequest(t, scheme.Scheme, app)
+	resp := &tester.Response{Client: req.Client.(*tester.Client)}
+	require.NoError(t, rollout(req, resp, func() metav1.Time { return now }))
+	return resp
+}
+
+func stableStatus(strategy string) *v1.RolloutStatus {
+	return &v1.RolloutStatus{
+		Strategy: strategy,
+		Stable: v1.RolloutRevision{
+			AppImage: v1.AppImage{
+				ID:     "stable",
+				Digest: "sha256:stable",
+			},
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {
+						Image: "stable-image",
+						Scale: &[]int32{4}[0],
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRolloutRollingStrategy(t *testing.T) {
+	app := rolloutApp(nil, stableStatus(v1.RolloutStrategyCanary))
+	runRollout(t, app, rolloutNow)
+	assert.Nil(t, app.Status.Rollout)
+}
+
+func TestRolloutRecordsStableRevision(t *testing.T) {
+	app := rolloutApp(&v1.Rollout{Strategy: v1.RolloutStrategyBlueGreen}, nil)
+	runRollout(t, app, rolloutNow)
+
+	assert.False(t, app.Status.Rollout.InProgress(app.Status.AppImage))
+	assert.Equal(t, app.Status.AppImage, app.Status.Rollout.Stable.AppImage)
+	assert.Equal(t, app.Status.AppSpec, app.Status.Rollout.Stable.AppSpec)
+}
+
+func TestRolloutCanarySteps(t *testing.T) {
+	app := rolloutApp(&v1.Rollout{
+		Strategy: v1.RolloutStrategyCanary,
+		Steps: []v1.RolloutStep{
+			{Weight: 25, Pause: &metav1.Duration{Duration: time.Minute}},
+			{Weight: 50},
+		},
+	}, stableStatus(v1.RolloutStrategyCanary))
+
+	// The canary is not ready yet
+	runRollout(t, app, rolloutNow)
+	assert.True(t, app.Status.Rollout.InProgress(app.Status.AppImage))
+	assert.Equal(t, "sha256:new", app.Status.Rollout.Digest)
+	assert.Equal(t, int32(25), app.Status.Rollout.Weight)
+	assert.False(t, app.Status.Rollout.Ready)
+	assert.Nil(t, app.Status.Rollout.StepStartTime)
+
+	// The canary is ready, so the pause of the first step starts
+	setCanaryReady(app, 1)
+	resp := runRollout(t, app, rolloutNow)
+	assert.True(t, app.Status.Rollout.Ready)
+	assert.Equal(t, rolloutNow, *app.Status.Rollout.StepStartTime)
+	assert.Equal(t, time.Minute, resp.Delay)
+
+	// Once the pause is over the rollout moves to the second step
+	runRollout(t, app, metav1.NewTime(rolloutNow.Add(time.Minute)))
+	assert.Equal(t, 1, app.Status.Rollout.Step)
+	assert.Equal(t, int32(50), app.Status.Rollout.Weight)
+	assert.False(t, app.Status.Rollout.Ready)
+
+	// The ready status of the first step does not count for the replicas of the second step
+	runRollout(t, app, rolloutNow)
+	assert.False(t, app.Status.Rollout.Ready)
+
+	// The second step has no pause, so it waits to be promoted
+	setCanaryReady(app, 2)
+	resp = runRollout(t, app, rolloutNow)
+	assert.True(t, app.Status.Rollout.Ready)
+	assert.Equal(t, 1, app.Status.Rollout.Step)
+	assert.Zero(t, resp.Delay)
+
+	app.Status.Rollout.Promote = true
+	runRollout(t, app, rolloutNow)
+	assert.False(t, app.Status.Rollout.InProgress(app.Status.AppImage))
+	assert.Equal(t, "sha256:new", app.Status.Rollout.Stable.AppImage.Digest)
+	assert.False(t, app.Status.Rollout.Promote)
+}
+
+func TestRolloutRestartsOnNewImage(t *testing.T) {
+	status := stableStatus(v1.RolloutStrategyCanary)
+	status.Digest = "sha256:previous"
+	status.Step = 1
+	status.Promote = true
+
+	app := rolloutApp(&v1.Rollout{
+		Strategy: v1.RolloutStrategyCanary,
+		Steps:    []v1.RolloutStep{{Weight: 25}, {Weight: 50}},
+	}, status)
+	runRollout(t, app, rolloutNow)
+
+	assert.Equal(t, "sha256:new", app.Status.Rollout.Digest)
+	assert.Equal(t, 0, app.Status.Rollout.Step)
+	assert.Equal(t, int32(25), app.Status.Rollout.Weight)
+	assert.False(t, app.Status.Rollout.Promote)
+	assert.Equal(t, "sha256:stable", app.Status.Rollout.Stable.AppImage.Digest)
+}
+
+func TestRolloutPromoteFull(t *testing.T) {
+	status := stableStatus(v1.RolloutStrategyCanary)
+	status.Digest = "sha256:new"
+	status.PromoteFull = true
+
+	app := rolloutApp(&v1.Rollout{
+		Strategy: v1.RolloutStrategyCanary,
+		Steps:    []v1.RolloutStep{{Weight: 25}, {Weight: 50}},
+	}, status)
+	runRollout(t, app, rolloutNow)
+
+	assert.False(t, app.Status.Rollout.InProgress(app.Status.AppImage))
+	assert.False(t, app.Status.Rollout.PromoteFull)
+}
+
+func TestRolloutBlueGreen(t *testing.T) {
+	app := rolloutApp(&v1.Rollout{Strategy: v1.RolloutStrategyBlueGreen}, stableStatus(v1.RolloutStrategyBlueGreen))
+
+	// Promoting before the preview is ready waits for it to be ready
+	app.Status.Rollout.Digest = "sha256:new"
+	app.Status.Rollout.Promote = true
+	runRollout(t, app, rolloutNow)
+	assert.True(t, app.Status.Rollout.InProgress(app.Status.AppImage))
+	assert.True(t, app.Status.Rollout.Promote)
+
+	setCanaryReady(app, 4)
+	runRollout(t, app, rolloutNow)
+	assert.False(t, app.Status.Rollout.InProgress(app.Status.AppImage))
+}
+
+func TestRolloutReplicas(t *testing.T) {
+	canary := func(weight int32) *v1.RolloutStatus {
+		return &v1.RolloutStatus{Strategy: v1.RolloutStrategyCanary, Weight: weight}
+	}
+	scale := func(scale int32) v1.Container {
+		return v1.Container{Scale: &scale}
+	}
+
+	for _, tt := range []struct {
+		name           string
+		container      v1.Container
+		status         *v1.RolloutStatus
+		stable, canary int32
+	}{
+		{name: "default scale", container: v1.Container{}, status: canary(10), stable: 1, canary: 1},
+		{name: "quarter", container: scale(4), status: canary(25), stable: 4, canary: 1},
+		{name: "rounds up", container: scale(4), status: canary(30), stable: 4, canary: 2},
+		{name: "full weight", container: scale(2), status: canary(100), stable: 2, canary: 2},
+		{name: "stopped", container: scale(0), status: canary(50), stable: 0, canary: 0},
+		{name: "autoscaled", container: v1.Container{Autoscale: &v1.Autoscale{Min: &[]int32{2}[0], Max: 5}}, status: canary(50), stable: 2, canary: 1},
+		{name: "bluegreen", container: scale(3), status: &v1.RolloutStatus{Strategy: v1.RolloutStrategyBlueGreen}, stable: 3, canary: 3},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			stable, next := rolloutReplicas(tt.container, tt.status)
+			assert.Equal(t, tt.stable, stable)
+			assert.Equal(t, tt.canary, next)
+		})
+	}
+}
+
+func TestRolloutProxyConf(t *testing.T) {
+	conf := toRolloutProxyConf("web-stable", "web-canary", []v1.PortDef{
+		{Port: 80, TargetPort: 8080, Protocol: v1.ProtocolHTTP},
+		{Port: 5432},
+		{Port: 53, TargetPort: 5353, Protocol: v1.ProtocolUDP},
+	}, 100)
+
+	assert.Contains(t, conf, "upstream port_80 {\n  server web-stable:80 down;\n  server web-canary:80 weight=100;\n}\n")
+	assert.Contains(t, conf, "  listen 8080;\n  location / {\n    proxy_pass http://port_80;\n")
+	assert.Contains(t, conf, "stream {\nupstream port_5432 {\n")
+	assert.Contains(t, conf, "  listen 5432;\n  proxy_pass port_5432;\n")
+	assert.Contains(t, conf, "  listen 5353 udp;\n  proxy_pass port_53;\n")
+}
@@ -3,6 +3,9 @@ package appdefinition
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -48,7 +51,10 @@ func toRouter(appInstance *v1.AppInstance, routerName string, router v1.Router)
 		return nil, nil
 	}
 
-	conf, confName := toNginxConf(routerName, router)
+	conf, confName, err := toNginxConf(routerName, router)
+	if err != nil {
+		return nil, err
+	}
 
 	podLabels := routerLabels(appInstance, router, routerName, labels.AcornAppPublicName, publicname.Get(appInstance))
 	deploymentLabels := routerLabels(appInstance, router, routerName)
@@ -163,51 +169,239 @@ func toRouter(appInstance *v1.AppInstance, routerName string, router v1.Router)
 	}, nil
 }
 
-func toNginxConf(routerName string, router v1.Router) (string, string) {
-	buf := &strings.Builder{}
-	buf.WriteString("server {\nlisten 8080;\n")
-	for _, route := range router.Routes {
-		if route.TargetServiceName == "" || route.Path == "" {
+// routeLocation is an nginx location of the router, the routes that match the same location are in the same block
+type routeLocation struct {
+	match string
+	path  string
+	// conditional are the indexes of the routes with header or query matches, in the order they are tried
+	conditional []int
+	// route is the index of the route used when none of the conditional routes match, -1 if there's none
+	route int
+}
+
+func toNginxConf(routerName string, router v1.Router) (string, string, error) {
+	var (
+		preamble  = &strings.Builder{}
+		buf       = &strings.Builder{}
+		locations []*routeLocation
+		byMatch   = map[string]*routeLocation{}
+	)
+
+	for i, route := range router.Routes {
+		if route.Empty() {
 			continue
 		}
-		port := 80
-		if route.TargetPort != 0 {
-			port = route.TargetPort
+		if err := route.Validate(); err != nil {
+			return "", "", fmt.Errorf("invalid route [%s] of router [%s]: %w", route.Path, routerName, err)
+		}
+
+		if len(route.Targets) > 0 {
+			writeUpstream(preamble, i, route.Targets)
+		}
+		conditional := writeRouteMatch(preamble, i, route)
+
+		for _, location := range routeLocations(route) {
+			l, ok := byMatch[location.match]
+			if !ok {
+				l = location
+				byMatch[l.match] = l
+				locations = append(locations, l)
+			}
+			if l.route != -1 {
+				// An earlier route always matches this location
+				continue
+			}
+			if conditional {
+				l.conditional = append(l.conditional, i)
+			} else {
+				l.route = i
+			}
 		}
+	}
+
+	buf.WriteString(preamble.String())
+	buf.WriteString("server {\nlisten 8080;\n")
+	named := map[int]bool{}
+	for _, l := range locations {
 		buf.WriteString("location ")
-		buf.WriteString("= ")
-		buf.WriteString(route.Path)
-		buf.WriteString(" {\n  proxy_pass ")
-		buf.WriteString("http://")
-		buf.WriteString(route.TargetServiceName)
-		buf.WriteString(":")
-		buf.WriteString(strconv.Itoa(port))
-		buf.WriteString(";\n}\n")
-		if route.PathType == v1.PathTypePrefix && !strings.HasSuffix(route.Path, "/") {
-			buf.WriteString("location ")
-			buf.WriteString(route.Path)
-			buf.WriteString("/")
-			buf.WriteString(" {\n  proxy_pass ")
-			buf.WriteString("http://")
-			buf.WriteString(route.TargetServiceName)
-			buf.WriteString(":")
-			buf.WriteString(strconv.Itoa(port))
-			buf.WriteString(";\n}\n")
+		buf.WriteString(l.match)
+		buf.WriteString(" {\n")
+		for j, i := range l.conditional {
+			code := strconv.Itoa(450 + j)
+			buf.WriteString("  error_page " + code + " = @route_" + strconv.Itoa(i) + ";\n")
+			buf.WriteString("  if ($route_" + strconv.Itoa(i) + ") {\n    return " + code + ";\n  }\n")
+			named[i] = true
+		}
+		route := l.route
+		if route == -1 {
+			route = fallbackRoute(router.Routes, l.path)
 		}
-		if route.PathType == v1.PathTypePrefix && route.Path == "/" {
-			buf.WriteString("location ")
-			buf.WriteString("/")
-			buf.WriteString(" {\n  proxy_pass ")
-			buf.WriteString("http://")
-			buf.WriteString(route.TargetServiceName)
-			buf.WriteString(":")
-			buf.WriteString(strconv.Itoa(port))
-			buf.WriteString(";\n}\n")
+		if route == -1 {
+			buf.WriteString("  return 404;\n")
+		} else {
+			writeRouteBody(buf, route, router.Routes[route])
 		}
+		buf.WriteString("}\n")
+	}
+	for _, i := range typed.SortedKeys(named) {
+		buf.WriteString("location @route_" + strconv.Itoa(i) + " {\n")
+		writeRouteBody(buf, i, router.Routes[i])
+		buf.WriteString("}\n")
 	}
 	buf.WriteString("}\n")
 
 	conf := buf.String()
 	hash := sha256.Sum256([]byte(conf))
-	return conf, name2.SafeConcatName(routerName, hex.EncodeToString(hash[:])[:8])
+	return conf, name2.SafeConcatName(routerName, hex.EncodeToString(hash[:])[:8]), nil
+}
+
+// routeLocations returns the nginx locations that match the path of the route
+func routeLocations(route v1.Route) (result []*routeLocation) {
+	result = append(result, &routeLocation{
+		match: "= " + route.Path,
+		path:  route.Path,
+		route: -1,
+	})
+	if route.PathType == v1.PathTypePrefix && !strings.HasSuffix(route.Path, "/") {
+		result = append(result, &routeLocation{
+			match: route.Path + "/",
+			path:  route.Path + "/",
+			route: -1,
+		})
+	}
+	if route.PathType == v1.PathTypePrefix && route.Path == "/" {
+		result = append(result, &routeLocation{
+			match: "/",
+			path:  "/",
+			route: -1,
+		})
+	}
+	return result
+}
+
+// fallbackRoute returns the index of the route without header or query matches with the longest prefix of the path,
+// the route nginx would have used for the path if there was no location for it, or -1 if there's none
+func fallbackRoute(routes []v1.Route, path string) int {
+	result := -1
+	for i, route := range routes {
+		if route.PathType != v1.PathTypePrefix || len(route.Headers) > 0 || len(route.Query) > 0 || route.Empty() {
+			continue
+		}
+		if route.Path != "/" && path != route.Path && !strings.HasPrefix(path, strings.TrimSuffix(route.Path, "/")+"/") {
+			continue
+		}
+		if result == -1 || len(route.Path) > len(routes[result].Path) {
+			result = i
+		}
+	}
+	return result
+}
+
+// writeUpstream writes the upstream that splits the requests of the route across its targets by weight
+func writeUpstream(buf *strings.Builder, i int, targets []v1.RouteTarget) {
+	buf.WriteString("upstream route_" + strconv.Itoa(i) + " {\n")
+	for _, target := range targets {
+		buf.WriteString("  server " + target.TargetServiceName + ":" + strconv.Itoa(routePort(target.TargetPort)))
+		if weight := target.GetWeight(); weight == 0 {
+			buf.WriteString(" down;\n")
+		} else {
+			buf.WriteString(" weight=" + strconv.Itoa(weight) + ";\n")
+		}
+	}
+	buf.WriteString("}\n")
+}
+
+// writeRouteMatch writes the maps that set the $route_<i> variable to 1 if the headers and the query of the request
+// match the route. It returns false if the route has no header or query matches.
+func writeRouteMatch(buf *strings.Builder, i int, route v1.Route) bool {
+	var variables, values []string
+	for _, entry := range typed.Sorted(route.Headers) {
+		variables = append(variables, "$http_"+strings.ReplaceAll(strings.ToLower(entry.Key), "-", "_"))
+		values = append(values, entry.Value)
+	}
+	for _, entry := range typed.Sorted(route.Query) {
+		variables = append(variables, "$arg_"+entry.Key)
+		values = append(values, entry.Value)
+	}
+	if len(variables) == 0 {
+		return false
+	}
+
+	name := "$route_" + strconv.Itoa(i)
+	if len(variables) == 1 {
+		writeMap(buf, variables[0], name, values[0])
+		return true
+	}
+
+	var combined string
+	for j := range variables {
+		variable := name + "_" + strconv.Itoa(j)
+		writeMap(buf, variables[j], variable, values[j])
+		combined += variable
+	}
+	writeMap(buf, "\""+combined+"\"", name, strings.Repeat("1", len(variables)))
+	return true
+}
+
+func writeMap(buf *strings.Builder, source, variable, value string) {
+	// Map values that start with ~ are regular expressions and some values are parameters, unless they are escaped
+	switch {
+	case strings.HasPrefix(value, "~"), value == "default", value == "hostnames", value == "include", value == "volatile":
+		value = "\\" + value
+	}
+	buf.WriteString("map " + source + " " + variable + " {\n")
+	buf.WriteString("  \"" + value + "\" 1;\n")
+	buf.WriteString("  default 0;\n")
+	buf.WriteString("}\n")
+}
+
+// writeRouteBody writes the directives that send the requests of a location to the route
+func writeRouteBody(buf *strings.Builder, i int, route v1.Route) {
+	if route.Redirect != nil {
+		code := route.Redirect.StatusCode
+		if code == 0 {
+			code = http.StatusFound
+		}
+		// Relative urls stay relative, nginx would otherwise add its own port
+		buf.WriteString("  absolute_redirect off;\n")
+		buf.WriteString("  return " + strconv.Itoa(code) + " \"" + route.Redirect.URL + "\";\n")
+		return
+	}
+
+	if route.Rewrite != "" {
+		path := regexp.QuoteMeta(route.Path)
+		base := strings.TrimSuffix(route.Rewrite, "/")
+		switch {
+		case route.PathType != v1.PathTypePrefix:
+			buf.WriteString("  rewrite \"^" + path + "$\" \"" + route.Rewrite + "\" break;\n")
+		case strings.HasSuffix(route.Path, "/"):
+			buf.WriteString("  rewrite \"^" + path + "(.*)$\" \"" + base + "/$1\" break;\n")
+		default:
+			buf.WriteString("  rewrite \"^" + path + "$\" \"" + route.Rewrite + "\" break;\n")
+			buf.WriteString("  rewrite \"^" + path + "(/.*)$\" \"" + base + "$1\" break;\n")
+		}
+	}
+
+	if route.TimeoutSeconds > 0 {
+		timeout := strconv.Itoa(route.TimeoutSeconds) + "s"
+		buf.WriteString("  proxy_read_timeout " + timeout + ";\n")
+		buf.WriteString("  proxy_send_timeout " + timeout + ";\n")
+	}
+
+	buf.WriteString("  proxy_pass http://")
+	if len(route.Targets) > 0 {
+		buf.WriteString("route_" + strconv.Itoa(i))
+	} else {
+		buf.WriteString(route.TargetServiceName)
+		buf.WriteString(":")
+		buf.WriteString(strconv.Itoa(routePort(route.TargetPort)))
+	}
+	buf.WriteString(";\n")
+}
+
+func routePort(port int) int {
+	if port == 0 {
+		return 80
+	}
+	return port
 }
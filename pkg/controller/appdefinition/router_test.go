@@ -4,9 +4,173 @@ import (
 	"testing"
 
 	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRouter(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/router", DeploySpec)
 }
+
+func TestRouterProxyConf(t *testing.T) {
+	conf, _, err := toNginxConf("router-name", v1.Router{
+		Routes: v1.Routes{
+			{
+				Path:     "/api",
+				PathType: v1.PathTypePrefix,
+				Headers:  map[string]string{"X-Version": "v2"},
+				Query:    map[string]string{"beta": "true"},
+				Targets: []v1.RouteTarget{
+					{TargetServiceName: "api-v2", TargetPort: 8080},
+				},
+			},
+			{
+				Path:     "/api",
+				PathType: v1.PathTypePrefix,
+				Rewrite:  "/",
+				Targets: []v1.RouteTarget{
+					{TargetServiceName: "api", Weight: &[]int{9}[0]},
+					{TargetServiceName: "api-canary"},
+					{TargetServiceName: "api-old", Weight: new(int)},
+				},
+				TimeoutSeconds: 300,
+			},
+			{
+				Path:     "/old",
+				PathType: v1.PathTypeExact,
+				Redirect: &v1.RouteRedirect{URL: "/new", StatusCode: 301},
+			},
+			{
+				Path:              "/",
+				PathType:          v1.PathTypePrefix,
+				TargetServiceName: "web",
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `upstream route_0 {
+  server api-v2:8080 weight=1;
+}
+map $http_x_version $route_0_0 {
+  "v2" 1;
+  default 0;
+}
+map $arg_beta $route_0_1 {
+  "true" 1;
+  default 0;
+}
+map "$route_0_0$route_0_1" $route_0 {
+  "11" 1;
+  default 0;
+}
+upstream route_1 {
+  server api:80 weight=9;
+  server api-canary:80 weight=1;
+  server api-old:80 down;
+}
+server {
+listen 8080;
+location = /api {
+  error_page 450 = @route_0;
+  if ($route_0) {
+    return 450;
+  }
+  rewrite "^/api$" "/" break;
+  rewrite "^/api(/.*)$" "$1" break;
+  proxy_read_timeout 300s;
+  proxy_send_timeout 300s;
+  proxy_pass http://route_1;
+}
+location /api/ {
+  error_page 450 = @route_0;
+  if ($route_0) {
+    return 450;
+  }
+  rewrite "^/api$" "/" break;
+  rewrite "^/api(/.*)$" "$1" break;
+  proxy_read_timeout 300s;
+  proxy_send_timeout 300s;
+  proxy_pass http://route_1;
+}
+location = /old {
+  absolute_redirect off;
+  return 301 "/new";
+}
+location = / {
+  proxy_pass http://web:80;
+}
+location / {
+  proxy_pass http://web:80;
+}
+location @route_0 {
+  proxy_pass http://route_0;
+}
+}
+`, conf)
+}
+
+func TestRouterProxyConfFallback(t *testing.T) {
+	// Requests that don't have the header of the only route of a path use the route of the shorter prefix
+	conf, _, err := toNginxConf("router-name", v1.Router{
+		Routes: v1.Routes{
+			{
+				Path:              "/admin",
+				PathType:          v1.PathTypeExact,
+				Headers:           map[string]string{"X-Admin": "~true"},
+				TargetServiceName: "admin",
+			},
+			{
+				Path:              "/",
+				PathType:          v1.PathTypePrefix,
+				TargetServiceName: "web",
+				TargetPort:        8080,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `map $http_x_admin $route_0 {
+  "\~true" 1;
+  default 0;
+}
+server {
+listen 8080;
+location = /admin {
+  error_page 450 = @route_0;
+  if ($route_0) {
+    return 450;
+  }
+  proxy_pass http://web:8080;
+}
+location = / {
+  proxy_pass http://web:8080;
+}
+location / {
+  proxy_pass http://web:8080;
+}
+location @route_0 {
+  proxy_pass http://admin:80;
+}
+}
+`, conf)
+}
+
+func TestRouterProxyConfInvalid(t *testing.T) {
+	for _, route := range []v1.Route{
+		{Path: "/a b", TargetServiceName: "web"},
+		{Path: "/a;", TargetServiceName: "web"},
+		{Path: "/", TargetServiceName: "web", Targets: []v1.RouteTarget{{TargetServiceName: "api"}}},
+		{Path: "/", Targets: []v1.RouteTarget{{TargetServiceName: "api", Weight: new(int)}}},
+		{Path: "/", Targets: []v1.RouteTarget{{TargetServiceName: "api", Weight: &[]int{-1}[0]}}},
+		{Path: "/", TargetServiceName: "web", Headers: map[string]string{"X_Bad": "1"}},
+		{Path: "/", TargetServiceName: "web", Query: map[string]string{"q": `"`}},
+		{Path: "/", TargetServiceName: "web", Rewrite: "api"},
+		{Path: "/", TargetServiceName: "web", Redirect: &v1.RouteRedirect{URL: "/new"}},
+		{Path: "/", Redirect: &v1.RouteRedirect{URL: "/new", StatusCode: 200}},
+		{Path: "/", TargetServiceName: "web", TimeoutSeconds: -1},
+	} {
+		_, _, err := toNginxConf("router-name", v1.Router{Routes: v1.Routes{route}})
+		assert.Error(t, err, route)
+	}
+}
@@ -179,6 +179,13 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.MustParseResourceQuantity(volumeBinding.Size)
 			}
 		} else {
+			if snapshotName, restore := isRestore(appInstance, vol); restore {
+				objs, err := restoreFromSnapshot(req, appInstance, &pvc, vol, snapshotName)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, objs...)
+			}
 			if volumeRequest.Class != "" {
 				// Specifically allowing volume classes that are inactive.
 				if volClass, ok := volumeClasses[volumeRequest.Class]; !ok && volumeBinding.Class == "" {
@@ -188,21 +195,25 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 					pvc.Labels[labels.AcornVolumeClass] = volClass.Name
 				}
 			}
-			pvName, err := lookupExistingPV(req, appInstance, vol)
+			pvName, err := lookupExistingPV(req, appInstance, pvc.Name)
 			if err != nil {
 				return nil, err
 			}
 			pvc.Spec.VolumeName = pvName
 
-			if volumeRequest.Size == "" {
-				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.DefaultSize
-			} else {
-				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.MustParseResourceQuantity(volumeRequest.Size)
+			size := v1.DefaultSize
+			if volumeRequest.Size != "" {
+				size = v1.MustParseResourceQuantity(volumeRequest.Size)
+			}
+			if existing, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; !ok || size.Cmp(existing) > 0 {
+				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *size
 			}
 		}
 
 		result = append(result, &pvc)
 	}
+
+	pruneVolumeRestores(appInstance)
 	return
 }
 
@@ -263,10 +274,29 @@ func bindName(volume string) string {
 	return name2.SafeConcatName(volume, "bind")
 }
 
+// isRestore returns the name of the snapshot the volume should be restored from, if any. A "from" set on the volume
+// binding takes precedence over the one in the Acornfile.
+func isRestore(appInstance *v1.AppInstance, volume string) (string, bool) {
+	from := appInstance.Status.AppSpec.Volumes[volume].From
+	if binding, bind := isBind(appInstance, volume); bind {
+		return "", false
+	} else if binding.From != "" {
+		from = binding.From
+	}
+	return v1.ParseSnapshotFrom(from)
+}
+
+func restoreName(volume, snapshotName string) string {
+	return name2.SafeConcatName(volume, "restore", snapshotName)
+}
+
 func toVolumeName(appInstance *v1.AppInstance, volume string) (string, bool) {
 	if _, bind := isBind(appInstance, volume); bind {
 		return bindName(volume), true
 	}
+	if snapshotName, restore := isRestore(appInstance, volume); restore {
+		return restoreName(volume, snapshotName), false
+	}
 	return volume, false
 }
 
@@ -284,6 +314,10 @@ func addVolumeReferencesForContainer(app *v1.AppInstance, volumeReferences map[v
 		}
 	}
 
+	if app.Status.GetDevMode() && syncsOnChange(container) {
+		volumeReferences[volumeReference{name: AcornHelper}] = true
+	}
+
 	for _, entry := range typed.Sorted(container.Files) {
 		file := entry.Value
 		if file.Secret.Name != "" {
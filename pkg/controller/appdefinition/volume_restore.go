@@ -0,0 +1,106 @@
+package appdefinition
+
+import (
+	"github.com/acorn-io/baaah/pkg/apply"
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/controller/volumesnapshot"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/volume"
+	name2 "github.com/rancher/wrangler/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// restoreFromSnapshot updates the PVC for the volume so that it is populated from the given snapshot. CSI snapshots
+// are used as the data source of the PVC, snapshots taken by copying are copied back into the PVC by a job. The snapshot
+// is recorded in the status of the app the first time it is found, so it is not needed after that.
+func restoreFromSnapshot(req router.Request, appInstance *v1.AppInstance, pvc *corev1.PersistentVolumeClaim, vol, snapshotName string) ([]kclient.Object, error) {
+	restore, ok := appInstance.Status.VolumeRestores[vol]
+	if !ok || restore.Snapshot != snapshotName {
+		snapshot, err := volumesnapshot.GetReadySnapshot(req, appInstance.Namespace, appInstance.Status.Namespace, v1.SnapshotFromPrefix+snapshotName)
+		if err != nil {
+			return nil, err
+		}
+		restore = v1.VolumeRestore{
+			Snapshot:     snapshot.Name,
+			Method:       snapshot.Status.Method,
+			SnapshotName: snapshot.Status.SnapshotName,
+			RestoreSize:  snapshot.Status.RestoreSize,
+			// The PVC is populated from a CSI snapshot when it is created
+			Completed: snapshot.Status.Method == v1.VolumeSnapshotMethodCSI,
+		}
+	}
+
+	pvc.Name = restoreName(vol, snapshotName)
+	pvc.Labels[labels.AcornVolumeSnapshotName] = restore.Snapshot
+	if restore.RestoreSize != nil {
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *restore.RestoreSize
+	}
+
+	var result []kclient.Object
+	if restore.Method == v1.VolumeSnapshotMethodCSI {
+		apiGroup := volume.VolumeSnapshotGVK.Group
+		pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+			APIGroup: &apiGroup,
+			Kind:     volume.VolumeSnapshotGVK.Kind,
+			Name:     restore.SnapshotName,
+		}
+	} else if !restore.Completed {
+		jobName := name2.SafeConcatName(pvc.Name, "restore")
+		done, err := volumesnapshot.CopyJobDone(req, pvc.Namespace, jobName)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			restore.Completed = true
+		} else {
+			job, err := volumesnapshot.CopyJob(req, jobName, pvc.Namespace, restore.SnapshotName, pvc.Name)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, job)
+		}
+	}
+
+	if appInstance.Status.VolumeRestores == nil {
+		appInstance.Status.VolumeRestores = map[string]v1.VolumeRestore{}
+	}
+	appInstance.Status.VolumeRestores[vol] = restore
+	return result, nil
+}
+
+// pruneVolumeRestores removes the recorded restores of volumes that are no longer restored from a snapshot.
+func pruneVolumeRestores(appInstance *v1.AppInstance) {
+	for vol, restore := range appInstance.Status.VolumeRestores {
+		if snapshotName, ok := isRestore(appInstance, vol); !ok || snapshotName != restore.Snapshot {
+			delete(appInstance.Status.VolumeRestores, vol)
+		}
+	}
+}
+
+// isRestorePending returns true if the volume is restored from a snapshot and the data has not been restored yet. Workloads
+// that mount the volume are not created or updated until it is, so that they don't see a partially restored volume.
+func isRestorePending(appInstance *v1.AppInstance, vol string) bool {
+	snapshotName, ok := isRestore(appInstance, vol)
+	if !ok {
+		return false
+	}
+	restore, ok := appInstance.Status.VolumeRestores[vol]
+	return !ok || restore.Snapshot != snapshotName || !restore.Completed
+}
+
+// restoreAnnotations holds back the creation and update of a workload while one of its volumes is being restored.
+func restoreAnnotations(appInstance *v1.AppInstance, volumes []corev1.Volume) map[string]string {
+	result := map[string]string{}
+	for _, vol := range volumes {
+		if vol.PersistentVolumeClaim == nil || !isRestorePending(appInstance, vol.Name) {
+			continue
+		}
+		if !appInstance.GetStopped() {
+			result[apply.AnnotationUpdate] = "false"
+		}
+		result[apply.AnnotationCreate] = "false"
+	}
+	return result
+}
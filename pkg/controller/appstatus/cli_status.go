@@ -15,6 +15,7 @@ func CLIStatus(req router.Request, resp router.Response) (err error) {
 	app := req.Object.(*v1.AppInstance)
 	app.Status.Columns.UpToDate = uptodate(app)
 	app.Status.Columns.Healthy = healthy(app)
+	app.Status.Columns.Desired, app.Status.Columns.Current = replicaCounts(app)
 	app.Status.Columns.Message = message(app)
 	app.Status.Columns.Endpoints, err = endpoints(req, app)
 	resp.Objects(app)
@@ -45,6 +46,29 @@ func message(app *v1.AppInstance) string {
 		}
 	}
 
+	for _, entry := range typed.Sorted(app.Status.AppStatus.Containers) {
+		autoscale := entry.Value.Autoscale
+		if autoscale == nil || autoscale.CurrentReplicas == autoscale.DesiredReplicas {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(fmt.Sprintf("[%s: scaling %d => %d", entry.Key, autoscale.CurrentReplicas, autoscale.DesiredReplicas))
+		if autoscale.LastDecision != "" {
+			buf.WriteString(": ")
+			buf.WriteString(autoscale.LastDecision)
+		}
+		buf.WriteString("]")
+	}
+
+	if msg := rolloutMessage(app); msg != "" {
+		if buf.Len() > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(msg)
+	}
+
 	if buf.Len() != 0 {
 		return buf.String()
 	}
@@ -58,6 +82,36 @@ func message(app *v1.AppInstance) string {
 	return "pending"
 }
 
+func rolloutMessage(app *v1.AppInstance) string {
+	rollout := app.Status.Rollout
+	if !rollout.InProgress(app.Status.AppImage) || app.Status.AppSpec.Rollout == nil {
+		return ""
+	}
+
+	buf := &strings.Builder{}
+	buf.WriteString("[rollout: ")
+	buf.WriteString(rollout.Strategy)
+	if rollout.Strategy == v1.RolloutStrategyCanary {
+		buf.WriteString(fmt.Sprintf(" step %d/%d at %d%%", rollout.Step+1, len(app.Status.AppSpec.Rollout.Steps), rollout.Weight))
+	}
+
+	switch {
+	case !rollout.Ready:
+		buf.WriteString(": waiting for ")
+		buf.WriteString(rollout.Revision())
+		buf.WriteString(" to be ready")
+	case rollout.Promote:
+		buf.WriteString(": promoting")
+	case rollout.Strategy == v1.RolloutStrategyCanary && rollout.Step < len(app.Status.AppSpec.Rollout.Steps) &&
+		app.Status.AppSpec.Rollout.Steps[rollout.Step].Pause != nil:
+		buf.WriteString(": paused")
+	default:
+		buf.WriteString(": waiting for promote")
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
 func uptodate(app *v1.AppInstance) string {
 	if app.Status.Namespace == "" {
 		return "-"
@@ -98,6 +152,26 @@ func healthy(app *v1.AppInstance) string {
 	return strconv.Itoa(int(ready))
 }
 
+// replicaCounts returns the number of replicas the containers of the app should have and the number they have. The
+// autoscalers decide how many replicas autoscaled containers should have.
+func replicaCounts(app *v1.AppInstance) (string, string) {
+	if app.Status.Namespace == "" || app.Status.AppStatus.Stopped {
+		return "-", "-"
+	}
+	var (
+		desired, current int32
+	)
+	for _, status := range app.Status.AppStatus.Containers {
+		if status.Autoscale != nil && status.Autoscale.DesiredReplicas > 0 {
+			desired += status.Autoscale.DesiredReplicas
+		} else {
+			desired += status.DesiredReplicaCount
+		}
+		current += status.RunningReplicaCount
+	}
+	return strconv.Itoa(int(desired)), strconv.Itoa(int(current))
+}
+
 func endpoints(req router.Request, app *v1.AppInstance) (string, error) {
 	endpointTarget := map[string][]v1.Endpoint{}
 	for _, endpoint := range app.Status.AppStatus.Endpoints {
@@ -10,6 +10,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/ports"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	klabels "k8s.io/apimachinery/pkg/labels"
@@ -63,6 +64,20 @@ func (a *appStatusRenderer) readContainers() error {
 			}
 		}
 
+		if a.app.Status.AppSpec.Containers[containerName].Autoscale != nil {
+			cs.Autoscale, err = a.getAutoscaleStatus(containerName)
+			if err != nil {
+				return err
+			}
+		}
+
+		if rollout := a.app.Status.Rollout; rollout.InProgress(a.app.Status.AppImage) {
+			cs.Rollout, err = a.getRolloutStatus(containerName, rollout)
+			if err != nil {
+				return err
+			}
+		}
+
 		if cs.LinkOverride != "" {
 			cs.UpToDate = true
 			cs.Ready, cs.Defined = a.isServiceReady(containerName)
@@ -151,6 +166,72 @@ func (a *appStatusRenderer) isDepReady(dep *appsv1.Deployment) (bool, error) {
 	return false, nil
 }
 
+func (a *appStatusRenderer) getAutoscaleStatus(containerName string) (*v1.AutoscaleStatus, error) {
+	// Getting the autoscaler through the request client triggers the app again when the autoscaler changes its
+	// status, so the replica counts follow its decisions
+	hpa := autoscalingv2.HorizontalPodAutoscaler{}
+	err := a.c.Get(a.ctx, router.Key(a.app.Status.Namespace, containerName), &hpa)
+	if apierror.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	status := &v1.AutoscaleStatus{
+		MinReplicas:     replicas(hpa.Spec.MinReplicas),
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+		LastScaleTime:   hpa.Status.LastScaleTime,
+	}
+
+	// AbleToScale describes the last decision made by the autoscaler, unless it is unable to compute
+	// a scale (ScalingActive is false) or the desired scale is being held at min or max (ScalingLimited is true)
+	for _, cond := range hpa.Status.Conditions {
+		switch {
+		case cond.Type == autoscalingv2.ScalingActive && cond.Status != corev1.ConditionTrue:
+			status.LastDecision = cond.Message
+			return status, nil
+		case cond.Type == autoscalingv2.ScalingLimited && cond.Status == corev1.ConditionTrue:
+			status.LastDecision = cond.Message
+		case cond.Type == autoscalingv2.AbleToScale && status.LastDecision == "":
+			status.LastDecision = cond.Message
+		}
+	}
+
+	return status, nil
+}
+
+func (a *appStatusRenderer) getRolloutStatus(containerName string, rollout *v1.RolloutStatus) (*v1.ContainerRolloutStatus, error) {
+	dep := appsv1.Deployment{}
+	err := a.c.Get(a.ctx, router.Key(a.app.Status.Namespace, rollout.DeploymentName(containerName)), &dep)
+	if apierror.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	status := &v1.ContainerRolloutStatus{
+		Revision:            rollout.Revision(),
+		ReadyReplicaCount:   dep.Status.ReadyReplicas,
+		DesiredReplicaCount: replicas(dep.Spec.Replicas),
+	}
+
+	if dep.Status.ObservedGeneration != dep.Generation ||
+		dep.Status.Replicas != dep.Status.UpdatedReplicas ||
+		status.ReadyReplicaCount != status.DesiredReplicaCount {
+		return status, nil
+	}
+
+	for _, cond := range dep.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable && cond.Status == corev1.ConditionTrue {
+			status.Ready = true
+			break
+		}
+	}
+	return status, nil
+}
+
 func replicas(replicas *int32) int32 {
 	if replicas == nil {
 		return 1
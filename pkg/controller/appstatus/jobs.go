@@ -2,15 +2,20 @@ package appstatus
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/ports"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func (a *appStatusRenderer) readJobs() error {
@@ -64,7 +69,9 @@ func (a *appStatusRenderer) readJobs() error {
 				c.Defined = true
 				c.UpToDate = cronJob.Annotations[labels.AcornAppGeneration] == strconv.Itoa(int(a.app.Generation))
 				c.RunningCount = len(cronJob.Status.Active)
-				if cronJob.Status.LastSuccessfulTime != nil {
+				c.Suspended = cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend
+				if cronJob.Status.LastSuccessfulTime != nil || c.Suspended {
+					// A suspended job has no run to wait for
 					c.CreateEventSucceeded = true
 					c.Ready = c.UpToDate
 				}
@@ -84,6 +91,11 @@ func (a *appStatusRenderer) readJobs() error {
 			}
 		}
 
+		c.Runs, err = a.readJobRuns(jobName)
+		if err != nil {
+			return err
+		}
+
 		if c.RunningCount > 0 {
 			c.TransitioningMessages = append(c.TransitioningMessages, "running")
 		} else if c.ErrorCount > 0 {
@@ -118,6 +130,56 @@ func (a *appStatusRenderer) readJobs() error {
 	return nil
 }
 
+// readJobRuns returns the runs of a job, which are the Kubernetes jobs of a job or the jobs created by the cron job of a
+// scheduled job.
+func (a *appStatusRenderer) readJobRuns(jobName string) (result []v1.JobRun, _ error) {
+	var jobList batchv1.JobList
+	if err := a.c.List(a.ctx, &jobList, &kclient.ListOptions{
+		Namespace: a.app.Status.Namespace,
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged: "true",
+			labels.AcornJobName: jobName,
+		}),
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, job := range jobList.Items {
+		run := v1.JobRun{
+			Name:           job.Name,
+			State:          v1.JobRunStateRunning,
+			Manual:         job.Annotations[jobs.ManualRunAnnotation] == jobs.ManualRun,
+			StartTime:      job.Status.StartTime,
+			CompletionTime: job.Status.CompletionTime,
+		}
+		if run.StartTime == nil {
+			run.StartTime = &job.CreationTimestamp
+		}
+		for _, cond := range job.Status.Conditions {
+			if cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case batchv1.JobComplete:
+				run.State = v1.JobRunStateSucceeded
+			case batchv1.JobFailed:
+				run.State = v1.JobRunStateFailed
+				run.Message = cond.Message
+				run.CompletionTime = &cond.LastTransitionTime
+			}
+		}
+		result = append(result, run)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].StartTime.Equal(result[j].StartTime) {
+			return result[i].Name > result[j].Name
+		}
+		return result[j].StartTime.Before(result[i].StartTime)
+	})
+	return result, nil
+}
+
 func addExpressionErrors(status *v1.CommonStatus, expressionErrors []v1.ExpressionError) {
 	missing := map[string]v1.DependencyType{}
 	for _, ee := range expressionErrors {
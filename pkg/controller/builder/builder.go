@@ -1,6 +1,8 @@
 package builder
 
 import (
+	"fmt"
+
 	"github.com/acorn-io/baaah/pkg/apply"
 	"github.com/acorn-io/baaah/pkg/router"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
@@ -10,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -41,8 +44,17 @@ func createBuilderObjects(req router.Request, resp router.Response) (string, str
 		forNamespace = builder.Namespace
 	}
 
+	var cacheSize *resource.Quantity
+	if *cfg.BuilderCacheSize != "" {
+		size, err := resource.ParseQuantity(*cfg.BuilderCacheSize)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("invalid builder cache size %q: %w", *cfg.BuilderCacheSize, err)
+		}
+		cacheSize = &size
+	}
+
 	objs := imagesystem.BuilderObjects(name, system.ImagesNamespace, forNamespace, system.DefaultImage(),
-		pubKey, privKey, builder.Status.UUID, registryDNS, *cfg.UseCustomCABundle, *cfg.PublishBuilders)
+		pubKey, privKey, builder.Status.UUID, registryDNS, *cfg.UseCustomCABundle, *cfg.PublishBuilders, cacheSize)
 
 	if *cfg.BuilderPerProject {
 		resp.Objects(objs...)
@@ -0,0 +1,84 @@
+package defaults
+
+import (
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/computeclasses"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// addDefaultCPU calculates the default cpu that should be used and considers the defaults from the Config, ComputeClass, and
+// runtime ComputeClass
+func addDefaultCPU(req router.Request, cfg *apiv1.Config, appInstance *v1.AppInstance) error {
+	if appInstance.Status.Defaults.CPU == nil {
+		appInstance.Status.Defaults.CPU = map[string]*int64{}
+	}
+
+	var (
+		defaultCC string
+		err       error
+	)
+	if value, ok := appInstance.Spec.ComputeClasses[""]; ok {
+		defaultCC = value
+	} else {
+		defaultCC, err = adminv1.GetDefaultComputeClass(req.Ctx, req.Client, appInstance.Namespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	appInstance.Status.Defaults.CPU[""] = cfg.WorkloadCPUDefault
+	cc, err := computeclasses.GetAsProjectComputeClassInstance(req.Ctx, req.Client, appInstance.Status.Namespace, defaultCC)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if cc != nil {
+		parsedCPU, err := computeclasses.ParseComputeClassCPU(cc.CPU)
+		if err != nil {
+			return err
+		}
+		def := parsedCPU.Def.MilliValue()
+		appInstance.Status.Defaults.CPU[""] = &def
+	}
+
+	if err := addWorkloadCPUDefault(req, appInstance, cfg.WorkloadCPUDefault, appInstance.Status.AppSpec.Containers); err != nil {
+		return err
+	}
+
+	if err := addWorkloadCPUDefault(req, appInstance, cfg.WorkloadCPUDefault, appInstance.Status.AppSpec.Jobs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func addWorkloadCPUDefault(req router.Request, appInstance *v1.AppInstance, configDefault *int64, containers map[string]v1.Container) error {
+	for name, container := range containers {
+		cpu := configDefault
+		computeClass, err := computeclasses.GetClassForWorkload(req.Ctx, req.Client, appInstance.Spec.ComputeClasses, container, name, appInstance.Namespace)
+		if err != nil {
+			return err
+		}
+
+		if computeClass != nil {
+			parsedCPU, err := computeclasses.ParseComputeClassCPU(computeClass.CPU)
+			if err != nil {
+				return err
+			}
+			def := parsedCPU.Def.MilliValue()
+			cpu = &def
+		}
+		appInstance.Status.Defaults.CPU[name] = cpu
+
+		for sidecarName := range container.Sidecars {
+			appInstance.Status.Defaults.CPU[sidecarName] = cpu
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package defaults
+
+import (
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	"github.com/acorn-io/runtime/pkg/scheme"
+)
+
+func TestComputeClassCPU(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/cpu/computeclass", Calculate)
+}
+
+func TestConfigCPU(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/cpu/config", Calculate)
+}
@@ -57,5 +57,9 @@ func calculate(req router.Request, appInstance *internalv1.AppInstance) error {
 		return err
 	}
 
+	if err = addDefaultCPU(req, cfg, appInstance); err != nil {
+		return err
+	}
+
 	return nil
 }
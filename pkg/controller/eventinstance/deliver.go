@@ -0,0 +1,314 @@
+package eventinstance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxDeliveryAttempts is the number of times an event is sent to a webhook before giving up
+	maxDeliveryAttempts = 8
+	minDeliveryBackoff  = 5 * time.Second
+	maxDeliveryBackoff  = 10 * time.Minute
+	webhookTimeout      = 10 * time.Second
+
+	// deliveryWorkers is the number of webhooks that are called at the same time
+	deliveryWorkers = 4
+	// deliveryQueueSize is the number of deliveries that wait for a worker. Deliveries that don't fit are queued again
+	// by the handler after a backoff.
+	deliveryQueueSize = 1000
+)
+
+// delivery is the state of sending an event to one subscription, stored in the event annotations
+type delivery struct {
+	Attempts    int          `json:"attempts,omitempty"`
+	Delivered   bool         `json:"delivered,omitempty"`
+	NextAttempt *metav1.Time `json:"nextAttempt,omitempty"`
+}
+
+// deliveryTask is an attempt to send an event to the webhook of a subscription
+type deliveryTask struct {
+	key          string
+	client       kclient.Client
+	event        *v1.EventInstance
+	subscription *v1.EventSubscriptionInstance
+}
+
+// DeliverToSubscriptions sends events to the webhooks of the matching EventSubscriptions in the project. The webhooks
+// are called by a fixed number of workers, so slow webhooks don't block the handler. Failed deliveries are retried with
+// an exponential backoff.
+func DeliverToSubscriptions() router.HandlerFunc {
+	d := newDeliverer(event.NewWebhookClient(webhookTimeout), deliveryQueueSize)
+	for i := 0; i < deliveryWorkers; i++ {
+		go d.work()
+	}
+	return d.deliver
+}
+
+type deliverer struct {
+	client *http.Client
+	now    func() time.Time
+	queue  chan deliveryTask
+	// updateStatus applies the result of a delivery attempt to the status of a subscription
+	updateStatus func(context.Context, kclient.Client, kclient.ObjectKey, func(*v1.EventSubscriptionInstanceStatus)) error
+
+	lock sync.Mutex
+	// inFlight are the deliveries that are queued or being sent
+	inFlight map[string]bool
+	// attempts are the attempts recorded by the workers, until the cached events have caught up with them
+	attempts map[string]int
+}
+
+func newDeliverer(client *http.Client, queueSize int) *deliverer {
+	return &deliverer{
+		client:       client,
+		now:          time.Now,
+		queue:        make(chan deliveryTask, queueSize),
+		updateStatus: updateSubscriptionStatus,
+		inFlight:     map[string]bool{},
+		attempts:     map[string]int{},
+	}
+}
+
+func (d *deliverer) deliver(req router.Request, resp router.Response) error {
+	e := req.Object.(*v1.EventInstance)
+
+	subscriptions := &v1.EventSubscriptionInstanceList{}
+	if err := req.List(subscriptions, &kclient.ListOptions{
+		Namespace: e.Namespace,
+	}); err != nil {
+		return err
+	}
+	if len(subscriptions.Items) == 0 {
+		return nil
+	}
+
+	var (
+		deliveries = deliveriesOf(e)
+		now        = d.now()
+		retry      time.Duration
+	)
+	for i := range subscriptions.Items {
+		subscription := &subscriptions.Items[i]
+		// Events that happened before the subscription was created are not sent
+		if e.Observed.Before(subscription.CreationTimestamp.Time) || !subscription.Matches(e) {
+			continue
+		}
+
+		var (
+			key   = fmt.Sprintf("%s/%s/%s", e.Namespace, e.Name, subscription.Name)
+			state = deliveries[subscription.Name]
+		)
+		if !d.caughtUp(key, state) {
+			// Check again in case the worker could not record the attempt
+			retry = shorter(retry, minDeliveryBackoff)
+			continue
+		}
+		if state.Delivered || state.Attempts >= maxDeliveryAttempts {
+			continue
+		}
+		if state.NextAttempt != nil && now.Before(state.NextAttempt.Time) {
+			retry = shorter(retry, state.NextAttempt.Sub(now))
+			continue
+		}
+
+		if !d.enqueue(deliveryTask{
+			key:          key,
+			client:       req.Client,
+			event:        e.DeepCopy(),
+			subscription: subscription,
+		}) {
+			logrus.Debugf("Delivery queue is full, retrying event %s/%s for %s in %s", e.Namespace, e.Name, subscription.Name, minDeliveryBackoff)
+			retry = shorter(retry, minDeliveryBackoff)
+		}
+	}
+
+	if retry > 0 {
+		resp.RetryAfter(retry)
+	}
+	return nil
+}
+
+// caughtUp returns false while the event doesn't have the last attempt recorded by a worker or the attempt is still
+// running. The update of the event by the worker triggers the handler again.
+func (d *deliverer) caughtUp(key string, state delivery) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.inFlight[key] {
+		return false
+	}
+	if attempts, ok := d.attempts[key]; ok {
+		if state.Attempts < attempts {
+			return false
+		}
+		delete(d.attempts, key)
+	}
+	return true
+}
+
+// enqueue queues the task for a worker, false is returned if the queue is full
+func (d *deliverer) enqueue(task deliveryTask) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.inFlight[task.key] {
+		return true
+	}
+	select {
+	case d.queue <- task:
+		d.inFlight[task.key] = true
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *deliverer) work() {
+	for task := range d.queue {
+		d.process(task)
+	}
+}
+
+// process sends the event of the task and records the result in the event and the status of the subscription
+func (d *deliverer) process(task deliveryTask) {
+	ctx := context.Background()
+	e, subscription := task.event, task.subscription
+
+	err := d.send(ctx, task.client, subscription, e)
+	now := d.now()
+
+	attempts, recordErr := recordAttempt(ctx, task.client, e, subscription.Name, func(state *delivery) {
+		state.Attempts++
+		state.NextAttempt = nil
+		if err == nil {
+			state.Delivered = true
+		} else if state.Attempts < maxDeliveryAttempts {
+			backoff := deliveryBackoff(state.Attempts)
+			state.NextAttempt = &metav1.Time{Time: now.Add(backoff)}
+			logrus.Debugf("Failed to deliver event %s/%s to %s, retrying in %s: %v", e.Namespace, e.Name, subscription.Name, backoff, err)
+		} else {
+			logrus.Warnf("Giving up delivering event %s/%s to %s after %d attempts: %v", e.Namespace, e.Name, subscription.Name, state.Attempts, err)
+		}
+	})
+	if recordErr != nil {
+		logrus.Warnf("Failed to record delivery of event %s/%s to %s: %v", e.Namespace, e.Name, subscription.Name, recordErr)
+	}
+
+	if statusErr := d.updateStatus(ctx, task.client, kclient.ObjectKeyFromObject(subscription), func(status *v1.EventSubscriptionInstanceStatus) {
+		if err == nil {
+			status.LastDelivered = &metav1.Time{Time: now}
+		} else {
+			status.LastFailed = &metav1.Time{Time: now}
+			status.LastError = err.Error()
+		}
+	}); statusErr != nil {
+		logrus.Warnf("Failed to update status of event subscription %s/%s: %v", subscription.Namespace, subscription.Name, statusErr)
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.inFlight, task.key)
+	if recordErr == nil {
+		d.attempts[task.key] = attempts
+	}
+}
+
+func (d *deliverer) send(ctx context.Context, c kclient.Client, subscription *v1.EventSubscriptionInstance, e *v1.EventInstance) error {
+	var key []byte
+	if subscription.Spec.Webhook.SecretName != "" {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, kclient.ObjectKey{Namespace: subscription.Namespace, Name: subscription.Spec.Webhook.SecretName}, secret); err != nil {
+			return fmt.Errorf("failed to get webhook secret %s: %w", subscription.Spec.Webhook.SecretName, err)
+		}
+		key = secret.Data["token"]
+		if len(key) == 0 {
+			return fmt.Errorf("webhook secret %s has no token", subscription.Spec.Webhook.SecretName)
+		}
+	}
+
+	return event.SendWebhook(ctx, d.client, subscription.Spec.Webhook.URL, key, (*apiv1.Event)(e))
+}
+
+func deliveriesOf(e *v1.EventInstance) map[string]delivery {
+	deliveries := map[string]delivery{}
+	if data := e.Annotations[labels.AcornEventDeliveries]; data != "" {
+		if err := json.Unmarshal([]byte(data), &deliveries); err != nil {
+			logrus.Warnf("Ignoring invalid deliveries of event %s/%s: %v", e.Namespace, e.Name, err)
+		}
+	}
+	return deliveries
+}
+
+// recordAttempt updates the delivery state of the subscription in the event and returns the number of attempts
+func recordAttempt(ctx context.Context, c kclient.Client, e *v1.EventInstance, subscriptionName string, update func(*delivery)) (int, error) {
+	var attempts int
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1.EventInstance{}
+		if err := c.Get(ctx, kclient.ObjectKeyFromObject(e), latest); err != nil {
+			return err
+		}
+
+		deliveries := deliveriesOf(latest)
+		state := deliveries[subscriptionName]
+		update(&state)
+		deliveries[subscriptionName] = state
+		attempts = state.Attempts
+
+		data, err := json.Marshal(deliveries)
+		if err != nil {
+			return err
+		}
+		if latest.Annotations == nil {
+			latest.Annotations = map[string]string{}
+		}
+		latest.Annotations[labels.AcornEventDeliveries] = string(data)
+		return c.Update(ctx, latest)
+	})
+	return attempts, err
+}
+
+func updateSubscriptionStatus(ctx context.Context, c kclient.Client, key kclient.ObjectKey, update func(*v1.EventSubscriptionInstanceStatus)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		subscription := &v1.EventSubscriptionInstance{}
+		if err := c.Get(ctx, key, subscription); err != nil {
+			return err
+		}
+		update(&subscription.Status)
+		return c.Status().Update(ctx, subscription)
+	})
+}
+
+// deliveryBackoff returns how long to wait after the given number of failed attempts
+func deliveryBackoff(attempts int) time.Duration {
+	backoff := minDeliveryBackoff
+	for i := 1; i < attempts && backoff < maxDeliveryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxDeliveryBackoff {
+		return maxDeliveryBackoff
+	}
+	return backoff
+}
+
+func shorter(a, b time.Duration) time.Duration {
+	if a == 0 || b < a {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,222 @@
+package eventinstance
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// webhookStandIn is a local webhook that fails the first requests
+type webhookStandIn struct {
+	lock       sync.Mutex
+	failures   int
+	signatures []string
+	bodies     [][]byte
+}
+
+func (w *webhookStandIn) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.failures > 0 {
+		w.failures--
+		rw.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	body, _ := io.ReadAll(req.Body)
+	w.bodies = append(w.bodies, body)
+	w.signatures = append(w.signatures, req.Header.Get(event.SignatureHeader))
+}
+
+func newTestDeliverer(now time.Time, queueSize int, statuses *[]v1.EventSubscriptionInstanceStatus) *deliverer {
+	d := newDeliverer(http.DefaultClient, queueSize)
+	d.now = func() time.Time {
+		return now
+	}
+	d.updateStatus = func(_ context.Context, _ kclient.Client, _ kclient.ObjectKey, update func(*v1.EventSubscriptionInstanceStatus)) error {
+		status := v1.EventSubscriptionInstanceStatus{}
+		update(&status)
+		*statuses = append(*statuses, status)
+		return nil
+	}
+	return d
+}
+
+func newSubscription(name, url string, created time.Time, spec v1.EventSubscriptionInstanceSpec) *v1.EventSubscriptionInstance {
+	spec.Webhook.URL = url
+	return &v1.EventSubscriptionInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "acorn",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: spec,
+	}
+}
+
+// lastEvent returns the event as it was last updated by the worker
+func lastEvent(t *testing.T, c *tester.Client) *v1.EventInstance {
+	t.Helper()
+	require.NotEmpty(t, c.Updated)
+	return c.Updated[len(c.Updated)-1].(*v1.EventInstance)
+}
+
+func recordedDeliveries(t *testing.T, c *tester.Client) map[string]delivery {
+	t.Helper()
+	deliveries := map[string]delivery{}
+	require.NoError(t, json.Unmarshal([]byte(lastEvent(t, c).Annotations[labels.AcornEventDeliveries]), &deliveries))
+	return deliveries
+}
+
+func TestDeliverToSubscriptions(t *testing.T) {
+	standIn := &webhookStandIn{failures: 1}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	var (
+		now      = time.Date(2023, 5, 1, 12, 0, 0, 0, time.Local)
+		statuses []v1.EventSubscriptionInstanceStatus
+		d        = newTestDeliverer(now, 10, &statuses)
+		e        = &v1.EventInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "abc",
+				Namespace: "acorn",
+			},
+			Type:     "JobFailed",
+			Severity: v1.EventSeverityWarn,
+			Source:   v1.EventSource{Kind: "app", Name: "app"},
+			Observed: v1.MicroTime(metav1.NewMicroTime(now.Add(-time.Minute))),
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "hook",
+				Namespace: "acorn",
+			},
+			Data: map[string][]byte{
+				"token": []byte("secret"),
+			},
+		}
+	)
+
+	req := tester.NewRequest(t, scheme.Scheme, e,
+		secret,
+		newSubscription("failures", server.URL, now.Add(-time.Hour), v1.EventSubscriptionInstanceSpec{
+			Types:      []string{"JobFailed"},
+			Severities: []v1.EventSeverity{v1.EventSeverityWarn},
+			Apps:       []string{"app"},
+			Webhook:    v1.EventWebhook{SecretName: "hook"},
+		}),
+		newSubscription("other-type", server.URL, now.Add(-time.Hour), v1.EventSubscriptionInstanceSpec{
+			Types: []string{"UpgradeAvailable"},
+		}),
+		newSubscription("other-app", server.URL, now.Add(-time.Hour), v1.EventSubscriptionInstanceSpec{
+			Apps: []string{"other"},
+		}),
+		newSubscription("created-later", server.URL, now, v1.EventSubscriptionInstanceSpec{}),
+	)
+	c := req.Client.(*tester.Client)
+
+	// The handler only queues the delivery, once
+	resp := &tester.Response{Client: c}
+	require.NoError(t, d.deliver(req, resp))
+	assert.Zero(t, resp.Delay)
+	require.NoError(t, d.deliver(req, &tester.Response{Client: c}))
+	require.Len(t, d.queue, 1)
+	assert.Empty(t, c.Updated)
+
+	// The first attempt fails and is retried after a backoff
+	d.process(<-d.queue)
+	assert.Equal(t, map[string]delivery{
+		"failures": {
+			Attempts:    1,
+			NextAttempt: &metav1.Time{Time: now.Add(minDeliveryBackoff)},
+		},
+	}, recordedDeliveries(t, c))
+	require.Len(t, statuses, 1)
+	assert.Contains(t, statuses[0].LastError, "502")
+
+	// An event from the cache without the recorded attempt is not sent again
+	resp = &tester.Response{Client: c}
+	require.NoError(t, d.deliver(req, resp))
+	assert.Empty(t, d.queue)
+
+	// Nothing is sent before the backoff expired
+	req.Object = lastEvent(t, c)
+	resp = &tester.Response{Client: c}
+	require.NoError(t, d.deliver(req, resp))
+	assert.Empty(t, d.queue)
+	assert.Equal(t, minDeliveryBackoff, resp.Delay)
+
+	// The retry is delivered and signed
+	d.now = func() time.Time {
+		return now.Add(minDeliveryBackoff)
+	}
+	resp = &tester.Response{Client: c}
+	require.NoError(t, d.deliver(req, resp))
+	assert.Zero(t, resp.Delay)
+	require.Len(t, d.queue, 1)
+	d.process(<-d.queue)
+	assert.Equal(t, map[string]delivery{
+		"failures": {
+			Attempts:  2,
+			Delivered: true,
+		},
+	}, recordedDeliveries(t, c))
+	require.Len(t, standIn.bodies, 1)
+	assert.True(t, event.VerifySignature([]byte("secret"), standIn.bodies[0], standIn.signatures[0]))
+	require.Len(t, statuses, 2)
+	assert.NotNil(t, statuses[1].LastDelivered)
+
+	// A delivered event is not sent again
+	req.Object = lastEvent(t, c)
+	require.NoError(t, d.deliver(req, &tester.Response{Client: c}))
+	assert.Empty(t, d.queue)
+	assert.Len(t, standIn.bodies, 1)
+}
+
+func TestDeliverQueueFull(t *testing.T) {
+	var (
+		now      = time.Date(2023, 5, 1, 12, 0, 0, 0, time.Local)
+		statuses []v1.EventSubscriptionInstanceStatus
+		d        = newTestDeliverer(now, 0, &statuses)
+		e        = &v1.EventInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "abc",
+				Namespace: "acorn",
+			},
+			Type:     "JobFailed",
+			Observed: v1.MicroTime(metav1.NewMicroTime(now)),
+		}
+	)
+
+	req := tester.NewRequest(t, scheme.Scheme, e,
+		newSubscription("all", "http://localhost", now.Add(-time.Hour), v1.EventSubscriptionInstanceSpec{}))
+	resp := &tester.Response{Client: req.Client.(*tester.Client)}
+	require.NoError(t, d.deliver(req, resp))
+	assert.Equal(t, minDeliveryBackoff, resp.Delay)
+	assert.Empty(t, d.inFlight)
+}
+
+func TestDeliveryBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Second, deliveryBackoff(1))
+	assert.Equal(t, 10*time.Second, deliveryBackoff(2))
+	assert.Equal(t, 40*time.Second, deliveryBackoff(4))
+	assert.Equal(t, maxDeliveryBackoff, deliveryBackoff(maxDeliveryAttempts))
+}
@@ -0,0 +1,92 @@
+package jobs
+
+import (
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	JobFailedEventType = "JobFailed"
+)
+
+// JobFailedEventDetails captures additional info about a failed job.
+type JobFailedEventDetails struct {
+	// JobName is the name of the job in the app.
+	JobName string `json:"jobName"`
+
+	// Reason is the reason of the failure reported by Kubernetes, like BackoffLimitExceeded.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable description of the failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// FailedAttempts is the number of pods of the job that failed.
+	FailedAttempts int32 `json:"failedAttempts"`
+}
+
+// RecordJobFailure records an event when a job of an app fails. The event is observed when the job failed, so it
+// is only recorded once.
+func RecordJobFailure(recorder event.Recorder) router.HandlerFunc {
+	return func(req router.Request, resp router.Response) error {
+		job := req.Object.(*batchv1.Job)
+		jobName := job.Labels[labels.AcornJobName]
+		if jobName == "" {
+			return nil
+		}
+
+		var failed *batchv1.JobCondition
+		for i, cond := range job.Status.Conditions {
+			if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+				failed = &job.Status.Conditions[i]
+				break
+			}
+		}
+		if failed == nil {
+			return nil
+		}
+
+		app := &v1.AppInstance{}
+		if err := req.Get(app, job.Labels[labels.AcornAppNamespace], job.Labels[labels.AcornAppName]); apierrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		e := apiv1.Event{
+			Type:        JobFailedEventType,
+			Actor:       "acorn-system",
+			Severity:    v1.EventSeverityWarn,
+			Description: fmt.Sprintf("Job %s failed", jobName),
+			Source:      event.ObjectSource(app),
+			Observed:    v1.MicroTime{Time: failed.LastTransitionTime.Time},
+		}
+		e.SetNamespace(app.GetNamespace())
+
+		var err error
+		if e.Details, err = v1.Mapify(JobFailedEventDetails{
+			JobName:        jobName,
+			Reason:         failed.Reason,
+			Message:        failed.Message,
+			FailedAttempts: job.Status.Failed,
+		}); err != nil {
+			logrus.Warnf("Failed to mapify event details: %s", err.Error())
+		}
+
+		// The name of the event is derived from its content, so it already exists if the failure was recorded before
+		if err := recorder.Record(req.Ctx, &e); err != nil && !apierrors.IsAlreadyExists(err) {
+			logrus.Warnf("Failed to record event: %s", err.Error())
+		}
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRecordJobFailure(t *testing.T) {
+	failedAt := metav1.NewTime(time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC))
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "acorn",
+			UID:       "app-uid",
+		},
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "migrate",
+			Namespace: "app-namespace",
+			Labels: map[string]string{
+				labels.AcornAppName:      "app",
+				labels.AcornAppNamespace: "acorn",
+				labels.AcornJobName:      "migrate",
+			},
+		},
+		Status: batchv1.JobStatus{
+			Failed: 3,
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:               batchv1.JobFailed,
+					Status:             corev1.ConditionTrue,
+					Reason:             "BackoffLimitExceeded",
+					Message:            "Job has reached the specified backoff limit",
+					LastTransitionTime: failedAt,
+				},
+			},
+		},
+	}
+
+	var recorded []*apiv1.Event
+	handler := RecordJobFailure(event.RecorderFunc(func(_ context.Context, e *apiv1.Event) error {
+		recorded = append(recorded, e)
+		return nil
+	}))
+
+	req := tester.NewRequest(t, scheme.Scheme, job, app)
+	require.NoError(t, handler(req, &tester.Response{}))
+	require.Len(t, recorded, 1)
+
+	e := recorded[0]
+	assert.Equal(t, JobFailedEventType, e.Type)
+	assert.Equal(t, v1.EventSeverityWarn, e.Severity)
+	assert.Equal(t, "acorn", e.Namespace)
+	assert.Equal(t, v1.EventSource{Kind: "app", Name: "app", UID: "app-uid"}, e.Source)
+	assert.True(t, failedAt.Time.Equal(e.Observed.Time))
+	assert.Equal(t, "BackoffLimitExceeded", e.Details["reason"])
+
+	// Running jobs don't record events
+	recorded = nil
+	job.Status.Conditions = nil
+	require.NoError(t, handler(tester.NewRequest(t, scheme.Scheme, job, app), &tester.Response{}))
+	assert.Empty(t, recorded)
+}
@@ -113,14 +113,23 @@ func addCompute(containers map[string]apiv1.Container, appInstance *apiv1.AppIns
 			requirements = all.Requirements
 		}
 
-		quotaRequest.Spec.Resources.CPU.Add(requirements.Requests["cpu"])
-		quotaRequest.Spec.Resources.Memory.Add(requirements.Requests["memory"])
+		quotaRequest.Spec.Resources.CPU.Add(requestOrLimit(requirements, corev1.ResourceCPU))
+		quotaRequest.Spec.Resources.Memory.Add(requestOrLimit(requirements, corev1.ResourceMemory))
 
 		// Recurse over any sidecars. Since sidecars can't have sidecars, this is safe.
 		addCompute(container.Sidecars, appInstance, quotaRequest)
 	}
 }
 
+// requestOrLimit returns the request for the resource, falling back to the limit since Kubernetes
+// defaults the request to the limit when only the limit is set.
+func requestOrLimit(requirements corev1.ResourceRequirements, name corev1.ResourceName) resource.Quantity {
+	if request, ok := requirements.Requests[name]; ok {
+		return request
+	}
+	return requirements.Limits[name]
+}
+
 // addStorage adds the storage resources of the volumes passed to the quota request.
 func addStorage(appInstance *apiv1.AppInstance, quotaRequest *adminv1.QuotaRequestInstance) error {
 	app := appInstance.Status.AppSpec
@@ -25,11 +25,13 @@ import (
 	"github.com/acorn-io/runtime/pkg/controller/secrets"
 	"github.com/acorn-io/runtime/pkg/controller/service"
 	"github.com/acorn-io/runtime/pkg/controller/tls"
+	"github.com/acorn-io/runtime/pkg/controller/volumesnapshot"
 	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/acorn-io/runtime/pkg/volume"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
@@ -61,6 +63,8 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	appRouter.HandlerFunc(appdefinition.PullAppImage(registryTransport, recorder))
 	appRouter.HandlerFunc(images.CreateImages)
 	appRouter.HandlerFunc(appdefinition.ParseAppImage)
+	appRouter.HandlerFunc(appdefinition.Rollout)
+	appRouter.HandlerFunc(appdefinition.RecordRevision)
 	appRouter.Middleware(appdefinition.FilterLabelsAndAnnotationsConfig).HandlerFunc(namespace.AddNamespace)
 	appRouter.Middleware(jobs.NeedsDestroyJobFinalization).FinalizeFunc(jobs.DestroyJobFinalizer, jobs.FinalizeDestroyJob)
 
@@ -73,7 +77,7 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 
 	appMeetsPreconditions := appHasNamespace.Middleware(appstatus.CheckStatus)
 	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(appdefinition.DeploySpec)
-	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(secrets.CreateSecrets)
+	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(secrets.CreateSecrets(recorder))
 	appMeetsPreconditions.HandlerFunc(appstatus.SetStatus)
 	appMeetsPreconditions.HandlerFunc(appstatus.ReadyStatus)
 	appMeetsPreconditions.HandlerFunc(networkpolicy.ForApp)
@@ -86,6 +90,8 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 
 	router.Type(&v1.ServiceInstance{}).HandlerFunc(service.RenderServices)
 
+	router.Type(&v1.VolumeSnapshotInstance{}).HandlerFunc(volumesnapshot.Snapshot)
+
 	router.Type(&v1.BuilderInstance{}).HandlerFunc(builder.SetRegion)
 	router.Type(&v1.BuilderInstance{}).HandlerFunc(builder.DeployBuilder)
 
@@ -95,8 +101,10 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	router.Type(&v1.ServiceInstance{}).HandlerFunc(gc.GCOrphans)
 
 	router.Type(&v1.EventInstance{}).HandlerFunc(eventinstance.GCExpired())
+	router.Type(&v1.EventInstance{}).HandlerFunc(eventinstance.DeliverToSubscriptions())
 
 	router.Type(&batchv1.Job{}).Selector(managedSelector).HandlerFunc(jobs.JobCleanup)
+	router.Type(&batchv1.Job{}).Selector(managedSelector).HandlerFunc(jobs.RecordJobFailure(recorder))
 	router.Type(&rbacv1.ClusterRole{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
 	router.Type(&rbacv1.ClusterRoleBinding{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
 	router.Type(&corev1.PersistentVolumeClaim{}).Selector(managedSelector).HandlerFunc(pvc.MarkAndSave)
@@ -106,6 +114,7 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	router.Type(&appsv1.Deployment{}).Namespace(system.ImagesNamespace).HandlerFunc(gc.GCOrphans)
 	router.Type(&corev1.Service{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
 	router.Type(&policyv1.PodDisruptionBudget{}).Namespace(system.ImagesNamespace).HandlerFunc(gc.GCOrphans)
+	router.Type(&autoscalingv2.HorizontalPodAutoscaler{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
 	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
 	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(jobs.JobPodOrphanCleanup)
 	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(jobsHandler.SaveJobOutput)
@@ -0,0 +1,20 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	"github.com/acorn-io/runtime/pkg/scheme"
+)
+
+func TestContainerCPU(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/cpu/container", Calculate)
+}
+
+func TestOverwriteAcornfileCPU(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/cpu/overwrite-acornfile-cpu", Calculate)
+}
+
+func TestComputeClassCPU(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/cpu/computeclass", Calculate)
+}
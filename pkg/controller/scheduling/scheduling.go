@@ -163,7 +163,44 @@ func ResourceRequirements(req router.Request, app *v1.AppInstance, containerName
 		requirements.Limits[corev1.ResourceMemory] = memoryQuantity
 	}
 
+	var cpuDefault *int64
+	if val, ok := app.Status.Defaults.CPU[containerName]; ok && val != nil {
+		cpuDefault = val
+	} else if val, ok := app.Status.Defaults.CPU[""]; ok && val != nil {
+		cpuDefault = val
+	}
+
+	cpuMax := cfg.WorkloadCPUMaximum
 	if computeClass != nil {
+		cpuMax = new(int64)
+		if computeClass.CPU.Max != "" && computeClass.CPU.Max != "0" {
+			maxQuantity, err := resource.ParseQuantity(computeClass.CPU.Max)
+			if err != nil {
+				return nil, err
+			}
+			cpuMax = &[]int64{maxQuantity.MilliValue()}[0]
+		}
+	}
+
+	cpuQuantity, err := v1.ValidateCPU(app.Spec.CPU, containerName, container, cpuDefault, cpuMax)
+	if err != nil {
+		return nil, err
+	}
+
+	// An explicit cpu sets both the requests and limits. Otherwise, fall back to the cpu request
+	// derived from the memory by the ComputeClass CPUScaler.
+	if cpuQuantity.MilliValue() != 0 {
+		if computeClass != nil {
+			if err := computeclasses.ValidateProjectComputeClass(*computeClass, memoryQuantity, memDefault); err != nil {
+				return nil, err
+			}
+			if err := computeclasses.ValidateProjectComputeClassCPU(*computeClass, cpuQuantity, cpuDefault); err != nil {
+				return nil, err
+			}
+		}
+		requirements.Requests[corev1.ResourceCPU] = cpuQuantity
+		requirements.Limits[corev1.ResourceCPU] = cpuQuantity
+	} else if computeClass != nil {
 		cpuQuantity, err := computeclasses.CalculateCPU(*computeClass, memDefault, memoryQuantity)
 		if err != nil {
 			return nil, err
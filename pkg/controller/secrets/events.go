@@ -0,0 +1,75 @@
+package secrets
+
+import (
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	SecretRotatedEventType = "SecretRotated"
+)
+
+// SecretRotatedEventDetails captures additional info about the rotation of a secret.
+type SecretRotatedEventDetails struct {
+	// SecretName is the name of the secret in the app.
+	SecretName string `json:"secretName"`
+
+	// SourceSecretName is the name of the secret in the project that holds the value.
+	SourceSecretName string `json:"sourceSecretName"`
+
+	// RotatedAt is the time the new value was generated.
+	RotatedAt string `json:"rotatedAt"`
+
+	// PreviousExpires is the time the previous value is removed from the secret.
+	// +optional
+	PreviousExpires string `json:"previousExpires,omitempty"`
+}
+
+// recordRotation records an event if the secret was rotated since it was last copied to the app namespace
+func recordRotation(req router.Request, recorder event.Recorder, appInstance *v1.AppInstance, secretName string, secret *corev1.Secret) error {
+	rotatedAt := secret.Annotations[labels.AcornSecretRotatedAt]
+
+	existing := &corev1.Secret{}
+	if err := req.Get(existing, appInstance.Status.Namespace, secretName); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if existing.Annotations[labels.AcornSecretRotatedAt] == rotatedAt {
+		return nil
+	}
+
+	e := apiv1.Event{
+		Type:        SecretRotatedEventType,
+		Actor:       "acorn-system",
+		Severity:    v1.EventSeverityInfo,
+		Description: fmt.Sprintf("Rotated secret %s", secretName),
+		Source:      event.ObjectSource(appInstance),
+		Observed:    v1.MicroTime(metav1.NowMicro()),
+	}
+	e.SetNamespace(appInstance.GetNamespace())
+
+	var err error
+	if e.Details, err = v1.Mapify(SecretRotatedEventDetails{
+		SecretName:       secretName,
+		SourceSecretName: secret.Name,
+		RotatedAt:        rotatedAt,
+		PreviousExpires:  secret.Annotations[labels.AcornSecretPreviousExpires],
+	}); err != nil {
+		logrus.Warnf("Failed to mapify event details: %s", err.Error())
+	}
+
+	if err := recorder.Record(req.Ctx, &e); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+	return nil
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/secrets"
@@ -47,7 +49,13 @@ func addSecretError(appInstance *v1.AppInstance, secretName string, err error) {
 	appInstance.Status.AppStatus.Secrets[secretName] = c
 }
 
-func CreateSecrets(req router.Request, resp router.Response) (err error) {
+func CreateSecrets(recorder event.Recorder) router.HandlerFunc {
+	return func(req router.Request, resp router.Response) error {
+		return createSecrets(req, resp, recorder)
+	}
+}
+
+func createSecrets(req router.Request, resp router.Response, recorder event.Recorder) (err error) {
 	var (
 		appInstance = req.Object.(*v1.AppInstance)
 		allSecrets  = map[string]*corev1.Secret{}
@@ -85,6 +93,19 @@ func CreateSecrets(req router.Request, resp router.Response) (err error) {
 			continue
 		}
 
+		if entry.secret.Type == "external" {
+			// Resolve the secret again when the TTL expires, or after a backoff if it could not be refreshed
+			refreshAfter := secrets.ExternalSecretRefreshAfter(secret, entry.secret, time.Now())
+			if refreshAfter < secrets.MinExternalSecretTTL {
+				refreshAfter = secrets.MinExternalSecretTTL
+			}
+			resp.RetryAfter(refreshAfter)
+		}
+
+		if retryAfter, ok := secrets.RotationRetryAfter(secret, entry.secret, time.Now()); ok {
+			resp.RetryAfter(retryAfter)
+		}
+
 		labelMap := map[string]string{
 			labels.AcornAppName:          appInstance.Name,
 			labels.AcornAppNamespace:     appInstance.Namespace,
@@ -100,6 +121,13 @@ func CreateSecrets(req router.Request, resp router.Response) (err error) {
 
 		annotations[labels.AcornAppGeneration] = strconv.FormatInt(appInstance.Generation, 10)
 
+		if rotatedAt := secret.Annotations[labels.AcornSecretRotatedAt]; rotatedAt != "" {
+			annotations[labels.AcornSecretRotatedAt] = rotatedAt
+			if err := recordRotation(req, recorder, appInstance, secretName, secret); err != nil {
+				return err
+			}
+		}
+
 		resp.Objects(&corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        secretName,
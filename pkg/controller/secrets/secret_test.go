@@ -1,25 +1,39 @@
 package secrets
 
 import (
+	"context"
+	"errors"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/router/tester"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/secrets"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var nopRecorder = event.RecorderFunc(func(context.Context, *apiv1.Event) error {
+	return nil
+})
+
 func TestSecretImageReference(t *testing.T) {
-	tester.DefaultTest(t, scheme.Scheme, "testdata/secret-image", CreateSecrets)
+	tester.DefaultTest(t, scheme.Scheme, "testdata/secret-image", CreateSecrets(nopRecorder))
 }
 
 func TestSecretEncrypted(t *testing.T) {
-	resp := tester.DefaultTest(t, scheme.Scheme, "testdata/secret-encrypted", CreateSecrets)
+	resp := tester.DefaultTest(t, scheme.Scheme, "testdata/secret-encrypted", CreateSecrets(nopRecorder))
 	secret := resp.Client.Created[0].(*corev1.Secret)
 	assert.Equal(t, "foo-", secret.GenerateName)
 	assert.Equal(t, "app-namespace", secret.Namespace)
@@ -54,7 +68,7 @@ func TestOpaque_Gen(t *testing.T) {
 				},
 			},
 		},
-	}, CreateSecrets)
+	}, CreateSecrets(nopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -103,7 +117,7 @@ func TestBasic_Gen(t *testing.T) {
 				},
 			},
 		},
-	}, CreateSecrets)
+	}, CreateSecrets(nopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -154,7 +168,7 @@ func TestTemplateTokenMissing_Gen(t *testing.T) {
 		},
 	}
 
-	resp, err := h.InvokeFunc(t, app, CreateSecrets)
+	resp, err := h.InvokeFunc(t, app, CreateSecrets(nopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -205,7 +219,7 @@ func TestTemplateToken_Gen(t *testing.T) {
 				},
 			},
 		},
-	}, CreateSecrets)
+	}, CreateSecrets(nopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -321,7 +335,7 @@ func TestSecretLabelsAnnotations(t *testing.T) {
 				},
 			},
 		},
-	}, CreateSecrets)
+	}, CreateSecrets(nopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -375,3 +389,377 @@ func TestSecretLabelsAnnotations(t *testing.T) {
 	assert.Contains(t, secret.Annotations, "globalfromacornfilea")
 	assert.NotContains(t, secret.Annotations, "sec1fromacornfilea")
 }
+
+func TestExternal_Gen(t *testing.T) {
+	provider := secrets.NewFakeProvider()
+	provider.Set("apps/db", map[string]string{"password": "first"})
+	secrets.RegisterProvider("fake", provider)
+
+	invoke := func(existing ...kclient.Object) (*v1.AppInstance, *tester.Response) {
+		app := &v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app-name",
+				Namespace: "app-ns",
+			},
+			Status: v1.AppInstanceStatus{
+				Namespace: "app-target-ns",
+				AppImage: v1.AppImage{
+					ID: "test",
+				},
+				AppSpec: v1.AppSpec{
+					Secrets: map[string]v1.Secret{
+						"db": {
+							Type: "external",
+							Params: map[string]any{
+								"provider": "fake",
+								"path":     "apps/db",
+								"ttl":      "1h",
+							},
+						},
+					},
+				},
+			},
+		}
+		req := tester.NewRequest(t, scheme.Scheme, app, existing...)
+		resp := &tester.Response{Client: req.Client.(*tester.Client)}
+		if err := CreateSecrets(nopRecorder)(req, resp); err != nil {
+			t.Fatal(err)
+		}
+		return app, resp
+	}
+
+	_, resp := invoke()
+	assert.Len(t, resp.Client.Created, 1)
+	assert.Equal(t, 1, provider.Calls())
+	secret := resp.Client.Created[0].(*corev1.Secret)
+	assert.Equal(t, v1.SecretTypeExternal, secret.Type)
+	assert.Equal(t, "first", string(secret.Data["password"]))
+	assert.NotEmpty(t, secret.Annotations[labels.AcornSecretRefreshedAt])
+	assert.Equal(t, time.Hour, resp.Delay.Round(time.Minute))
+
+	// The data is used until the TTL expires
+	provider.Set("apps/db", map[string]string{"password": "second"})
+	_, resp = invoke(secret)
+	assert.Equal(t, 1, provider.Calls())
+	assert.Equal(t, "first", string(resp.Collected[0].(*corev1.Secret).Data["password"]))
+
+	// After the TTL the secret is updated, which redeploys the containers that use it with onChange: redeploy
+	expired := secret.DeepCopy()
+	expired.Annotations[labels.AcornSecretRefreshedAt] = time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	_, resp = invoke(expired)
+	assert.Equal(t, 2, provider.Calls())
+	assert.Len(t, resp.Client.Updated, 1)
+	assert.Equal(t, "second", string(resp.Collected[0].(*corev1.Secret).Data["password"]))
+
+	// The last known data is kept while the provider fails
+	provider.SetError(errors.New("provider unavailable"))
+	_, resp = invoke(expired)
+	assert.Equal(t, "first", string(resp.Collected[0].(*corev1.Secret).Data["password"]))
+	assert.InDelta(t, float64(secrets.MinExternalSecretTTL), float64(resp.Delay), float64(time.Second))
+	require.Len(t, resp.Client.Updated, 1)
+	failed := resp.Client.Updated[0].(*corev1.Secret)
+	assert.Equal(t, "1", failed.Annotations[labels.AcornSecretRefreshFailures])
+
+	// The provider is not asked again until the backoff expires
+	calls := provider.Calls()
+	_, resp = invoke(failed)
+	assert.Equal(t, calls, provider.Calls())
+	assert.Empty(t, resp.Client.Updated)
+
+	// The backoff doubles with every failure
+	failed.Annotations[labels.AcornSecretRefreshFailedAt] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	_, resp = invoke(failed)
+	assert.Equal(t, calls+1, provider.Calls())
+	require.Len(t, resp.Client.Updated, 1)
+	assert.Equal(t, "2", resp.Client.Updated[0].(*corev1.Secret).Annotations[labels.AcornSecretRefreshFailures])
+	assert.InDelta(t, float64(2*secrets.MinExternalSecretTTL), float64(resp.Delay), float64(time.Second))
+
+	// Without data there is nothing to fall back to
+	app, resp := invoke()
+	assert.Empty(t, resp.Collected)
+	assert.Contains(t, app.Status.AppStatus.Secrets["db"].LookupErrors[0], "provider unavailable")
+}
+
+func TestToken_Rotate(t *testing.T) {
+	var recorded []apiv1.Event
+	recorder := event.RecorderFunc(func(_ context.Context, e *apiv1.Event) error {
+		recorded = append(recorded, *e)
+		return nil
+	})
+
+	invoke := func(existing ...kclient.Object) *tester.Response {
+		app := &v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app-name",
+				Namespace: "app-ns",
+			},
+			Status: v1.AppInstanceStatus{
+				Namespace: "app-target-ns",
+				AppImage: v1.AppImage{
+					ID: "test",
+				},
+				AppSpec: v1.AppSpec{
+					Secrets: map[string]v1.Secret{
+						"tok": {
+							Type: "token",
+							Params: map[string]any{
+								"length":     int64(16),
+								"characters": "abcdef",
+							},
+							Rotate: &v1.SecretRotate{
+								Every: metav1.Duration{Duration: 720 * time.Hour},
+							},
+						},
+					},
+				},
+			},
+		}
+		req := tester.NewRequest(t, scheme.Scheme, app, existing...)
+		resp := &tester.Response{Client: req.Client.(*tester.Client)}
+		if err := CreateSecrets(recorder)(req, resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tok-abcde",
+			Namespace: "app-ns",
+			Labels: map[string]string{
+				labels.AcornAppName:         "app-name",
+				labels.AcornManaged:         "true",
+				labels.AcornSecretName:      "tok",
+				labels.AcornSecretGenerated: "true",
+				labels.AcornPublicName:      "app-name.tok",
+			},
+			Annotations: map[string]string{
+				labels.AcornSecretRotatedAt: time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339),
+			},
+		},
+		Data: map[string][]byte{"token": []byte("old")},
+		Type: v1.SecretTypeToken,
+	}
+	appSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tok",
+			Namespace: "app-target-ns",
+			Annotations: map[string]string{
+				labels.AcornSecretRotatedAt: source.Annotations[labels.AcornSecretRotatedAt],
+			},
+		},
+	}
+
+	// Not due yet, check again when the interval passed
+	resp := invoke(source, appSecret)
+	assert.Empty(t, resp.Client.Updated)
+	assert.Equal(t, 696*time.Hour, resp.Delay.Round(time.Hour))
+	assert.Empty(t, recorded)
+
+	// Due, the old value is kept as previous for the grace period
+	source.Annotations[labels.AcornSecretRotatedAt] = time.Now().Add(-800 * time.Hour).UTC().Format(time.RFC3339)
+	resp = invoke(source, appSecret)
+	assert.Len(t, resp.Client.Updated, 1)
+	rotated := resp.Client.Updated[0].(*corev1.Secret)
+	assert.Len(t, rotated.Data["token"], 16)
+	assert.Equal(t, "old", string(rotated.Data[secrets.PreviousKey]))
+	assert.NotEmpty(t, rotated.Annotations[labels.AcornSecretPreviousExpires])
+	assert.Equal(t, time.Hour, resp.Delay.Round(time.Minute))
+	assert.Equal(t, rotated.Data, resp.Collected[0].(*corev1.Secret).Data)
+	assert.Equal(t, rotated.Annotations[labels.AcornSecretRotatedAt], resp.Collected[0].(*corev1.Secret).Annotations[labels.AcornSecretRotatedAt])
+	assert.Len(t, recorded, 1)
+	assert.Equal(t, SecretRotatedEventType, recorded[0].Type)
+	assert.Equal(t, "tok", recorded[0].Details["secretName"])
+
+	// The previous value is removed after the grace period
+	expired := rotated.DeepCopy()
+	expired.Annotations[labels.AcornSecretPreviousExpires] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	resp = invoke(expired, appSecret)
+	assert.Len(t, resp.Client.Updated, 1)
+	assert.NotContains(t, resp.Client.Updated[0].(*corev1.Secret).Data, secrets.PreviousKey)
+	assert.Equal(t, rotated.Data["token"], resp.Client.Updated[0].(*corev1.Secret).Data["token"])
+
+	// acorn secret rotate requests a rotation before it is due
+	requested := source.DeepCopy()
+	requested.Annotations[labels.AcornSecretRotatedAt] = time.Now().UTC().Format(time.RFC3339)
+	requested.Annotations[labels.AcornSecretRotateRequested] = time.Now().UTC().Format(time.RFC3339)
+	resp = invoke(requested, appSecret)
+	assert.Len(t, resp.Client.Updated, 1)
+	assert.Equal(t, "old", string(resp.Client.Updated[0].(*corev1.Secret).Data[secrets.PreviousKey]))
+	assert.NotContains(t, resp.Client.Updated[0].(*corev1.Secret).Annotations, labels.AcornSecretRotateRequested)
+}
+
+func TestToken_RotateFixed(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-name",
+			Namespace: "app-ns",
+		},
+		Status: v1.AppInstanceStatus{
+			Namespace: "app-target-ns",
+			AppImage: v1.AppImage{
+				ID: "test",
+			},
+			AppSpec: v1.AppSpec{
+				Secrets: map[string]v1.Secret{
+					"tok": {
+						Type: "token",
+						Params: map[string]any{
+							"length":     int64(16),
+							"characters": "abcdef",
+						},
+						Data: map[string]string{"token": "fixed"},
+						Rotate: &v1.SecretRotate{
+							Every: metav1.Duration{Duration: 720 * time.Hour},
+						},
+					},
+				},
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tok-abcde",
+			Namespace: "app-ns",
+			Labels: map[string]string{
+				labels.AcornAppName:         "app-name",
+				labels.AcornManaged:         "true",
+				labels.AcornSecretName:      "tok",
+				labels.AcornSecretGenerated: "true",
+				labels.AcornPublicName:      "app-name.tok",
+			},
+			Annotations: map[string]string{
+				labels.AcornSecretRotatedAt:       time.Now().Add(-800 * time.Hour).UTC().Format(time.RFC3339),
+				labels.AcornSecretRotateRequested: time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+		Data: map[string][]byte{"token": []byte("fixed")},
+		Type: v1.SecretTypeToken,
+	}
+
+	req := tester.NewRequest(t, scheme.Scheme, app, source)
+	resp := &tester.Response{Client: req.Client.(*tester.Client)}
+	require.NoError(t, CreateSecrets(event.RecorderFunc(func(context.Context, *apiv1.Event) error { return nil }))(req, resp))
+
+	// A fixed token is never rotated, so there is nothing to check again and the request to rotate it is dropped
+	assert.Zero(t, resp.Delay)
+	require.Len(t, resp.Client.Updated, 1)
+	updated := resp.Client.Updated[0].(*corev1.Secret)
+	assert.Equal(t, "fixed", string(updated.Data["token"]))
+	assert.NotContains(t, updated.Data, secrets.PreviousKey)
+	assert.NotContains(t, updated.Annotations, labels.AcornSecretRotateRequested)
+}
+
+func TestGenerated_Rotate(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-name",
+			Namespace: "app-ns",
+		},
+		Status: v1.AppInstanceStatus{
+			Namespace: "app-target-ns",
+			AppImage: v1.AppImage{
+				ID: "test",
+			},
+			AppSpec: v1.AppSpec{
+				Jobs: map[string]v1.Container{
+					"gen": {},
+				},
+				Secrets: map[string]v1.Secret{
+					"gen": {
+						Type: "generated",
+						Params: map[string]any{
+							"job":    "gen",
+							"format": "text",
+						},
+					},
+				},
+			},
+		},
+	}
+	invoke := func(existing ...kclient.Object) *tester.Response {
+		req := tester.NewRequest(t, scheme.Scheme, app.DeepCopy(), existing...)
+		resp := &tester.Response{Client: req.Client.(*tester.Client)}
+		if err := CreateSecrets(nopRecorder)(req, resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gen-abcde",
+			Namespace: "app-ns",
+			Labels: map[string]string{
+				labels.AcornAppName:         "app-name",
+				labels.AcornManaged:         "true",
+				labels.AcornSecretName:      "gen",
+				labels.AcornSecretGenerated: "true",
+				labels.AcornPublicName:      "app-name.gen",
+			},
+			Annotations: map[string]string{
+				labels.AcornSecretRotateRequested: time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+		Data: map[string][]byte{"content": []byte("old")},
+		Type: v1.SecretTypeGenerated,
+	}
+	output := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobs.GetJobOutputSecretName(context.Background(), "app-target-ns", "gen"),
+			Namespace: "app-target-ns",
+		},
+		Data: map[string][]byte{"out": []byte("old")},
+	}
+
+	// The rotation starts and the current value is kept until the job ran again
+	resp := invoke(source, output)
+	assert.Len(t, resp.Client.Updated, 1)
+	pending := resp.Client.Updated[0].(*corev1.Secret)
+	rotation := pending.Annotations[labels.AcornSecretRotation]
+	assert.NotEmpty(t, rotation)
+	assert.NotContains(t, pending.Annotations, labels.AcornSecretRotateRequested)
+	assert.Equal(t, "old", string(pending.Data["content"]))
+
+	req := tester.NewRequest(t, scheme.Scheme, app.DeepCopy(), pending)
+	annotations, err := secrets.JobRotationAnnotations(req, app, "gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{labels.AcornSecretRotationPrefix + "gen": rotation}, annotations)
+
+	// Once the job completed with the rotation the new output becomes the value
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gen",
+			Namespace: "app-target-ns",
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: annotations,
+				},
+			},
+		},
+		Status: batchv1.JobStatus{
+			Succeeded: 1,
+		},
+	}
+	output.Data["out"] = []byte("new")
+	resp = invoke(pending, output, job)
+	assert.Len(t, resp.Client.Updated, 1)
+	rotated := resp.Client.Updated[0].(*corev1.Secret)
+	assert.Equal(t, "new", string(rotated.Data["content"]))
+	assert.Equal(t, "old", string(rotated.Data[secrets.PreviousKey]))
+	assert.Equal(t, rotation, rotated.Annotations[labels.AcornSecretRotatedAt])
+	assert.Equal(t, rotation, rotated.Annotations[labels.AcornSecretRotation])
+
+	// Secrets of scheduled jobs take the latest output of the job, the rotation request is dropped
+	app.Status.AppSpec.Jobs["gen"] = v1.Container{Schedule: "@daily"}
+	resp = invoke(source, output)
+	assert.Len(t, resp.Client.Updated, 1)
+	scheduled := resp.Client.Updated[0].(*corev1.Secret)
+	assert.NotContains(t, scheduled.Annotations, labels.AcornSecretRotateRequested)
+	assert.NotContains(t, scheduled.Annotations, labels.AcornSecretRotation)
+	assert.Equal(t, "new", string(scheduled.Data["content"]))
+}
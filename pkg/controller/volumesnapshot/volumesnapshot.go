@@ -0,0 +1,312 @@
+package volumesnapshot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/uncached"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/condition"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/volume"
+	"github.com/rancher/wrangler/pkg/name"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	sourceMount = "/source"
+	targetMount = "/target"
+)
+
+// Snapshot takes a snapshot of the volume referenced by the VolumeSnapshotInstance. If the volume is provisioned
+// by a CSI driver that has a VolumeSnapshotClass then a CSI VolumeSnapshot is created, otherwise the data is copied
+// to a new PersistentVolumeClaim by a job.
+func Snapshot(req router.Request, resp router.Response) error {
+	if req.Object == nil {
+		return nil
+	}
+
+	snapshot := req.Object.(*v1.VolumeSnapshotInstance)
+	cond := condition.Setter(snapshot, resp, v1.VolumeSnapshotConditionReady)
+
+	// The source is only resolved once so the snapshot is kept even if the source volume is later removed.
+	if snapshot.Status.SnapshotName == "" {
+		if err := resolveSource(req, snapshot); err != nil {
+			cond.Error(err)
+			return nil
+		}
+	}
+
+	var (
+		ready bool
+		err   error
+	)
+	switch snapshot.Status.Method {
+	case v1.VolumeSnapshotMethodCSI:
+		ready, err = csiSnapshot(req, resp, snapshot)
+	default:
+		ready, err = copySnapshot(req, resp, snapshot)
+	}
+	if err != nil {
+		cond.Error(err)
+		return nil
+	}
+
+	snapshot.Status.ReadyToUse = ready
+	if ready {
+		cond.Success()
+	} else {
+		cond.Unknown("waiting for snapshot to be ready")
+	}
+	return nil
+}
+
+func resolveSource(req router.Request, snapshot *v1.VolumeSnapshotInstance) error {
+	pv, err := volume.FindPersistentVolume(req.Ctx, req.Client, snapshot.Namespace, snapshot.Spec.VolumeName)
+	if err != nil {
+		return err
+	}
+	if pv.Spec.ClaimRef == nil || pv.Spec.ClaimRef.Name == "" {
+		return fmt.Errorf("volume %s is not bound to an app", snapshot.Spec.VolumeName)
+	}
+
+	snapshot.Status.AppName = pv.Labels[labels.AcornAppName]
+	snapshot.Status.AppNamespace = pv.Spec.ClaimRef.Namespace
+	snapshot.Status.VolumeName = pv.Labels[labels.AcornVolumeName]
+	snapshot.Status.ClaimName = pv.Spec.ClaimRef.Name
+
+	// The claim of a bound or restored volume is not named after the volume in the Acornfile, but its labels are.
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := req.Get(pvc, pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name); err == nil {
+		if volumeName := pvc.Labels[labels.AcornVolumeName]; volumeName != "" {
+			snapshot.Status.VolumeName = volumeName
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+	snapshot.Status.RestoreSize = pv.Spec.Capacity.Storage()
+
+	if pv.Spec.CSI != nil {
+		class, err := volume.GetVolumeSnapshotClass(req.Ctx, req.Client, pv.Spec.CSI.Driver)
+		if err != nil {
+			return err
+		}
+		if class != "" {
+			snapshot.Status.Method = v1.VolumeSnapshotMethodCSI
+			snapshot.Status.ClassName = class
+		}
+	}
+
+	if snapshot.Status.Method == "" {
+		snapshot.Status.Method = v1.VolumeSnapshotMethodCopy
+		snapshot.Status.ClassName = pv.Spec.StorageClassName
+	}
+
+	snapshot.Status.SnapshotName = name.SafeConcatName("snapshot", snapshot.Name)
+	return nil
+}
+
+func csiSnapshot(req router.Request, resp router.Response, snapshot *v1.VolumeSnapshotInstance) (bool, error) {
+	vs := &unstructured.Unstructured{}
+	vs.SetGroupVersionKind(volume.VolumeSnapshotGVK)
+	vs.SetName(snapshot.Status.SnapshotName)
+	vs.SetNamespace(snapshot.Status.AppNamespace)
+	vs.SetLabels(snapshotLabels(snapshot))
+	vs.Object["spec"] = map[string]interface{}{
+		"volumeSnapshotClassName": snapshot.Status.ClassName,
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": snapshot.Status.ClaimName,
+		},
+	}
+	resp.Objects(vs)
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(volume.VolumeSnapshotGVK)
+	if err := req.Get(uncached.Get(existing), snapshot.Status.AppNamespace, snapshot.Status.SnapshotName); apierrors.IsNotFound(err) {
+		resp.RetryAfter(5 * time.Second)
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if msg, _, _ := unstructured.NestedString(existing.Object, "status", "error", "message"); msg != "" {
+		return false, fmt.Errorf("snapshot failed: %s", msg)
+	}
+
+	if size, ok, _ := unstructured.NestedString(existing.Object, "status", "restoreSize"); ok {
+		if q, err := resource.ParseQuantity(size); err == nil {
+			snapshot.Status.RestoreSize = &q
+		}
+	}
+
+	ready, _, _ := unstructured.NestedBool(existing.Object, "status", "readyToUse")
+	if !ready {
+		resp.RetryAfter(5 * time.Second)
+	}
+	return ready, nil
+}
+
+func copySnapshot(req router.Request, resp router.Response, snapshot *v1.VolumeSnapshotInstance) (bool, error) {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      snapshot.Status.SnapshotName,
+			Namespace: snapshot.Status.AppNamespace,
+			Labels:    snapshotLabels(snapshot),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{},
+			},
+		},
+	}
+	if snapshot.Status.ClassName != "" {
+		pvc.Spec.StorageClassName = &snapshot.Status.ClassName
+	}
+	if snapshot.Status.RestoreSize != nil {
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *snapshot.Status.RestoreSize
+	} else {
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.DefaultSize
+	}
+
+	job, err := CopyJob(req, snapshot.Status.SnapshotName, snapshot.Status.AppNamespace, snapshot.Status.ClaimName, snapshot.Status.SnapshotName)
+	if err != nil {
+		return false, err
+	}
+	job.Labels = snapshotLabels(snapshot)
+	resp.Objects(pvc, job)
+
+	return CopyJobDone(req, job.Namespace, job.Name)
+}
+
+// CopyJobDone returns true once the copy job has succeeded and an error if it has failed.
+func CopyJobDone(req router.Request, namespace, name string) (bool, error) {
+	existing := &batchv1.Job{}
+	if err := req.Get(existing, namespace, name); apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if existing.Status.Succeeded > 0 {
+		return true, nil
+	}
+	for _, c := range existing.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return false, fmt.Errorf("copying volume data failed: %s", c.Message)
+		}
+	}
+	return false, nil
+}
+
+// CopyJob returns a job that copies the data of the source claim into the target claim. ReadWriteOnce claims can only be
+// attached to one node, so the job runs on the node of a pod that already has one of the claims mounted. The pod template
+// of a job can not be changed, so the node of an existing job is kept.
+func CopyJob(req router.Request, jobName, namespace, sourceClaim, targetClaim string) (*batchv1.Job, error) {
+	var nodeName string
+	existing := &batchv1.Job{}
+	if err := req.Get(existing, namespace, jobName); err == nil {
+		nodeName = existing.Spec.Template.Spec.NodeName
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	} else {
+		for _, claim := range []string{sourceClaim, targetClaim} {
+			nodeName, err = volume.NodeForClaim(req.Ctx, req.Client, namespace, claim)
+			if err != nil {
+				return nil, err
+			}
+			if nodeName != "" {
+				break
+			}
+		}
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: namespace,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &[]int32{3}[0],
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					NodeName:      nodeName,
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:    "copy",
+							Image:   system.DefaultImage(),
+							Command: []string{"cp", "-a", sourceMount + "/.", targetMount + "/"},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "source",
+									MountPath: sourceMount,
+									ReadOnly:  true,
+								},
+								{
+									Name:      "target",
+									MountPath: targetMount,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "source",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: sourceClaim,
+									ReadOnly:  true,
+								},
+							},
+						},
+						{
+							Name: "target",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: targetClaim,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func snapshotLabels(snapshot *v1.VolumeSnapshotInstance) map[string]string {
+	return map[string]string{
+		labels.AcornAppName:            snapshot.Status.AppName,
+		labels.AcornAppNamespace:       snapshot.Namespace,
+		labels.AcornVolumeSnapshotName: snapshot.Name,
+	}
+}
+
+// GetReadySnapshot returns the snapshot referenced by a volume "from" field, ensuring it is ready to be restored
+// into the given app namespace.
+func GetReadySnapshot(req router.Request, namespace, appNamespace, from string) (*v1.VolumeSnapshotInstance, error) {
+	snapshotName, ok := v1.ParseSnapshotFrom(from)
+	if !ok {
+		return nil, fmt.Errorf("invalid volume from [%s], must be in the format %sNAME", from, v1.SnapshotFromPrefix)
+	}
+
+	snapshot := &v1.VolumeSnapshotInstance{}
+	if err := req.Get(snapshot, namespace, snapshotName); err != nil {
+		return nil, err
+	}
+	if snapshot.Status.AppNamespace != appNamespace {
+		return nil, fmt.Errorf("volume snapshot %s can only be restored to app %s", snapshotName, snapshot.Status.AppName)
+	}
+	if !snapshot.Status.ReadyToUse {
+		return nil, fmt.Errorf("volume snapshot %s is not ready", snapshotName)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,20 @@
+package volumesnapshot
+
+import (
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	"github.com/acorn-io/runtime/pkg/scheme"
+)
+
+func TestCopySnapshot(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/copy", Snapshot)
+}
+
+func TestCopySnapshotReady(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/copy-ready", Snapshot)
+}
+
+func TestCopySnapshotMounted(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/copy-mounted", Snapshot)
+}
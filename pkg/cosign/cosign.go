@@ -34,6 +34,7 @@ type VerifyOpts struct {
 	Namespace          string
 	AnnotationRules    v1.SignatureAnnotations
 	Key                string
+	Keyless            *v1.KeylessSigner
 	SignatureAlgorithm string
 	OciRemoteOpts      []ociremote.Option
 	CraneOpts          []crane.Option
@@ -149,7 +150,8 @@ func ensureSignatureArtifact(ctx context.Context, c client.Reader, namespace str
 	return sigRefToUse, nil
 }
 
-// VerifySignature checks if the image is signed with the given key and if the annotations match the given rules
+// VerifySignature checks if the image is signed with the given key, or by one of the given keyless identities, and if
+// the annotations match the given rules
 // This does a lot of image and image manifest juggling to fetch artifacts, digests, etc. from the registry, so we have to be
 // careful to not do too many GET requests that count against registry rate limits (e.g. for DockerHub).
 // Crane uses HEAD (with GET as a fallback) wherever it can, so it's a good choice here e.g. for fetching digests.
@@ -180,6 +182,10 @@ func VerifySignature(ctx context.Context, opts VerifyOpts) error {
 			return fmt.Errorf("failed to load key: %w", err)
 		}
 		cosignOpts.SigVerifier = verifier
+	} else if opts.Keyless != nil {
+		if err := setKeylessCheckOpts(ctx, *opts.Keyless, cosignOpts); err != nil {
+			return fmt.Errorf("failed to set up keyless verification: %w", err)
+		}
 	}
 
 	// --- get and verify signatures
@@ -0,0 +1,251 @@
+// Package cosigntest provides a fake certificate authority and transparency log to create keyless signatures in tests.
+package cosigntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cyberphone/json-canonicalization/go/src/webpki.org/jsoncanonicalizer"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+)
+
+// oidcIssuerOID is the certificate extension that Fulcio stores the OIDC issuer of the identity in
+var oidcIssuerOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+
+// Sigstore issues short-lived signing certificates like Fulcio and records signatures like Rekor, without any network
+type Sigstore struct {
+	caKey    *ecdsa.PrivateKey
+	caCert   *x509.Certificate
+	caPEM    []byte
+	logKey   *ecdsa.PrivateKey
+	logIndex int64
+}
+
+// SignOptions describe the identity that an image is signed by
+type SignOptions struct {
+	Issuer      string
+	Subject     string
+	Annotations map[string]interface{}
+	// NoTransparencyLog signs the image without a transparency log entry
+	NoTransparencyLog bool
+}
+
+func NewSigstore() (*Sigstore, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake-sigstore", Organization: []string{"acorn"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, caKey.Public(), caKey)
+	if err != nil {
+		return nil, err
+	}
+	caCert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	caPEM, err := cryptoutils.MarshalCertificateToPEM(caCert)
+	if err != nil {
+		return nil, err
+	}
+
+	logKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sigstore{
+		caKey:  caKey,
+		caCert: caCert,
+		caPEM:  caPEM,
+		logKey: logKey,
+	}, nil
+}
+
+// CertificateAuthority returns the PEM encoded root certificate of the fake certificate authority
+func (s *Sigstore) CertificateAuthority() string {
+	return string(s.caPEM)
+}
+
+// TransparencyLogKey returns the PEM encoded public key of the fake transparency log
+func (s *Sigstore) TransparencyLogKey() string {
+	pem, err := cryptoutils.MarshalPublicKeyToPEM(s.logKey.Public())
+	if err != nil {
+		panic(err)
+	}
+	return string(pem)
+}
+
+// Sign signs the image with a certificate issued to the identity and pushes the signature to the registry of the image
+func (s *Sigstore) Sign(img name.Digest, opts SignOptions, remoteOpts ...ociremote.Option) error {
+	key, certPEM, err := s.issueCertificate(opts.Issuer, opts.Subject)
+	if err != nil {
+		return fmt.Errorf("failed to issue certificate: %w", err)
+	}
+
+	pld, err := (&payload.Cosign{Image: img, Annotations: opts.Annotations}).MarshalJSON()
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(pld)
+	rawSig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		return err
+	}
+	b64Sig := base64.StdEncoding.EncodeToString(rawSig)
+
+	sigOpts := []static.Option{static.WithCertChain(certPEM, s.caPEM)}
+	if !opts.NoTransparencyLog {
+		rekorBundle, err := s.logEntry(digest[:], b64Sig, certPEM)
+		if err != nil {
+			return fmt.Errorf("failed to create transparency log entry: %w", err)
+		}
+		sigOpts = append(sigOpts, static.WithBundle(rekorBundle))
+	}
+
+	sig, err := static.NewSignature(pld, b64Sig, sigOpts...)
+	if err != nil {
+		return err
+	}
+
+	entity, err := ociremote.SignedEntity(img, remoteOpts...)
+	if err != nil {
+		return err
+	}
+	entity, err = mutate.AttachSignatureToEntity(entity, sig)
+	if err != nil {
+		return err
+	}
+	return ociremote.WriteSignatures(img.Repository, entity, remoteOpts...)
+}
+
+func (s *Sigstore) issueCertificate(issuer, subject string) (*ecdsa.PrivateKey, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:    serial,
+		NotBefore:       time.Now().Add(-time.Minute),
+		NotAfter:        time.Now().Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		ExtraExtensions: []pkix.Extension{{Id: oidcIssuerOID, Value: []byte(issuer)}},
+	}
+	// Workload identities, like CI workflows, are URIs while users are identified by their email
+	if strings.Contains(subject, "://") {
+		uri, err := url.Parse(subject)
+		if err != nil {
+			return nil, nil, err
+		}
+		template.URIs = []*url.URL{uri}
+	} else {
+		template.EmailAddresses = []string{subject}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, key.Public(), s.caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM, err := cryptoutils.MarshalCertificateToPEM(cert)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, certPEM, nil
+}
+
+// logEntry creates a hashedrekord entry for the signature and signs it with the key of the log
+func (s *Sigstore) logEntry(digest []byte, b64Sig string, certPEM []byte) (*bundle.RekorBundle, error) {
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{
+				"hash": map[string]any{
+					"algorithm": "sha256",
+					"value":     hex.EncodeToString(digest),
+				},
+			},
+			"signature": map[string]any{
+				"content": b64Sig,
+				"publicKey": map[string]any{
+					"content": base64.StdEncoding.EncodeToString(certPEM),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logPub, err := x509.MarshalPKIXPublicKey(s.logKey.Public())
+	if err != nil {
+		return nil, err
+	}
+	logID := sha256.Sum256(logPub)
+
+	s.logIndex++
+	rekorPayload := bundle.RekorPayload{
+		Body:           base64.StdEncoding.EncodeToString(body),
+		IntegratedTime: time.Now().Unix(),
+		LogIndex:       s.logIndex,
+		LogID:          hex.EncodeToString(logID[:]),
+	}
+
+	contents, err := json.Marshal(rekorPayload)
+	if err != nil {
+		return nil, err
+	}
+	canonicalized, err := jsoncanonicalizer.Transform(contents)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(canonicalized)
+	set, err := ecdsa.SignASN1(rand.Reader, s.logKey, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &bundle.RekorBundle{
+		SignedEntryTimestamp: set,
+		Payload:              rekorPayload,
+	}, nil
+}
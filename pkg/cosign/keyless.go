@@ -0,0 +1,140 @@
+package cosign
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"regexp"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/fulcioroots"
+	"github.com/sigstore/sigstore/pkg/tuf"
+)
+
+// ValidateKeylessSigner checks that the identities and roots of the keyless signer can be used for verification
+func ValidateKeylessSigner(signer v1.KeylessSigner) error {
+	if len(signer.Identities) == 0 {
+		return fmt.Errorf("at least one identity must be specified")
+	}
+
+	for i, identity := range signer.Identities {
+		if identity.Issuer == "" && identity.IssuerRegexp == "" {
+			return fmt.Errorf("identities[%d]: one of issuer or issuerRegexp must be specified", i)
+		}
+		if identity.Subject == "" && identity.SubjectRegexp == "" {
+			return fmt.Errorf("identities[%d]: one of subject or subjectRegexp must be specified", i)
+		}
+		for _, expr := range []string{identity.IssuerRegexp, identity.SubjectRegexp} {
+			if _, err := regexp.Compile(expr); err != nil {
+				return fmt.Errorf("identities[%d]: invalid regular expression %s: %w", i, expr, err)
+			}
+		}
+	}
+
+	if signer.CertificateAuthority != "" {
+		if _, _, err := parseCertificateAuthority(signer.CertificateAuthority); err != nil {
+			return err
+		}
+	}
+
+	if signer.TransparencyLogKey != "" {
+		keys := cosign.NewTrustedTransparencyLogPubKeys()
+		if err := keys.AddTransparencyLogPubKey([]byte(signer.TransparencyLogKey), tuf.Active); err != nil {
+			return fmt.Errorf("invalid transparency log key: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// setKeylessCheckOpts configures the cosign options to verify signatures made with short-lived certificates.
+// Transparency log inclusion is verified offline using the bundle that is attached to the signature.
+func setKeylessCheckOpts(ctx context.Context, signer v1.KeylessSigner, co *cosign.CheckOpts) error {
+	for _, identity := range signer.Identities {
+		co.Identities = append(co.Identities, cosign.Identity{
+			Issuer:        identity.Issuer,
+			IssuerRegExp:  identity.IssuerRegexp,
+			Subject:       identity.Subject,
+			SubjectRegExp: identity.SubjectRegexp,
+		})
+	}
+
+	if signer.CertificateAuthority != "" {
+		roots, intermediates, err := parseCertificateAuthority(signer.CertificateAuthority)
+		if err != nil {
+			return err
+		}
+		co.RootCerts = roots
+		co.IntermediateCerts = intermediates
+		// Certificates of a private certificate authority are not logged in the public certificate transparency log
+		co.IgnoreSCT = true
+	} else {
+		roots, err := fulcioroots.Get()
+		if err != nil {
+			return fmt.Errorf("failed to get Fulcio roots: %w", err)
+		}
+		intermediates, err := fulcioroots.GetIntermediates()
+		if err != nil {
+			return fmt.Errorf("failed to get Fulcio intermediates: %w", err)
+		}
+		co.RootCerts = roots
+		co.IntermediateCerts = intermediates
+
+		co.CTLogPubKeys, err = cosign.GetCTLogPubs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get certificate transparency log public keys: %w", err)
+		}
+	}
+
+	if signer.IgnoreTransparencyLog {
+		co.IgnoreTlog = true
+		return nil
+	}
+
+	co.IgnoreTlog = false
+	co.Offline = true
+	if signer.TransparencyLogKey != "" {
+		keys := cosign.NewTrustedTransparencyLogPubKeys()
+		if err := keys.AddTransparencyLogPubKey([]byte(signer.TransparencyLogKey), tuf.Active); err != nil {
+			return fmt.Errorf("invalid transparency log key: %w", err)
+		}
+		co.RekorPubKeys = &keys
+	} else {
+		keys, err := cosign.GetRekorPubs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get transparency log public keys: %w", err)
+		}
+		co.RekorPubKeys = keys
+	}
+
+	return nil
+}
+
+// parseCertificateAuthority splits the PEM encoded certificates into self-signed roots and intermediates
+func parseCertificateAuthority(pem string) (roots *x509.CertPool, intermediates *x509.CertPool, err error) {
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM([]byte(strings.TrimSpace(pem)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid certificate authority: %w", err)
+	}
+	if len(certs) == 0 {
+		return nil, nil, fmt.Errorf("invalid certificate authority: no certificates found")
+	}
+
+	roots = x509.NewCertPool()
+	for _, cert := range certs {
+		if bytes.Equal(cert.RawSubject, cert.RawIssuer) {
+			roots.AddCert(cert)
+			continue
+		}
+		// intermediates should be nil if there are none, so that cosign uses the chain of the signature
+		if intermediates == nil {
+			intermediates = x509.NewCertPool()
+		}
+		intermediates.AddCert(cert)
+	}
+	return roots, intermediates, nil
+}
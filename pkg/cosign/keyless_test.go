@@ -0,0 +1,170 @@
+package cosign
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cosign/cosigntest"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer  = "https://token.actions.githubusercontent.com"
+	testSubject = "https://github.com/acorn-io/runtime/.github/workflows/release.yaml@refs/heads/main"
+)
+
+// pushRandomImage pushes a new image to the registry and returns its digest reference
+func pushRandomImage(t *testing.T, host string) name.Digest {
+	t.Helper()
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	ref, err := name.NewDigest(fmt.Sprintf("%s/library/keyless@%s", host, digest))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	return ref
+}
+
+func TestVerifySignatureKeyless(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	sigstore, err := cosigntest.NewSigstore()
+	require.NoError(t, err)
+	otherSigstore, err := cosigntest.NewSigstore()
+	require.NoError(t, err)
+
+	signer := v1.KeylessSigner{
+		Identities: []v1.KeylessIdentity{
+			{Issuer: "https://accounts.example.com", Subject: "admin@example.com"},
+			{Issuer: testIssuer, SubjectRegexp: `^https://github\.com/acorn-io/.*@refs/heads/main$`},
+		},
+		CertificateAuthority: sigstore.CertificateAuthority(),
+		TransparencyLogKey:   sigstore.TransparencyLogKey(),
+	}
+	require.NoError(t, ValidateKeylessSigner(signer))
+
+	testCases := []struct {
+		description string
+		sigstore    *cosigntest.Sigstore
+		sign        cosigntest.SignOptions
+		signer      func(v1.KeylessSigner) v1.KeylessSigner
+		shouldError bool
+	}{
+		{
+			description: "should pass because the workflow identity matches",
+			sigstore:    sigstore,
+			sign:        cosigntest.SignOptions{Issuer: testIssuer, Subject: testSubject},
+		},
+		{
+			description: "should pass because the email identity matches",
+			sigstore:    sigstore,
+			sign:        cosigntest.SignOptions{Issuer: "https://accounts.example.com", Subject: "admin@example.com"},
+		},
+		{
+			description: "should fail because the subject does not match",
+			sigstore:    sigstore,
+			sign:        cosigntest.SignOptions{Issuer: testIssuer, Subject: "https://github.com/other/repo/.github/workflows/release.yaml@refs/heads/main"},
+			shouldError: true,
+		},
+		{
+			description: "should fail because the issuer does not match",
+			sigstore:    sigstore,
+			sign:        cosigntest.SignOptions{Issuer: "https://accounts.example.com", Subject: testSubject},
+			shouldError: true,
+		},
+		{
+			description: "should fail because the certificate was issued by another certificate authority",
+			sigstore:    otherSigstore,
+			sign:        cosigntest.SignOptions{Issuer: testIssuer, Subject: testSubject},
+			signer: func(signer v1.KeylessSigner) v1.KeylessSigner {
+				signer.IgnoreTransparencyLog = true
+				return signer
+			},
+			shouldError: true,
+		},
+		{
+			description: "should fail because the signature is not in the transparency log",
+			sigstore:    sigstore,
+			sign:        cosigntest.SignOptions{Issuer: testIssuer, Subject: testSubject, NoTransparencyLog: true},
+			shouldError: true,
+		},
+		{
+			description: "should pass because the transparency log is ignored",
+			sigstore:    sigstore,
+			sign:        cosigntest.SignOptions{Issuer: testIssuer, Subject: testSubject, NoTransparencyLog: true},
+			signer: func(signer v1.KeylessSigner) v1.KeylessSigner {
+				signer.IgnoreTransparencyLog = true
+				return signer
+			},
+		},
+		{
+			description: "should fail because the entry was recorded by another transparency log",
+			sigstore:    sigstore,
+			sign:        cosigntest.SignOptions{Issuer: testIssuer, Subject: testSubject},
+			signer: func(signer v1.KeylessSigner) v1.KeylessSigner {
+				signer.TransparencyLogKey = otherSigstore.TransparencyLogKey()
+				return signer
+			},
+			shouldError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ref := pushRandomImage(t, u.Host)
+			require.NoError(t, tc.sigstore.Sign(ref, tc.sign))
+
+			keyless := signer
+			if tc.signer != nil {
+				keyless = tc.signer(signer)
+			}
+
+			opts := VerifyOpts{
+				NoCache: true,
+				Keyless: &keyless,
+			}
+			require.NoError(t, EnsureReferences(context.Background(), nil, ref.Name(), &opts))
+
+			err := VerifySignature(context.Background(), opts)
+			if tc.shouldError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateKeylessSigner(t *testing.T) {
+	assert.Error(t, ValidateKeylessSigner(v1.KeylessSigner{}))
+	assert.Error(t, ValidateKeylessSigner(v1.KeylessSigner{
+		Identities: []v1.KeylessIdentity{{Subject: "admin@example.com"}},
+	}))
+	assert.Error(t, ValidateKeylessSigner(v1.KeylessSigner{
+		Identities: []v1.KeylessIdentity{{Issuer: testIssuer, SubjectRegexp: "("}},
+	}))
+	assert.Error(t, ValidateKeylessSigner(v1.KeylessSigner{
+		Identities:           []v1.KeylessIdentity{{Issuer: testIssuer, Subject: testSubject}},
+		CertificateAuthority: "not a certificate",
+	}))
+	assert.NoError(t, ValidateKeylessSigner(v1.KeylessSigner{
+		Identities: []v1.KeylessIdentity{{IssuerRegexp: ".*", Subject: testSubject}},
+	}))
+}
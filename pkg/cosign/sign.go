@@ -0,0 +1,136 @@
+package cosign
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	cremote "github.com/sigstore/cosign/v2/pkg/cosign/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	cosignature "github.com/sigstore/cosign/v2/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+)
+
+// GeneratePayload returns the payload that is signed for the image. The annotations end up in the optional section of
+// the payload, which is where ImageAllowRules match their annotations against.
+func GeneratePayload(ref name.Digest, annotations map[string]interface{}) ([]byte, error) {
+	return (&payload.Cosign{Image: ref, Annotations: annotations}).MarshalJSON()
+}
+
+// SignPayload signs the payload with the private key at keyRef and returns the base64 encoded signature and the
+// PEM encoded public key of the signer. keyRef supports the same schemes as cosign, like file paths and k8s://.
+func SignPayload(ctx context.Context, pld []byte, keyRef string, pf cosign.PassFunc) (string, string, error) {
+	signer, err := cosignature.SignerVerifierFromKeyRef(ctx, keyRef, pf)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to load private key from %s: %w", keyRef, err)
+	}
+
+	sig, err := signer.SignMessage(bytes.NewReader(pld))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to sign payload: %w", err)
+	}
+
+	pub, err := signer.PublicKey()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get public key: %w", err)
+	}
+
+	pem, err := cryptoutils.MarshalPublicKeyToPEM(pub)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal public key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(sig), string(pem), nil
+}
+
+// AttachSignature verifies that the signature was made for the image with the public key and then stores it next to
+// the image. It returns the digest of the signature artifact.
+func AttachSignature(ctx context.Context, ref name.Digest, pld []byte, signatureB64, publicKey string, opts ...remote.Option) (string, error) {
+	sci := payload.SimpleContainerImage{}
+	if err := json.Unmarshal(pld, &sci); err != nil {
+		return "", fmt.Errorf("failed to decode payload: %w", err)
+	}
+	if sci.Critical.Image.DockerManifestDigest != ref.DigestStr() {
+		return "", fmt.Errorf("payload is for image digest %s, not %s", sci.Critical.Image.DockerManifestDigest, ref.DigestStr())
+	}
+
+	verifier, err := LoadKey(ctx, publicKey, "")
+	if err != nil {
+		return "", err
+	}
+
+	rawSig, err := base64.StdEncoding.DecodeString(signatureB64)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if err := verifier.VerifySignature(bytes.NewReader(rawSig), bytes.NewReader(pld)); err != nil {
+		return "", fmt.Errorf("signature does not match public key: %w", err)
+	}
+
+	sig, err := static.NewSignature(pld, signatureB64)
+	if err != nil {
+		return "", err
+	}
+
+	ociOpts := []ociremote.Option{ociremote.WithRemoteOptions(opts...)}
+	entity, err := ociremote.SignedEntity(ref, ociOpts...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get image %s: %w", ref.Name(), err)
+	}
+
+	// re-signing with the same key replaces the existing signature instead of adding a duplicate
+	entity, err = mutate.AttachSignatureToEntity(entity, sig, mutate.WithDupeDetector(cremote.NewDupeDetector(verifier)))
+	if err != nil {
+		return "", err
+	}
+
+	if err := ociremote.WriteSignatures(ref.Repository, entity, ociOpts...); err != nil {
+		return "", fmt.Errorf("failed to write signature: %w", err)
+	}
+
+	sigTag, err := ociremote.SignatureTag(ref, ociOpts...)
+	if err != nil {
+		return "", err
+	}
+	desc, err := remote.Head(sigTag, opts...)
+	if err != nil {
+		return "", err
+	}
+	return desc.Digest.String(), nil
+}
+
+// CopySignatures copies the signature artifact of the image to another repository, e.g. when the image is pushed.
+// It returns false if the image has no signatures.
+func CopySignatures(src name.Digest, dst name.Repository, opts ...remote.Option) (bool, error) {
+	ociOpts := []ociremote.Option{ociremote.WithRemoteOptions(opts...)}
+
+	srcTag, err := ociremote.SignatureTag(src, ociOpts...)
+	if err != nil {
+		return false, err
+	}
+
+	sigs, err := remote.Image(srcTag, opts...)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get signatures of %s: %w", src.Name(), err)
+	}
+
+	if err := remote.Write(dst.Tag(srcTag.TagStr()), sigs, opts...); err != nil {
+		return false, fmt.Errorf("failed to copy signatures to %s: %w", dst.Name(), err)
+	}
+	return true, nil
+}
@@ -0,0 +1,98 @@
+package cosign
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndAttachSignature(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	passFunc := func(bool) ([]byte, error) { return []byte("secret"), nil }
+	keys, err := cosign.GenerateKeyPair(passFunc)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "cosign.key")
+	require.NoError(t, os.WriteFile(keyFile, keys.PrivateBytes, 0600))
+
+	ref := pushRandomImage(t, u.Host)
+	pld, err := GeneratePayload(ref, map[string]interface{}{"tag": "release"})
+	require.NoError(t, err)
+
+	sig, pub, err := SignPayload(context.Background(), pld, keyFile, passFunc)
+	require.NoError(t, err)
+	assert.Equal(t, string(keys.PublicBytes), pub)
+
+	// Signing twice with the same key must not add a second signature
+	for i := 0; i < 2; i++ {
+		sigDigest, err := AttachSignature(context.Background(), ref, pld, sig, pub)
+		require.NoError(t, err)
+		assert.NotEmpty(t, sigDigest)
+	}
+	sigs, err := ociremote.Signatures(mustSignatureTag(t, ref))
+	require.NoError(t, err)
+	layers, err := sigs.Get()
+	require.NoError(t, err)
+	assert.Len(t, layers, 1)
+
+	verify := func(ref name.Digest, key string, annotations v1.SignatureAnnotations) error {
+		opts := VerifyOpts{
+			NoCache:            true,
+			Key:                key,
+			AnnotationRules:    annotations,
+			SignatureAlgorithm: "sha256",
+		}
+		if err := EnsureReferences(context.Background(), nil, ref.Name(), &opts); err != nil {
+			return err
+		}
+		return VerifySignature(context.Background(), opts)
+	}
+
+	assert.NoError(t, verify(ref, pub, v1.SignatureAnnotations{Match: map[string]string{"tag": "release"}}))
+	assert.Error(t, verify(ref, pub, v1.SignatureAnnotations{Match: map[string]string{"tag": "dev"}}))
+
+	otherKeys, err := cosign.GenerateKeyPair(passFunc)
+	require.NoError(t, err)
+	assert.Error(t, verify(ref, string(otherKeys.PublicBytes), v1.SignatureAnnotations{}))
+
+	// The signature must match the public key and the image
+	_, err = AttachSignature(context.Background(), ref, pld, sig, string(otherKeys.PublicBytes))
+	assert.Error(t, err)
+	_, err = AttachSignature(context.Background(), pushRandomImage(t, u.Host), pld, sig, pub)
+	assert.Error(t, err)
+
+	// Signatures are copied along with the image
+	dst, err := name.NewRepository(u.Host + "/library/pushed")
+	require.NoError(t, err)
+	copied, err := CopySignatures(ref, dst)
+	require.NoError(t, err)
+	assert.True(t, copied)
+	assert.NoError(t, verify(dst.Digest(ref.DigestStr()), pub, v1.SignatureAnnotations{}))
+
+	copied, err = CopySignatures(pushRandomImage(t, u.Host), dst)
+	require.NoError(t, err)
+	assert.False(t, copied)
+}
+
+func mustSignatureTag(t *testing.T, ref name.Digest) name.Tag {
+	t.Helper()
+	tag, err := ociremote.SignatureTag(ref)
+	require.NoError(t, err)
+	return tag
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -37,99 +38,20 @@ type Options struct {
 	BidirectionalSync bool
 }
 
-type watcher struct {
-	c            client.Client
-	imageAndArgs imagesource.ImageSource
-	trigger      chan struct{}
-	watching     []string
-	watchingTS   []time.Time
-	initOnce     sync.Once
-}
-
-func (w *watcher) Trigger() {
-	select {
-	case w.trigger <- struct{}{}:
-	default:
-	}
-}
-
-func (w *watcher) readFiles(ctx context.Context) []string {
-	files, err := w.imageAndArgs.WatchFiles(ctx, w.c)
-	if err != nil {
-		logrus.Errorf("failed to resolve files to watch: %v", err)
-	}
-	return files
-}
-
-func (w *watcher) foundChanges() bool {
-	logrus.Debugf("Checking timestamp of %v", w.watching)
-	for i, f := range w.watching {
-		s, err := os.Stat(f)
-		if err == nil {
-			if w.watchingTS[i] != s.ModTime() {
-				if !w.watchingTS[i].IsZero() {
-					logrus.Infof("%s has changed", f)
-				}
-				return true
-			}
-		} else if !os.IsNotExist(err) {
-			logrus.Errorf("failed to read %s: %v", f, err)
-		}
-	}
-	return false
-}
-
-func timestamps(files []string) []time.Time {
-	result := make([]time.Time, len(files))
-	for i, f := range files {
-		s, err := os.Stat(f)
-		if err == nil {
-			result[i] = s.ModTime()
-		}
-	}
-	return result
-}
-
-func (w *watcher) updateTimestamps(ctx context.Context) {
-	files := w.readFiles(ctx)
-	w.watching = files
-	w.watchingTS = timestamps(files)
-}
-
-func (w *watcher) Wait(ctx context.Context) error {
-	init := false
-	w.initOnce.Do(func() {
-		w.watching = w.readFiles(ctx)
-		init = true
-	})
-
-	for {
-		if !init && !w.foundChanges() {
-			select {
-			case <-w.trigger:
-			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(time.Second):
-				continue
-			}
-		}
-
-		w.updateTimestamps(ctx)
-		return nil
-	}
-}
-
 func buildLoop(ctx context.Context, client client.Client, hash clientHash, opts *Options) error {
 	var (
 		watcher = watcher{
+			c:            client,
 			trigger:      make(chan struct{}, 1),
-			watchingTS:   make([]time.Time, 1),
+			debounce:     defaultDebounce,
 			imageAndArgs: opts.ImageSource,
 		}
 		startLock sync.Mutex
 		started   = false
 		appName   string
 		lockOnce  sync.Once
+		// previous are the images of the last successful build, the next build reuses the ones that didn't change
+		previous *v1.ImagesData
 	)
 
 	defer func() {
@@ -138,6 +60,12 @@ func buildLoop(ctx context.Context, client client.Client, hash clientHash, opts
 		}
 	}()
 
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			logrus.Debugf("Failed to close file watcher: %v", err)
+		}
+	}()
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -157,11 +85,22 @@ func buildLoop(ctx context.Context, client client.Client, hash clientHash, opts
 
 outer:
 	for {
-		if err := watcher.Wait(ctx); err != nil {
+		changed, err := watcher.Wait(ctx)
+		if err != nil {
 			return err
 		}
+		reuse, rebuilt := reusableImages(watcher.set, previous, changed)
+		if len(rebuilt) > 0 {
+			logrus.Infof("Rebuilding %s (%s)", strings.Join(rebuilt, ", "), changeSummary(watcher.set.cwd, changed))
+		} else if len(changed) > 0 {
+			logrus.Infof("Rebuilding, %s", changeSummary(watcher.set.cwd, changed))
+		}
 
-		image, deployArgs, err := opts.ImageSource.GetImageAndDeployArgs(ctx, client)
+		image, appImage, deployArgs, err := opts.ImageSource.GetAppImageAndDeployArgs(ctx, client, reuse)
+		if err != nil {
+			// The next build doesn't reuse images, in case the reused images made this build fail
+			previous = nil
+		}
 		if err == pflag.ErrHelp {
 			continue
 		} else if err != nil {
@@ -176,6 +115,9 @@ outer:
 		}
 
 		failed.Store(false)
+		if appImage != nil {
+			previous = &appImage.ImageData
+		}
 
 		for {
 			appName, err = runOrUpdate(ctx, client, hash, image, deployArgs, opts)
@@ -0,0 +1,87 @@
+package dev
+
+import (
+	"path/filepath"
+	"sort"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/docker/docker/pkg/fileutils"
+)
+
+// reusableImages returns the images of the previous build that the next build can use again, the ones whose build
+// doesn't include a changed file, and the sorted keys of the images that are rebuilt because of the changed files.
+// The build compares the builds of the Acornfile to the previous ones, so images whose build changed in the Acornfile
+// are rebuilt as well.
+func reusableImages(set watchSet, previous *v1.ImagesData, changed []string) (*v1.ImagesData, []string) {
+	if previous == nil {
+		return nil, nil
+	}
+
+	var (
+		reuse   = previous.DeepCopy()
+		rebuilt = map[string]bool{}
+	)
+	reuse.Builds = nil
+	for _, record := range previous.Builds {
+		build := recordBuild(record)
+		if build == nil {
+			// Acorns and pulled images are always resolved again
+			continue
+		}
+		if set.buildChanged(*build, changed) {
+			rebuilt[record.ImageKey] = true
+			continue
+		}
+		reuse.Builds = append(reuse.Builds, *record.DeepCopy())
+	}
+
+	var keys []string
+	for key := range rebuilt {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return reuse, keys
+}
+
+// recordBuild returns the build of the image from a Dockerfile, nil if the image isn't built from a Dockerfile
+func recordBuild(record v1.BuildRecord) *v1.Build {
+	if record.ContainerBuild != nil {
+		return record.ContainerBuild.Build
+	}
+	if record.ImageBuild != nil {
+		return record.ImageBuild.ContainerBuild
+	}
+	return nil
+}
+
+// buildChanged returns true if one of the changed files is the Dockerfile or is in the build context or the context
+// dirs of the build
+func (s *watchSet) buildChanged(build v1.Build, changed []string) bool {
+	var (
+		contextDir = filepath.Join(s.cwd, build.Context)
+		dockerfile = filepath.Join(s.cwd, build.Dockerfile)
+	)
+	for _, path := range changed {
+		if path == dockerfile {
+			return true
+		}
+		if rel, ok := within(contextDir, path); ok && !matches(s.contextIgnore(contextDir), rel) {
+			return true
+		}
+		for _, dir := range build.ContextDirs {
+			if _, ok := within(filepath.Join(s.cwd, dir), path); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *watchSet) contextIgnore(dir string) *fileutils.PatternMatcher {
+	for _, buildContext := range s.contexts {
+		if buildContext.dir == dir {
+			return buildContext.ignore
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package dev
+
+import (
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/docker/docker/pkg/fileutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReusableImages(t *testing.T) {
+	cwd := filepath.Join("/", "src", "app")
+	ignore, err := fileutils.NewPatternMatcher([]string{"*.md"})
+	require.NoError(t, err)
+
+	set := watchSet{
+		cwd: cwd,
+		contexts: []buildContext{
+			{dir: filepath.Join(cwd, "web"), ignore: ignore},
+			{dir: filepath.Join(cwd, "api")},
+			{dir: filepath.Join(cwd, "tools")},
+		},
+	}
+
+	previous := &v1.ImagesData{
+		Containers: map[string]v1.ContainerData{
+			"web": {Image: "registry/app@sha256:web", Sidecars: map[string]v1.ImageData{
+				"proxy": {Image: "registry/app@sha256:proxy"},
+			}},
+			"api": {Image: "registry/app@sha256:api"},
+			"db":  {Image: "registry/app@sha256:db"},
+		},
+		Images: map[string]v1.ImageData{
+			"tools": {Image: "registry/app@sha256:tools"},
+		},
+		Builds: []v1.BuildRecord{
+			containerRecord("web", &v1.Build{Context: "web", Dockerfile: "web/Dockerfile"}),
+			containerRecord("web.proxy", &v1.Build{Context: "web", Dockerfile: "proxy.Dockerfile"}),
+			containerRecord("api", &v1.Build{Context: "api", Dockerfile: "api/Dockerfile", ContextDirs: map[string]string{"/config": "./config"}}),
+			{ContainerBuild: &v1.ContainerImageBuilderSpec{Image: "postgres"}, ImageKey: "db"},
+			{ImageBuild: &v1.ImageBuilderSpec{ContainerBuild: &v1.Build{Context: "tools", Dockerfile: "tools/Dockerfile"}}, ImageKey: "tools"},
+			{AcornBuild: &v1.AcornBuilderSpec{Image: "ghcr.io/acorn-io/redis"}, ImageKey: "redis"},
+		},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		changed []string
+		reused  []string
+		rebuilt []string
+	}{
+		{
+			name:   "nothing changed",
+			reused: []string{"web", "web.proxy", "api", "tools"},
+		},
+		{
+			name:    "build context",
+			changed: []string{filepath.Join(cwd, "web", "main.go")},
+			reused:  []string{"api", "tools"},
+			rebuilt: []string{"web", "web.proxy"},
+		},
+		{
+			name:    "ignored file",
+			changed: []string{filepath.Join(cwd, "web", "README.md")},
+			reused:  []string{"web", "web.proxy", "api", "tools"},
+		},
+		{
+			name:    "dockerfile outside of the build context",
+			changed: []string{filepath.Join(cwd, "proxy.Dockerfile")},
+			reused:  []string{"web", "api", "tools"},
+			rebuilt: []string{"web.proxy"},
+		},
+		{
+			name:    "context dir",
+			changed: []string{filepath.Join(cwd, "config", "api.yaml"), filepath.Join(cwd, "tools", "lint.sh")},
+			reused:  []string{"web", "web.proxy"},
+			rebuilt: []string{"api", "tools"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			reuse, rebuilt := reusableImages(set, previous, tt.changed)
+
+			var reused []string
+			for _, record := range reuse.Builds {
+				reused = append(reused, record.ImageKey)
+			}
+			assert.Equal(t, tt.reused, reused)
+			assert.Equal(t, tt.rebuilt, rebuilt)
+			assert.Equal(t, previous.Containers, reuse.Containers)
+			assert.Equal(t, previous.Images, reuse.Images)
+		})
+	}
+
+	reuse, rebuilt := reusableImages(set, nil, []string{filepath.Join(cwd, "web", "main.go")})
+	assert.Nil(t, reuse)
+	assert.Nil(t, rebuilt)
+}
+
+func containerRecord(key string, build *v1.Build) v1.BuildRecord {
+	return v1.BuildRecord{
+		ContainerBuild: &v1.ContainerImageBuilderSpec{Build: build},
+		ImageKey:       key,
+	}
+}
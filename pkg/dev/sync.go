@@ -62,21 +62,29 @@ func containerSync(ctx context.Context, client client.Client, appName string, op
 			if con.Spec.Init {
 				return false, nil
 			}
-			for remoteDir, mount := range con.Spec.Dirs {
-				if mount.ContextDir == "" {
-					continue
-				}
-				var (
-					remoteDir = remoteDir
-					mount     = mount
-				)
+			startSync := func(localDir, remoteDir, run string) {
 				go func() {
-					startSyncForPath(ctx, client, con, cwd, mount.ContextDir, remoteDir, opts.BidirectionalSync)
+					startSyncForPath(ctx, client, con, cwd, localDir, remoteDir, run, opts.BidirectionalSync)
 					syncLock.Lock()
 					delete(syncing, con.Name)
 					syncLock.Unlock()
 				}()
 			}
+			for remoteDir, mount := range con.Spec.Dirs {
+				if mount.ContextDir == "" {
+					continue
+				}
+				startSync(mount.ContextDir, remoteDir, "")
+			}
+			if onChange := con.Spec.Dev.GetOnChange(); onChange != nil {
+				dirs, err := onChange.SyncDirs()
+				if err != nil {
+					logrus.Errorf("failed to sync changes into container %s: %v", con.Name, err)
+				}
+				for remoteDir, localDir := range dirs {
+					startSync(localDir, remoteDir, onChange.Run)
+				}
+			}
 			syncLock.Lock()
 			syncing[con.Name] = true
 			syncLock.Unlock()
@@ -86,7 +94,7 @@ func containerSync(ctx context.Context, client client.Client, appName string, op
 	return err
 }
 
-func invokeStartSyncForPath(ctx context.Context, client client.Client, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, bidirectional bool) (chan struct{}, chan error, error) {
+func invokeStartSyncForPath(ctx context.Context, client client.Client, con *apiv1.ContainerReplica, cwd, localDir, remoteDir, run string, bidirectional bool) (chan struct{}, chan error, error) {
 	source := filepath.Join(cwd, localDir)
 	if s, err := os.Stat(source); err == nil && !s.IsDir() {
 		return nil, nil, nil
@@ -110,7 +118,7 @@ func invokeStartSyncForPath(ctx context.Context, client client.Client, con *apiv
 		logrus.Warnf("failed to open %s for syncing: %v", filepath.Join(cwd, ".dockerignore"), err)
 		exclude = nil
 	}
-	s, err := sync.NewSync(ctx, source, sync.Options{
+	syncOpts := sync.Options{
 		DownstreamDisabled: !bidirectional,
 		Polling:            true,
 		Verbose:            true,
@@ -118,7 +126,13 @@ func invokeStartSyncForPath(ctx context.Context, client client.Client, con *apiv
 		InitialSync:        latest.InitialSyncStrategyPreferLocal,
 		Log: newLogger().
 			WithPrefix(strings.TrimPrefix(con.Name, con.Spec.AppName+".") + ": (sync): "),
-	})
+	}
+	if run != "" {
+		// The command runs in the container after every batch of changes that is synced into it
+		syncOpts.UploadBatchCmd = "sh"
+		syncOpts.UploadBatchArgs = []string{"-c", run}
+	}
+	s, err := sync.NewSync(ctx, source, syncOpts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -172,7 +186,7 @@ func (i *ignore) Write(p []byte) (n int, err error) {
 	return i.Out.Write(p)
 }
 
-func startSyncForPath(ctx context.Context, client client.Client, con *apiv1.ContainerReplica, cwd, localDir, remoteDir string, bidirectional bool) {
+func startSyncForPath(ctx context.Context, client client.Client, con *apiv1.ContainerReplica, cwd, localDir, remoteDir, run string, bidirectional bool) {
 	for {
 		var (
 			wait    <-chan struct{}
@@ -184,7 +198,7 @@ func startSyncForPath(ctx context.Context, client client.Client, con *apiv1.Cont
 			return
 		}
 		if err == nil {
-			wait, waiterr, err = invokeStartSyncForPath(ctx, client, con, cwd, localDir, remoteDir, bidirectional)
+			wait, waiterr, err = invokeStartSyncForPath(ctx, client, con, cwd, localDir, remoteDir, run, bidirectional)
 		}
 
 		if err == nil {
@@ -0,0 +1,396 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/imagesource"
+	"github.com/docker/docker/pkg/fileutils"
+	"github.com/fsnotify/fsnotify"
+	"github.com/moby/buildkit/frontend/dockerfile/dockerignore"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// acornIgnoreFile lists the files of the build directory, in the format of a .dockerignore file, whose changes
+	// don't trigger a rebuild in dev mode
+	acornIgnoreFile = ".acornignore"
+
+	// defaultDebounce is how long the watcher collects changes after the first change, so that saving many files
+	// at once triggers a single rebuild
+	defaultDebounce = 250 * time.Millisecond
+)
+
+type watcher struct {
+	c            client.Client
+	imageAndArgs imagesource.ImageSource
+	trigger      chan struct{}
+	debounce     time.Duration
+	fs           *fsnotify.Watcher
+	set          watchSet
+}
+
+// watchSet is the set of files whose changes trigger a rebuild
+type watchSet struct {
+	// cwd is the build directory
+	cwd string
+	// files always trigger a rebuild, like the Acornfile and the Dockerfiles
+	files map[string]bool
+	// contexts are the build contexts, the files in them trigger a rebuild unless they are ignored
+	contexts []buildContext
+	// ignore are the patterns of the .acornignore file of the build directory
+	ignore *fileutils.PatternMatcher
+	// synced are the directories that are synced into the containers, their files don't trigger a rebuild
+	synced []string
+}
+
+type buildContext struct {
+	dir string
+	// ignore are the patterns of the .dockerignore file of the build context
+	ignore *fileutils.PatternMatcher
+}
+
+func (w *watcher) Trigger() {
+	select {
+	case w.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Wait returns after the first call when files changed or a rebuild was triggered. It returns the changed files, in
+// the order they changed, or nothing if the rebuild was triggered.
+func (w *watcher) Wait(ctx context.Context) ([]string, error) {
+	if w.fs == nil {
+		// The first build starts right away
+		return nil, w.watch(ctx)
+	}
+
+	var (
+		changed  []string
+		seen     = map[string]bool{}
+		debounce <-chan time.Time
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-w.trigger:
+			return nil, w.watch(ctx)
+		case <-debounce:
+			return changed, w.watch(ctx)
+		case err := <-w.fs.Errors:
+			logrus.Errorf("failed to watch files: %v", err)
+		case event := <-w.fs.Events:
+			path, ok, err := w.handle(event)
+			if err != nil {
+				return nil, err
+			}
+			if ok && !seen[path] {
+				seen[path] = true
+				changed = append(changed, path)
+				if debounce == nil {
+					debounce = time.After(w.debounce)
+				}
+			}
+		}
+	}
+}
+
+func (w *watcher) Close() error {
+	if w.fs == nil {
+		return nil
+	}
+	return w.fs.Close()
+}
+
+// handle returns the changed file and if the change triggers a rebuild
+func (w *watcher) handle(event fsnotify.Event) (string, bool, error) {
+	if event.Op == fsnotify.Chmod {
+		return "", false, nil
+	}
+
+	path := filepath.Clean(event.Name)
+	if event.Has(fsnotify.Create) {
+		if s, err := os.Stat(path); err == nil && s.IsDir() && w.set.inContext(path) {
+			// Files created in the new directory before it is watched are missed, the new directory triggers the
+			// rebuild then
+			if err := w.addDir(path); err != nil {
+				return "", false, err
+			}
+		}
+	}
+
+	return path, w.set.triggers(path), nil
+}
+
+// watch watches the files of the current app definition, the Acornfile may have changed them since the last build
+func (w *watcher) watch(ctx context.Context) error {
+	if err := w.Close(); err != nil {
+		logrus.Debugf("failed to close file watcher: %v", err)
+	}
+
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch files: %w", err)
+	}
+	w.fs = fsWatcher
+	w.set = w.readWatchSet(ctx)
+
+	// The directories of the files are watched because editors often replace files instead of writing them
+	dirs := map[string]bool{}
+	for file := range w.set.files {
+		dirs[filepath.Dir(file)] = true
+	}
+	for dir := range dirs {
+		if err := w.add(dir); err != nil {
+			return err
+		}
+	}
+	for _, buildContext := range w.set.contexts {
+		if err := w.addDir(buildContext.dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *watcher) readWatchSet(ctx context.Context) watchSet {
+	set := watchSet{
+		files: map[string]bool{},
+	}
+
+	cwd, file, err := w.imageAndArgs.ResolveImageAndFile()
+	if err != nil || file == "" {
+		// This is a reference to an image, not a build
+		return set
+	}
+	set.cwd, _ = filepath.Abs(cwd)
+
+	// The Acornfile is watched even if it is invalid, so that fixing it triggers a rebuild
+	if file, err := filepath.Abs(file); err == nil {
+		set.files[file] = true
+	}
+
+	appDef, _, err := w.imageAndArgs.GetAppDefinition(ctx, w.c)
+	if err != nil {
+		logrus.Errorf("failed to resolve files to watch: %v", err)
+		return set
+	}
+
+	files, err := appDef.WatchFiles(set.cwd)
+	if err != nil {
+		logrus.Errorf("failed to resolve files to watch: %v", err)
+		return set
+	}
+	for _, file := range files {
+		set.files[file] = true
+	}
+
+	contexts, err := appDef.BuildContexts(set.cwd)
+	if err != nil {
+		logrus.Errorf("failed to resolve build contexts to watch: %v", err)
+		return set
+	}
+
+	set.synced, err = appDef.SyncedDirs(set.cwd)
+	if err != nil {
+		logrus.Errorf("failed to resolve synced directories: %v", err)
+		return set
+	}
+
+	for _, dir := range contexts {
+		set.contexts = append(set.contexts, buildContext{
+			dir:    dir,
+			ignore: readIgnoreFile(filepath.Join(dir, ".dockerignore")),
+		})
+	}
+
+	set.files[filepath.Join(set.cwd, acornIgnoreFile)] = true
+	set.ignore = readIgnoreFile(filepath.Join(set.cwd, acornIgnoreFile))
+	return set
+}
+
+func readIgnoreFile(file string) *fileutils.PatternMatcher {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		logrus.Warnf("failed to open %s for watching: %v", file, err)
+		return nil
+	}
+	defer f.Close()
+
+	patterns, err := dockerignore.ReadAll(f)
+	if err != nil {
+		logrus.Warnf("failed to read %s for watching: %v", file, err)
+		return nil
+	}
+
+	pm, err := fileutils.NewPatternMatcher(patterns)
+	if err != nil {
+		logrus.Warnf("invalid patterns in %s: %v", file, err)
+		return nil
+	}
+	return pm
+}
+
+// add watches a directory, directories that don't exist yet are skipped. Running out of watches is an error, changes
+// in the directories that are not watched would be missed.
+func (w *watcher) add(dir string) error {
+	err := w.fs.Add(dir)
+	if errors.Is(err, syscall.ENOSPC) {
+		return fmt.Errorf("failed to watch %s, the limit of file watches is reached: raise fs.inotify.max_user_watches "+
+			"or ignore directories that don't need to be watched, like dependencies, in %s or .dockerignore", dir, acornIgnoreFile)
+	} else if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		logrus.Warnf("failed to watch %s: %v", dir, err)
+	}
+	return nil
+}
+
+// addDir watches a directory of a build context and its subdirectories, except the ignored and the synced ones
+func (w *watcher) addDir(root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+		if w.set.skipDir(path) {
+			return filepath.SkipDir
+		}
+		return w.add(path)
+	})
+}
+
+// triggers returns true if a change of the file triggers a rebuild
+func (s *watchSet) triggers(path string) bool {
+	if s.files[path] {
+		return true
+	}
+	if s.ignored(path) {
+		return false
+	}
+	for _, buildContext := range s.contexts {
+		if rel, ok := within(buildContext.dir, path); ok && !matches(buildContext.ignore, rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// inContext returns true if the path is in a build context and is not ignored
+func (s *watchSet) inContext(path string) bool {
+	return !s.skipDir(path) && s.triggers(path)
+}
+
+// skipDir returns true if none of the files in the directory trigger a rebuild
+func (s *watchSet) skipDir(dir string) bool {
+	if filepath.Base(dir) == ".git" {
+		return true
+	}
+	for _, synced := range s.synced {
+		if _, ok := within(synced, dir); ok {
+			return true
+		}
+	}
+	if rel, ok := within(s.cwd, dir); ok && ignoresDir(s.ignore, rel) {
+		return true
+	}
+
+	// The directory is skipped if every build context it is in ignores it, like node_modules in a .dockerignore
+	inContext := false
+	for _, buildContext := range s.contexts {
+		rel, ok := within(buildContext.dir, dir)
+		if !ok {
+			continue
+		}
+		if !ignoresDir(buildContext.ignore, rel) {
+			return false
+		}
+		inContext = true
+	}
+	return inContext
+}
+
+// ignored returns true if the file is ignored by the .acornignore file or synced into a container
+func (s *watchSet) ignored(path string) bool {
+	for _, synced := range s.synced {
+		if _, ok := within(synced, path); ok {
+			return true
+		}
+	}
+	rel, ok := within(s.cwd, path)
+	return ok && matches(s.ignore, rel)
+}
+
+// within returns the path relative to the directory, if the path is in the directory
+func within(dir, path string) (string, bool) {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
+
+// ignoresDir returns true if the directory and all the files in it are ignored. An exclusion, like !logs/keep.txt, that
+// may match a file in the directory includes it again, so the directory is not ignored then.
+func ignoresDir(pm *fileutils.PatternMatcher, rel string) bool {
+	if !matches(pm, rel) {
+		return false
+	}
+	for _, pattern := range pm.Patterns() {
+		if pattern.Exclusion() && mayMatchWithin(pattern.String(), rel) {
+			return false
+		}
+	}
+	return true
+}
+
+// mayMatchWithin returns true if the pattern may match the directory, a parent of it or a file in it
+func mayMatchWithin(pattern, dir string) bool {
+	patternParts := strings.Split(pattern, string(filepath.Separator))
+	for i, dirPart := range strings.Split(dir, string(filepath.Separator)) {
+		if i >= len(patternParts) {
+			// The pattern matches a parent of the directory
+			return true
+		}
+		if strings.Contains(patternParts[i], "**") {
+			return true
+		}
+		if ok, err := filepath.Match(patternParts[i], dirPart); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func matches(pm *fileutils.PatternMatcher, rel string) bool {
+	if pm == nil || rel == "." {
+		return false
+	}
+	ok, err := pm.MatchesOrParentMatches(rel)
+	return err == nil && ok
+}
+
+// changeSummary describes the files that triggered a rebuild, like "web/main.go and 2 other files changed"
+func changeSummary(cwd string, changed []string) string {
+	first := changed[0]
+	if rel, ok := within(cwd, first); ok {
+		first = rel
+	}
+	switch len(changed) {
+	case 1:
+		return first + " changed"
+	case 2:
+		return first + " and 1 other file changed"
+	default:
+		return fmt.Sprintf("%s and %d other files changed", first, len(changed)-1)
+	}
+}
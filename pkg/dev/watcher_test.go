@@ -0,0 +1,168 @@
+package dev
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/runtime/pkg/imagesource"
+	"github.com/docker/docker/pkg/fileutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const watcherAcornfile = `
+containers: {
+	web: {
+		build: "web"
+		dirs: "/app/static": "./web/static"
+	}
+}
+`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func newTestWatcher(t *testing.T) (*watcher, string) {
+	t.Helper()
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+
+	writeFile(t, filepath.Join(dir, "Acornfile"), watcherAcornfile)
+	writeFile(t, filepath.Join(dir, acornIgnoreFile), "web/docs\n")
+	writeFile(t, filepath.Join(dir, "web", "Dockerfile"), "FROM nginx\n")
+	writeFile(t, filepath.Join(dir, "web", ".dockerignore"), "*.log\nnode_modules\n")
+	writeFile(t, filepath.Join(dir, "web", "main.go"), "package main\n")
+	writeFile(t, filepath.Join(dir, "web", "static", "index.html"), "<html></html>\n")
+	writeFile(t, filepath.Join(dir, "web", "docs", "README.md"), "# web\n")
+	writeFile(t, filepath.Join(dir, "web", "node_modules", "lib", "index.js"), "module.exports = {}\n")
+	writeFile(t, filepath.Join(dir, "other", "notes.txt"), "notes\n")
+
+	w := &watcher{
+		imageAndArgs: imagesource.NewImageSource("", []string{dir}, nil, nil),
+		trigger:      make(chan struct{}, 1),
+		debounce:     100 * time.Millisecond,
+	}
+	t.Cleanup(func() {
+		_ = w.Close()
+	})
+	return w, dir
+}
+
+func waitForChanges(t *testing.T, w *watcher) []string {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	changed, err := w.Wait(ctx)
+	require.NoError(t, err)
+	return changed
+}
+
+func TestWatchSetTriggers(t *testing.T) {
+	w, dir := newTestWatcher(t)
+
+	// The first call sets up the watches and returns right away
+	assert.Empty(t, waitForChanges(t, w))
+
+	for _, tt := range []struct {
+		path     string
+		triggers bool
+	}{
+		{path: "Acornfile", triggers: true},
+		{path: acornIgnoreFile, triggers: true},
+		{path: "web/Dockerfile", triggers: true},
+		{path: "web/.dockerignore", triggers: true},
+		{path: "web/main.go", triggers: true},
+		{path: "web/pkg/handler.go", triggers: true},
+		{path: "web/server.log", triggers: false},
+		{path: "web/docs/README.md", triggers: false},
+		{path: "web/static/index.html", triggers: false},
+		{path: "web/node_modules/lib/index.js", triggers: false},
+		{path: "other/notes.txt", triggers: false},
+	} {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.triggers, w.set.triggers(filepath.Join(dir, filepath.FromSlash(tt.path))))
+		})
+	}
+}
+
+func TestWatchSetSkipDir(t *testing.T) {
+	w, dir := newTestWatcher(t)
+	assert.Empty(t, waitForChanges(t, w))
+
+	for _, tt := range []struct {
+		path string
+		skip bool
+	}{
+		{path: "web", skip: false},
+		{path: "web/pkg", skip: false},
+		{path: "web/.git", skip: true},
+		{path: "web/docs", skip: true},
+		{path: "web/static", skip: true},
+		{path: "web/node_modules", skip: true},
+		{path: "web/node_modules/lib", skip: true},
+	} {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.skip, w.set.skipDir(filepath.Join(dir, filepath.FromSlash(tt.path))))
+		})
+	}
+}
+
+func TestIgnoresDir(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		patterns []string
+		dir      string
+		ignored  bool
+	}{
+		{name: "not matched", patterns: []string{"logs"}, dir: "src", ignored: false},
+		{name: "matched", patterns: []string{"logs"}, dir: "logs", ignored: true},
+		{name: "parent matched", patterns: []string{"logs"}, dir: "logs/2023", ignored: true},
+		{name: "exclusion of another directory", patterns: []string{"logs", "tmp", "!tmp/keep.txt"}, dir: "logs", ignored: true},
+		{name: "exclusion in the directory", patterns: []string{"logs", "!logs/keep.txt"}, dir: "logs", ignored: false},
+		{name: "exclusion with wildcard", patterns: []string{"logs", "!*/keep.txt"}, dir: "logs", ignored: false},
+		{name: "exclusion in any directory", patterns: []string{"logs", "!**/keep.txt"}, dir: "logs", ignored: false},
+		{name: "exclusion of the parent", patterns: []string{"logs/*", "!logs"}, dir: "logs/2023", ignored: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pm, err := fileutils.NewPatternMatcher(tt.patterns)
+			require.NoError(t, err)
+			assert.Equal(t, tt.ignored, ignoresDir(pm, filepath.FromSlash(tt.dir)))
+		})
+	}
+}
+
+func TestWatcherWait(t *testing.T) {
+	w, dir := newTestWatcher(t)
+	assert.Empty(t, waitForChanges(t, w))
+
+	// Ignored and synced files don't trigger a rebuild, the first file that does is reported first
+	writeFile(t, filepath.Join(dir, "web", "server.log"), "started\n")
+	writeFile(t, filepath.Join(dir, "web", "docs", "README.md"), "# web app\n")
+	writeFile(t, filepath.Join(dir, "web", "static", "index.html"), "<html>hi</html>\n")
+	writeFile(t, filepath.Join(dir, "web", "main.go"), "package main\n\nfunc main() {}\n")
+	writeFile(t, filepath.Join(dir, "Acornfile"), watcherAcornfile+"\n")
+
+	changed := waitForChanges(t, w)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "web", "main.go"),
+		filepath.Join(dir, "Acornfile"),
+	}, changed)
+	assert.Equal(t, "web/main.go and 1 other file changed", changeSummary(dir, changed))
+
+	// New directories in a build context are watched
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "web", "pkg"), 0755))
+	assert.Equal(t, []string{filepath.Join(dir, "web", "pkg")}, waitForChanges(t, w))
+
+	writeFile(t, filepath.Join(dir, "web", "pkg", "handler.go"), "package pkg\n")
+	assert.Equal(t, []string{filepath.Join(dir, "web", "pkg", "handler.go")}, waitForChanges(t, w))
+
+	// A trigger rebuilds without changes
+	w.Trigger()
+	assert.Empty(t, waitForChanges(t, w))
+}
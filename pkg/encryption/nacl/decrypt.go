@@ -38,20 +38,39 @@ func DecryptNamespacedDataMap(ctx context.Context, c kclient.Reader, data map[st
 	return to, nil
 }
 
+// DecryptNamespacedData decrypts the data with the keys of the namespace. The data can be encrypted for many
+// recipients, every key of the namespace that is one of the recipients is tried.
 func DecryptNamespacedData(ctx context.Context, c kclient.Reader, data []byte, namespace string) ([]byte, error) {
 	keys, err := GetAllNaclKeys(ctx, c, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	var errs []error
+	recipients, err := Unwrap(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		errs  []error
+		tried = map[string]bool{}
+	)
 	for _, key := range keys {
-		data, err := key.Decrypt(data)
+		pubKey := KeyBytesToB64String(key.PublicKey)
+		if _, ok := recipients[pubKey]; !ok || tried[pubKey] {
+			continue
+		}
+		tried[pubKey] = true
+
+		data, err := key.decrypt(recipients[pubKey])
 		if err == nil {
 			return data, nil
-		} else {
-			errs = append(errs, fmt.Errorf("pubkey %s: %w", KeyBytesToB64String(key.PublicKey), err))
 		}
+		errs = append(errs, fmt.Errorf("pubkey %s: %w", pubKey, err))
+	}
+
+	if len(tried) == 0 {
+		return nil, &ErrDecryptionKeyNotAvailable{}
 	}
 
 	return nil, &ErrUnableToDecrypt{
@@ -61,7 +80,7 @@ func DecryptNamespacedData(ctx context.Context, c kclient.Reader, data []byte, n
 
 func (k *NaclKey) Decrypt(encData []byte) ([]byte, error) {
 	pubKeyString := KeyBytesToB64String(k.PublicKey)
-	preppedData, err := unwrapForDecryption(encData)
+	preppedData, err := Unwrap(encData)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +90,10 @@ func (k *NaclKey) Decrypt(encData []byte) ([]byte, error) {
 		return nil, &ErrDecryptionKeyNotAvailable{}
 	}
 
+	return k.decrypt(encryptedData)
+}
+
+func (k *NaclKey) decrypt(encryptedData []byte) ([]byte, error) {
 	decryptedBytes, ok := box.OpenAnonymous(nil, encryptedData, k.PublicKey, k.privateKey)
 	if !ok {
 		return nil, &ErrUnableToDecrypt{}
@@ -79,7 +102,8 @@ func (k *NaclKey) Decrypt(encData []byte) ([]byte, error) {
 	return decryptedBytes, nil
 }
 
-func unwrapForDecryption(data []byte) (map[string][]byte, error) {
+// Unwrap returns the encrypted content of the data for every recipient
+func Unwrap(data []byte) (map[string][]byte, error) {
 	trimmedData := strings.TrimPrefix(string(data), EncPrefix)
 	trimmedData = strings.TrimSuffix(trimmedData, EncSuffix)
 
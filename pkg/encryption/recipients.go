@@ -0,0 +1,226 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+)
+
+const (
+	// AgeRecipientPrefix is the prefix of age X25519 recipients, like age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p
+	AgeRecipientPrefix = "age1"
+	// PGPRecipientPrefix is the prefix of PGP recipients in encrypted data, followed by the fingerprint of the key
+	PGPRecipientPrefix = "pgp:"
+)
+
+// Recipients are the keys that data is encrypted for. Every recipient can decrypt the data on its own.
+type Recipients struct {
+	// PublicKeys are the public keys of projects and age recipients
+	PublicKeys []string
+	PGPKeys    openpgp.EntityList
+}
+
+// Identities are private keys that decrypt data outside a cluster
+type Identities struct {
+	Age []age.Identity
+	PGP openpgp.EntityList
+}
+
+// PGPRecipient returns the ID of the PGP key in encrypted data
+func PGPRecipient(entity *openpgp.Entity) string {
+	return PGPRecipientPrefix + strings.ToUpper(fmt.Sprintf("%x", entity.PrimaryKey.Fingerprint))
+}
+
+// Encrypt encrypts the message for all recipients and returns it in the format that is accepted for secret data
+func Encrypt(msg string, recipients Recipients) (string, error) {
+	data := nacl.MultiEncryptedData{}
+	for _, key := range recipients.PublicKeys {
+		if !strings.HasPrefix(key, AgeRecipientPrefix) {
+			encData, err := nacl.Encrypt(msg, key)
+			if err != nil {
+				return "", fmt.Errorf("encrypting for %s: %w", key, err)
+			}
+			data[key] = encData.EncryptedContent
+			continue
+		}
+
+		recipient, err := age.ParseX25519Recipient(key)
+		if err != nil {
+			return "", err
+		}
+		buf := &bytes.Buffer{}
+		w, err := age.Encrypt(buf, recipient)
+		if err != nil {
+			return "", fmt.Errorf("encrypting for %s: %w", key, err)
+		}
+		if _, err := io.WriteString(w, msg); err != nil {
+			return "", err
+		}
+		if err := w.Close(); err != nil {
+			return "", err
+		}
+		data[key] = base64.RawURLEncoding.EncodeToString(buf.Bytes())
+	}
+
+	for _, entity := range recipients.PGPKeys {
+		buf := &bytes.Buffer{}
+		w, err := openpgp.Encrypt(buf, []*openpgp.Entity{entity}, nil, nil, nil)
+		if err != nil {
+			return "", fmt.Errorf("encrypting for %s: %w", PGPRecipient(entity), err)
+		}
+		if _, err := io.WriteString(w, msg); err != nil {
+			return "", err
+		}
+		if err := w.Close(); err != nil {
+			return "", err
+		}
+		data[PGPRecipient(entity)] = base64.RawURLEncoding.EncodeToString(buf.Bytes())
+	}
+
+	return data.Marshal()
+}
+
+// ReadPGPKeys reads armored or binary PGP keys
+func ReadPGPKeys(data []byte) (openpgp.EntityList, error) {
+	if bytes.Contains(data, []byte("-----BEGIN PGP")) {
+		return openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+	}
+	return openpgp.ReadKeyRing(bytes.NewReader(data))
+}
+
+// ParseIdentities reads age identities or PGP private keys. Passphrase is called to decrypt PGP private keys that are
+// protected by a passphrase.
+func ParseIdentities(data []byte, passphrase func() ([]byte, error)) (*Identities, error) {
+	if bytes.Contains(data, []byte("AGE-SECRET-KEY-")) {
+		ids, err := age.ParseIdentities(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return &Identities{Age: ids}, nil
+	}
+
+	entities, err := ReadPGPKeys(data)
+	if err != nil {
+		return nil, fmt.Errorf("key is neither an age identity nor a PGP private key: %w", err)
+	}
+
+	var pass []byte
+	for _, entity := range entities {
+		if entity.PrivateKey == nil {
+			return nil, fmt.Errorf("PGP key %s is not a private key", PGPRecipient(entity))
+		}
+		if entity.PrivateKey.Encrypted && pass == nil {
+			if pass, err = passphrase(); err != nil {
+				return nil, err
+			}
+		}
+		if err := decryptPGPKeys(entity, pass); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Identities{PGP: entities}, nil
+}
+
+func decryptPGPKeys(entity *openpgp.Entity, passphrase []byte) error {
+	if entity.PrivateKey.Encrypted {
+		if err := entity.PrivateKey.Decrypt(passphrase); err != nil {
+			return fmt.Errorf("decrypting PGP key %s: %w", PGPRecipient(entity), err)
+		}
+	}
+	for _, subkey := range entity.Subkeys {
+		if subkey.PrivateKey != nil && subkey.PrivateKey.Encrypted {
+			if err := subkey.PrivateKey.Decrypt(passphrase); err != nil {
+				return fmt.Errorf("decrypting PGP subkey of %s: %w", PGPRecipient(entity), err)
+			}
+		}
+	}
+	return nil
+}
+
+// Decrypt decrypts data that was encrypted with Encrypt with the identities. Every recipient of the data that matches
+// one of the identities is tried.
+func Decrypt(data []byte, identities *Identities) ([]byte, error) {
+	recipients, err := nacl.Unwrap(data)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(recipients))
+	for key := range recipients {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var (
+		errs    []error
+		matched bool
+	)
+	for _, key := range keys {
+		var (
+			plaintext []byte
+			err       error
+		)
+		switch {
+		case strings.HasPrefix(key, AgeRecipientPrefix) && identities.matchesAge(key):
+			plaintext, err = decryptAge(recipients[key], identities.Age)
+		case strings.HasPrefix(key, PGPRecipientPrefix) && identities.matchesPGP(key):
+			plaintext, err = decryptPGP(recipients[key], identities.PGP)
+		default:
+			continue
+		}
+		matched = true
+		if err == nil {
+			return plaintext, nil
+		}
+		errs = append(errs, fmt.Errorf("recipient %s: %w", key, err))
+	}
+
+	if !matched {
+		return nil, &nacl.ErrDecryptionKeyNotAvailable{}
+	}
+	return nil, &nacl.ErrUnableToDecrypt{
+		Errs: errs,
+	}
+}
+
+func (i *Identities) matchesAge(recipient string) bool {
+	for _, id := range i.Age {
+		if x25519, ok := id.(*age.X25519Identity); !ok || x25519.Recipient().String() == recipient {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Identities) matchesPGP(recipient string) bool {
+	for _, entity := range i.PGP {
+		if PGPRecipient(entity) == recipient {
+			return true
+		}
+	}
+	return false
+}
+
+func decryptAge(ciphertext []byte, identities []age.Identity) ([]byte, error) {
+	r, err := age.Decrypt(bytes.NewReader(ciphertext), identities...)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func decryptPGP(ciphertext []byte, keyring openpgp.EntityList) ([]byte, error) {
+	md, err := openpgp.ReadMessage(bytes.NewReader(ciphertext), keyring, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(md.UnverifiedBody)
+}
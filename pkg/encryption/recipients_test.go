@@ -0,0 +1,92 @@
+package encryption
+
+import (
+	"bytes"
+	crypto_rand "crypto/rand"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func armoredPrivateKey(t *testing.T, entity *openpgp.Entity) []byte {
+	buf := &bytes.Buffer{}
+	w, err := armor.Encode(buf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.SerializePrivateWithoutSigning(w, nil))
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	ageID, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	otherAgeID, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	require.NoError(t, err)
+	naclPub, _, err := box.GenerateKey(crypto_rand.Reader)
+	require.NoError(t, err)
+
+	encrypted, err := Encrypt("hunter2", Recipients{
+		PublicKeys: []string{nacl.KeyBytesToB64String(naclPub), ageID.Recipient().String()},
+		PGPKeys:    openpgp.EntityList{entity},
+	})
+	require.NoError(t, err)
+	assert.True(t, nacl.IsAcornEncryptedData([]byte(encrypted)))
+
+	recipients, err := nacl.Unwrap([]byte(encrypted))
+	require.NoError(t, err)
+	assert.Len(t, recipients, 3)
+	assert.Contains(t, recipients, nacl.KeyBytesToB64String(naclPub))
+	assert.Contains(t, recipients, ageID.Recipient().String())
+	assert.Contains(t, recipients, PGPRecipient(entity))
+
+	ids, err := ParseIdentities([]byte("# created: today\n"+ageID.String()+"\n"), nil)
+	require.NoError(t, err)
+	plaintext, err := Decrypt([]byte(encrypted), ids)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", string(plaintext))
+
+	ids, err = ParseIdentities(armoredPrivateKey(t, entity), nil)
+	require.NoError(t, err)
+	plaintext, err = Decrypt([]byte(encrypted), ids)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", string(plaintext))
+
+	ids, err = ParseIdentities([]byte(otherAgeID.String()), nil)
+	require.NoError(t, err)
+	_, err = Decrypt([]byte(encrypted), ids)
+	assert.ErrorAs(t, err, new(*nacl.ErrDecryptionKeyNotAvailable))
+}
+
+func TestParseIdentitiesPassphrase(t *testing.T) {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	require.NoError(t, err)
+	encrypted, err := Encrypt("hunter2", Recipients{PGPKeys: openpgp.EntityList{entity}})
+	require.NoError(t, err)
+
+	require.NoError(t, entity.PrivateKey.Encrypt([]byte("secret")))
+	for _, subkey := range entity.Subkeys {
+		require.NoError(t, subkey.PrivateKey.Encrypt([]byte("secret")))
+	}
+	key := armoredPrivateKey(t, entity)
+
+	_, err = ParseIdentities(key, func() ([]byte, error) {
+		return []byte("wrong"), nil
+	})
+	assert.Error(t, err)
+
+	ids, err := ParseIdentities(key, func() ([]byte, error) {
+		return []byte("secret"), nil
+	})
+	require.NoError(t, err)
+	plaintext, err := Decrypt([]byte(encrypted), ids)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", string(plaintext))
+}
@@ -0,0 +1,165 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of the request body, prefixed with "sha256="
+	SignatureHeader = "X-Acorn-Signature"
+	// EventTypeHeader holds the type of the event
+	EventTypeHeader = "X-Acorn-Event"
+	// DeliveryHeader holds the name of the event, which is the same for every attempt to deliver it
+	DeliveryHeader = "X-Acorn-Delivery"
+)
+
+// internalPrefixes are the address ranges webhooks can't be sent to besides the private networks. The shared address
+// space is used for the pods and services of some clusters.
+var internalPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// IsInternalAddress returns true if the address is loopback, link-local, unspecified or in a private network. Webhooks
+// are sent from inside the cluster, so these addresses could reach the services, pods and metadata endpoints there.
+func IsInternalAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsUnspecified() || addr.IsPrivate() {
+		return true
+	}
+	for _, prefix := range internalPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInternalHost returns true if the host of a webhook url is an internal address or localhost. Host names are checked
+// again by the client of NewWebhookClient after they are resolved.
+func IsInternalHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	addr, err := netip.ParseAddr(strings.Trim(host, "[]"))
+	return err == nil && IsInternalAddress(addr)
+}
+
+// NewWebhookClient returns a client for SendWebhook that refuses to connect to internal addresses, after the host of
+// the url is resolved, and that doesn't follow redirects. Proxies are not used, so the address that is checked is the
+// address of the webhook.
+func NewWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if IsInternalAddress(addr) {
+				return fmt.Errorf("webhook address %s is internal", addr)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Sign returns the value of the SignatureHeader for the body
+func Sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature returns true if the signature was created with the key for the body
+func VerifySignature(key, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(key, body)), []byte(signature))
+}
+
+// WebhookPayload returns the body that is sent to webhooks for the event
+func WebhookPayload(e *apiv1.Event) ([]byte, error) {
+	payload := apiv1.Event{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiv1.SchemeGroupVersion.String(),
+			Kind:       "Event",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Name,
+			Namespace: e.Namespace,
+		},
+		Type:        e.Type,
+		Severity:    e.Severity,
+		Actor:       e.Actor,
+		Source:      e.Source,
+		Description: e.Description,
+		Observed:    e.Observed,
+		Details:     e.Details,
+	}
+	return json.Marshal(payload)
+}
+
+// SendWebhook POSTs the event to the url. The request is signed if a key is given. Any response other than 2xx
+// is an error.
+func SendWebhook(ctx context.Context, client *http.Client, url string, key []byte, e *apiv1.Event) error {
+	body, err := WebhookPayload(e)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, e.Type)
+	req.Header.Set(DeliveryHeader, e.Name)
+	if len(key) > 0 {
+		req.Header.Set(SignatureHeader, Sign(key, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSendWebhook(t *testing.T) {
+	key := []byte("secret")
+	e := &apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "abc",
+			Namespace:       "acorn",
+			ResourceVersion: "1",
+			Annotations:     map[string]string{"foo": "bar"},
+		},
+		Type:     "JobFailed",
+		Severity: v1.EventSeverityWarn,
+		Actor:    "acorn-system",
+		Source:   v1.EventSource{Kind: "app", Name: "app"},
+		Details:  v1.GenericMap{"jobName": "migrate"},
+	}
+
+	var (
+		status   = http.StatusNoContent
+		received *apiv1.Event
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, "JobFailed", req.Header.Get(EventTypeHeader))
+		assert.Equal(t, "abc", req.Header.Get(DeliveryHeader))
+		assert.True(t, VerifySignature(key, body, req.Header.Get(SignatureHeader)))
+		assert.False(t, VerifySignature([]byte("wrong"), body, req.Header.Get(SignatureHeader)))
+
+		received = new(apiv1.Event)
+		require.NoError(t, json.Unmarshal(body, received))
+		rw.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	require.NoError(t, SendWebhook(context.Background(), server.Client(), server.URL, key, e))
+	require.NotNil(t, received)
+	assert.Equal(t, "Event", received.Kind)
+	assert.Equal(t, "abc", received.Name)
+	assert.Empty(t, received.Annotations)
+	assert.Empty(t, received.ResourceVersion)
+	assert.Equal(t, e.Source, received.Source)
+	assert.Equal(t, "migrate", received.Details["jobName"])
+
+	status = http.StatusInternalServerError
+	assert.Error(t, SendWebhook(context.Background(), server.Client(), server.URL, key, e))
+}
+
+func TestIsInternalHost(t *testing.T) {
+	for _, host := range []string{"127.0.0.1", "localhost", "api.localhost", "10.43.0.1", "172.16.5.4", "192.168.1.1",
+		"169.254.169.254", "100.64.0.1", "0.0.0.0", "::1", "[::1]", "fe80::1", "fd00::1", "::ffff:127.0.0.1"} {
+		assert.Truef(t, IsInternalHost(host), "%s should be internal", host)
+	}
+	for _, host := range []string{"example.com", "8.8.8.8", "2001:4860:4860::8888"} {
+		assert.Falsef(t, IsInternalHost(host), "%s should not be internal", host)
+	}
+}
+
+func TestWebhookClientRefusesInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := SendWebhook(context.Background(), NewWebhookClient(time.Second), server.URL, nil, &apiv1.Event{})
+	assert.ErrorContains(t, err, "is internal")
+}
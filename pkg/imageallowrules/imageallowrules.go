@@ -2,6 +2,7 @@ package imageallowrules
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/acorn-io/runtime/pkg/cosign"
 	"github.com/acorn-io/runtime/pkg/imagepattern"
 	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/imagesystem"
+	"github.com/acorn-io/runtime/pkg/sbom"
 	"github.com/acorn-io/runtime/pkg/tags"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/crane"
@@ -19,6 +22,8 @@ import (
 	ocosign "github.com/sigstore/cosign/v2/pkg/cosign"
 	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -58,6 +63,70 @@ func CheckImageAllowed(ctx context.Context, c client.Reader, namespace, image, d
 	return CheckImageAgainstRules(ctx, c, namespace, image, digest, rulesList.Items, keychain, opts...)
 }
 
+// ExplainImageAllowed evaluates the ImageAllowRules of the project against the image and returns the result of every rule.
+// If ruleNames are given, only those rules are evaluated.
+func ExplainImageAllowed(ctx context.Context, c client.Reader, namespace, image, digest string, ruleNames []string, opts ...remote.Option) ([]RuleResult, error) {
+	rulesList := &v1.ImageAllowRuleInstanceList{}
+	if err := c.List(ctx, rulesList, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, fmt.Errorf("failed to list ImageAllowRules: %w", err)
+	}
+
+	rules := rulesList.Items
+	if len(ruleNames) > 0 {
+		rules = nil
+		for _, ruleName := range ruleNames {
+			found := false
+			for _, rule := range rulesList.Items {
+				if rule.Name == ruleName {
+					rules = append(rules, rule)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("ImageAllowRule %s not found in project %s", ruleName, namespace)
+			}
+		}
+	}
+
+	opts, err := images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	keychain, err := images.GetAuthenticationRemoteKeychainWithLocalAuth(ctx, nil, nil, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return ExplainImageAgainstRules(ctx, c, namespace, image, digest, rules, keychain, opts...)
+}
+
+// VerifyImageSignature verifies that the image is signed by the key and that the signature matches the annotation rules
+func VerifyImageSignature(ctx context.Context, c client.Reader, namespace, image, digest, key string, annotations v1.SignatureAnnotations, opts ...remote.Option) error {
+	opts, err := images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return err
+	}
+
+	keychain, err := images.GetAuthenticationRemoteKeychainWithLocalAuth(ctx, nil, nil, c, namespace)
+	if err != nil {
+		return err
+	}
+
+	verifyOpts, err := newVerifyOpts(ctx, c, namespace, image, digest, keychain, opts...)
+	if err != nil {
+		return err
+	}
+	verifyOpts.Key = key
+	verifyOpts.AnnotationRules = annotations
+
+	if err := cosign.EnsureReferences(ctx, c, image, &verifyOpts); err != nil {
+		return err
+	}
+	return cosign.VerifySignature(ctx, verifyOpts)
+}
+
 // CheckImageAgainstRules checks if the image is allowed by the given ImageAllowRules
 // If no rules are given, the image is
 // - DENIED if strict mode (deny-by-default) is enabled
@@ -81,7 +150,35 @@ func CheckImageAgainstRules(ctx context.Context, c client.Reader, namespace stri
 
 	logrus.Debugf("Checking image %s (%s) against %d rules", image, digest, len(imageAllowRules))
 
-	// Check if the image is allowed
+	verifyOpts, err := newVerifyOpts(ctx, c, namespace, image, digest, keychain, opts...)
+	if err != nil {
+		return err
+	}
+
+	return checkImageAgainstRules(ctx, c, image, digest, imageAllowRules, verifyOpts, opts...)
+}
+
+// RuleResult explains why an ImageAllowRule allows an image or not
+type RuleResult struct {
+	Namespace string
+	Name      string
+	Allowed   bool
+	Reason    string
+}
+
+// ExplainImageAgainstRules evaluates all given ImageAllowRules against the image, regardless of whether the
+// ImageAllowRules feature is enabled, and returns the result of every rule
+func ExplainImageAgainstRules(ctx context.Context, c client.Reader, namespace string, image string, digest string, imageAllowRules []v1.ImageAllowRuleInstance, keychain authn.Keychain, opts ...remote.Option) ([]RuleResult, error) {
+	verifyOpts, err := newVerifyOpts(ctx, c, namespace, image, digest, keychain, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluateRules(ctx, c, image, digest, imageAllowRules, verifyOpts, false, opts...)
+}
+
+// newVerifyOpts returns the options to verify the signatures of an image
+func newVerifyOpts(ctx context.Context, c client.Reader, namespace, image, digest string, keychain authn.Keychain, opts ...remote.Option) (cosign.VerifyOpts, error) {
 	verifyOpts := cosign.VerifyOpts{
 		Namespace:          namespace,
 		AnnotationRules:    v1.SignatureAnnotations{},
@@ -91,9 +188,77 @@ func CheckImageAgainstRules(ctx context.Context, c client.Reader, namespace stri
 		CraneOpts:          []crane.Option{crane.WithContext(ctx), crane.WithAuthFromKeychain(keychain)},
 	}
 
+	localRef, ok, err := LocalImageReference(ctx, c, namespace, image, digest)
+	if err != nil {
+		return verifyOpts, err
+	}
+	if ok {
+		// The signatures of local images are stored next to the image in the internal registry, so there is nothing to cache
+		verifyOpts.ImageRef = localRef
+		verifyOpts.NoCache = true
+	}
+
+	return verifyOpts, nil
+}
+
+// LocalImageReference returns the reference of the image in the internal registry if the image was built or
+// imported and is referenced without a registry, e.g. by a local tag or its ID. Signatures of these images are
+// stored next to the image in the internal registry. Images that are referenced with a registry are signed there.
+func LocalImageReference(ctx context.Context, c client.Reader, namespace, image, digest string) (name.Digest, bool, error) {
+	if digest == "" {
+		return name.Digest{}, false, nil
+	}
+
 	ref, err := name.ParseReference(image, name.WithDefaultRegistry(""), name.WithDefaultTag(""))
 	if err != nil {
-		return fmt.Errorf("error parsing image reference %s: %w", image, err)
+		return name.Digest{}, false, fmt.Errorf("error parsing image reference %s: %w", image, err)
+	}
+	if ref.Context().RegistryStr() != "" {
+		return name.Digest{}, false, nil
+	}
+
+	digest = strings.TrimPrefix(digest, "sha256:")
+	imageInstance := &v1.ImageInstance{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: digest}, imageInstance); apierrors.IsNotFound(err) {
+		return name.Digest{}, false, nil
+	} else if err != nil {
+		return name.Digest{}, false, err
+	}
+	if imageInstance.Remote {
+		return name.Digest{}, false, nil
+	}
+
+	ref, err = imagesystem.GetInternalRepoForNamespaceAndID(ctx, c, namespace, digest)
+	if err != nil {
+		return name.Digest{}, false, err
+	}
+	return ref.Context().Digest("sha256:" + digest), true, nil
+}
+
+// checkImageAgainstRules checks the image against the scopes and signature rules of the ImageAllowRules
+func checkImageAgainstRules(ctx context.Context, c client.Reader, image string, digest string, imageAllowRules []v1.ImageAllowRuleInstance, verifyOpts cosign.VerifyOpts, opts ...remote.Option) error {
+	results, err := evaluateRules(ctx, c, image, digest, imageAllowRules, verifyOpts, true, opts...)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Allowed {
+			return nil
+		}
+	}
+	return &ErrImageNotAllowed{Image: image}
+}
+
+// evaluateRules returns the results of the ImageAllowRules for the image. If firstMatch is set, it stops at the first
+// rule that allows the image.
+func evaluateRules(ctx context.Context, c client.Reader, image string, digest string, imageAllowRules []v1.ImageAllowRuleInstance, verifyOpts cosign.VerifyOpts, firstMatch bool, opts ...remote.Option) ([]RuleResult, error) {
+	ref, err := name.ParseReference(image, name.WithDefaultRegistry(""), name.WithDefaultTag(""))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing image reference %s: %w", image, err)
+	}
+
+	if digest != "" && !strings.HasPrefix(digest, "sha256:") {
+		digest = "sha256:" + digest
 	}
 
 	if ref.Identifier() == "" && tags.SHAPattern.MatchString(image) {
@@ -105,70 +270,149 @@ func CheckImageAgainstRules(ctx context.Context, c client.Reader, namespace stri
 		digest = ref.Context().Digest(digest).Name()
 	}
 
-iarLoop:
+	var results []RuleResult
 	for _, imageAllowRule := range imageAllowRules {
-		// Check if the image is in scope of the ImageAllowRule
-		if !imageCovered(ref, digest, imageAllowRule) {
-			continue
+		allowed, reason, err := checkRule(ctx, c, image, ref, digest, imageAllowRule, &verifyOpts, opts...)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, RuleResult{
+			Namespace: imageAllowRule.Namespace,
+			Name:      imageAllowRule.Name,
+			Allowed:   allowed,
+			Reason:    reason,
+		})
+		if allowed && firstMatch {
+			break
 		}
+	}
+	return results, nil
+}
 
-		// > Signatures
-		// Any verification error or failed verification issue will skip on to the next IAR
-		for _, rule := range imageAllowRule.Signatures.Rules {
-			if err := cosign.EnsureReferences(ctx, c, image, &verifyOpts); err != nil {
-				return fmt.Errorf("error ensuring references for image %s: %w", image, err)
+// checkRule checks if the ImageAllowRule allows the image and returns the reason why it does not
+// Any verification error or failed verification issue fails the rule
+func checkRule(ctx context.Context, c client.Reader, image string, ref name.Reference, digest string, imageAllowRule v1.ImageAllowRuleInstance, verifyOpts *cosign.VerifyOpts, opts ...remote.Option) (bool, string, error) {
+	// Check if the image is in scope of the ImageAllowRule
+	if !imageCovered(ref, digest, imageAllowRule) {
+		return false, "image is not in scope of the rule", nil
+	}
+
+	// > Signatures
+	for ruleIndex, rule := range imageAllowRule.Signatures.Rules {
+		if err := cosign.EnsureReferences(ctx, c, image, verifyOpts); err != nil {
+			var verr *ocosign.VerificationError
+			if errors.As(err, &verr) {
+				return false, fmt.Sprintf("signatures.rules.%d: %v", ruleIndex, err), nil
 			}
-			verifyOpts.AnnotationRules = rule.Annotations
-
-			// allOf: all signatures must pass verification
-			if len(rule.SignedBy.AllOf) != 0 {
-				for allOfRuleIndex, signer := range rule.SignedBy.AllOf {
-					logrus.Debugf("Checking image %s against %s/%s.signatures.allOf.%d", image, imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex)
-					verifyOpts.Key = signer
-					err := cosign.VerifySignature(ctx, verifyOpts)
-					if err != nil {
-						if _, ok := err.(*ocosign.VerificationError); !ok {
-							logrus.Errorf("error verifying image %s against %s/%s.signatures.allOf.%d: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex, err)
-						}
-						continue iarLoop // failed or errored in allOf, try next IAR
-					}
+			return false, "", fmt.Errorf("error ensuring references for image %s: %w", image, err)
+		}
+		verifyOpts.AnnotationRules = rule.Annotations
+
+		// allOf: all signatures must pass verification
+		for allOfRuleIndex, signer := range rule.SignedBy.AllOf {
+			logrus.Debugf("Checking image %s against %s/%s.signatures.allOf.%d", image, imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex)
+			verifyOpts.Key = signer
+			verifyOpts.Keyless = nil
+			err := cosign.VerifySignature(ctx, *verifyOpts)
+			if err != nil {
+				if _, ok := err.(*ocosign.VerificationError); !ok {
+					logrus.Errorf("error verifying image %s against %s/%s.signatures.allOf.%d: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex, err)
 				}
+				// failed or errored in allOf, try next IAR
+				return false, fmt.Sprintf("signatures.rules.%d.signedBy.allOf.%d: %v", ruleIndex, allOfRuleIndex, err), nil
 			}
-			var anyOfErrs []error
-			// anyOf: only one signature must pass verification
-			if len(rule.SignedBy.AnyOf) != 0 {
-				anyOfOK := false
-				for anyOfRuleIndex, signer := range rule.SignedBy.AnyOf {
-					logrus.Debugf("Checking image %s against %s/%s.signatures.anyOf.%d", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex)
-					verifyOpts.Key = signer
-					err := cosign.VerifySignature(ctx, verifyOpts)
-					if err == nil {
-						anyOfOK = true
-						break
+		}
+
+		var anyOfErrs []error
+		// anyOf: only one signature must pass verification
+		if len(rule.SignedBy.AnyOf) != 0 {
+			anyOfOK := false
+			for anyOfRuleIndex, signer := range rule.SignedBy.AnyOf {
+				logrus.Debugf("Checking image %s against %s/%s.signatures.anyOf.%d", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex)
+				verifyOpts.Key = signer
+				verifyOpts.Keyless = nil
+				err := cosign.VerifySignature(ctx, *verifyOpts)
+				if err == nil {
+					anyOfOK = true
+					break
+				} else {
+					if _, ok := err.(*ocosign.VerificationError); ok {
+						logrus.Debugf("image %s not allowed as per %s/%s.signatures.anyOf.%d: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex, err)
 					} else {
-						if _, ok := err.(*ocosign.VerificationError); ok {
-							logrus.Debugf("image %s not allowed as per %s/%s.signatures.anyOf.%d: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex, err)
-						} else {
-							e := fmt.Errorf("error verifying image %s against %s/%s.signatures.anyOf.%d: %w", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex, err)
-							anyOfErrs = append(anyOfErrs, e)
-							logrus.Errorln(e.Error())
-						}
-					}
-				}
-				if !anyOfOK {
-					if len(anyOfErrs) == len(rule.SignedBy.AnyOf) {
-						// we had errors for all anyOf rules (not failed verification, but actual errors)
-						e := fmt.Errorf("error verifying image %s against %s/%s.signatures.anyOf.*: %w", image, imageAllowRule.Namespace, imageAllowRule.Name, merr.NewErrors(anyOfErrs...))
+						e := fmt.Errorf("error verifying image %s against %s/%s.signatures.anyOf.%d: %w", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex, err)
+						anyOfErrs = append(anyOfErrs, e)
 						logrus.Errorln(e.Error())
 					}
-					continue iarLoop // failed or errored in all anyOf, try next IAR
 				}
 			}
+			if !anyOfOK {
+				if len(anyOfErrs) == len(rule.SignedBy.AnyOf) {
+					// we had errors for all anyOf rules (not failed verification, but actual errors)
+					e := fmt.Errorf("error verifying image %s against %s/%s.signatures.anyOf.*: %w", image, imageAllowRule.Namespace, imageAllowRule.Name, merr.NewErrors(anyOfErrs...))
+					logrus.Errorln(e.Error())
+				}
+				// failed or errored in all anyOf, try next IAR
+				return false, fmt.Sprintf("signatures.rules.%d.signedBy.anyOf: no signature verified with any of the keys", ruleIndex), nil
+			}
 		}
 
-		return nil
+		// keyless: a signature must be made by one of the identities
+		if rule.SignedBy.Keyless != nil {
+			logrus.Debugf("Checking image %s against %s/%s.signatures.keyless", image, imageAllowRule.Namespace, imageAllowRule.Name)
+			verifyOpts.Key = ""
+			verifyOpts.Keyless = rule.SignedBy.Keyless
+			if err := cosign.VerifySignature(ctx, *verifyOpts); err != nil {
+				var verr *ocosign.VerificationError
+				if errors.As(err, &verr) {
+					logrus.Debugf("image %s not allowed as per %s/%s.signatures.keyless: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, err)
+				} else {
+					logrus.Errorf("error verifying image %s against %s/%s.signatures.keyless: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, err)
+				}
+				// failed or errored in keyless, try next IAR
+				return false, fmt.Sprintf("signatures.rules.%d.signedBy.keyless: %v", ruleIndex, err), nil
+			}
+		}
 	}
-	return &ErrImageNotAllowed{Image: image}
+
+	// > SBOM
+	if imageAllowRule.SBOM.Required {
+		if ok, reason, err := checkSBOM(digest, imageAllowRule.SBOM, verifyOpts, opts...); err != nil || !ok {
+			return false, reason, err
+		}
+	}
+
+	if len(imageAllowRule.Signatures.Rules) == 0 {
+		return true, "image is in scope of the rule", nil
+	}
+	return true, "image is in scope of the rule and all signature rules passed", nil
+}
+
+// checkSBOM checks that a SBOM in one of the formats is stored next to the image
+func checkSBOM(digest string, rule v1.ImageAllowRuleSBOM, verifyOpts *cosign.VerifyOpts, opts ...remote.Option) (bool, string, error) {
+	subject := verifyOpts.ImageRef
+	if subject.Identifier() == "" {
+		var err error
+		subject, err = name.NewDigest(digest)
+		if err != nil {
+			return false, fmt.Sprintf("sbom: unable to determine the image reference to look up its SBOM: %v", err), nil
+		}
+	}
+
+	docs, err := sbom.List(subject, opts...)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, doc := range docs {
+		if len(rule.Formats) == 0 || slices.Contains(rule.Formats, doc.Format) {
+			return true, "", nil
+		}
+	}
+
+	if len(rule.Formats) > 0 {
+		return false, fmt.Sprintf("sbom: image has no SBOM in format %s", strings.Join(rule.Formats, ", ")), nil
+	}
+	return false, "sbom: image has no SBOM", nil
 }
 
 func imageCovered(image name.Reference, digest string, iar v1.ImageAllowRuleInstance) bool {
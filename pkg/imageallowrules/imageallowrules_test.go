@@ -1,11 +1,24 @@
 package imageallowrules
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/cosign/cosigntest"
+	"github.com/acorn-io/runtime/pkg/sbom"
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -103,3 +116,262 @@ func TestImageCovered(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckImageAgainstRulesKeyless(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	sigstore, err := cosigntest.NewSigstore()
+	require.NoError(t, err)
+
+	iars := []v1.ImageAllowRuleInstance{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "keyless",
+				Namespace: "testns",
+			},
+			Images: []string{"**"},
+			Signatures: v1.ImageAllowRuleSignatures{
+				Rules: []v1.SignatureRules{
+					{
+						SignedBy: v1.SignedBy{
+							Keyless: &v1.KeylessSigner{
+								Identities: []v1.KeylessIdentity{
+									{
+										Issuer:        "https://token.actions.githubusercontent.com",
+										SubjectRegexp: `^https://github\.com/acorn-io/`,
+									},
+								},
+								CertificateAuthority: sigstore.CertificateAuthority(),
+								TransparencyLogKey:   sigstore.TransparencyLogKey(),
+							},
+						},
+						Annotations: v1.SignatureAnnotations{
+							Match: map[string]string{"qa": "approved"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name    string
+		sign    *cosigntest.SignOptions
+		allowed bool
+	}{
+		{
+			name: "signed by allowed identity",
+			sign: &cosigntest.SignOptions{
+				Issuer:      "https://token.actions.githubusercontent.com",
+				Subject:     "https://github.com/acorn-io/runtime/.github/workflows/release.yaml@refs/tags/v1.0.0",
+				Annotations: map[string]interface{}{"qa": "approved"},
+			},
+			allowed: true,
+		},
+		{
+			name: "signed by other identity",
+			sign: &cosigntest.SignOptions{
+				Issuer:      "https://token.actions.githubusercontent.com",
+				Subject:     "https://github.com/someone-else/runtime/.github/workflows/release.yaml@refs/tags/v1.0.0",
+				Annotations: map[string]interface{}{"qa": "approved"},
+			},
+		},
+		{
+			name: "signed without required annotations",
+			sign: &cosigntest.SignOptions{
+				Issuer:  "https://token.actions.githubusercontent.com",
+				Subject: "https://github.com/acorn-io/runtime/.github/workflows/release.yaml@refs/tags/v1.0.0",
+			},
+		},
+		{
+			name: "not in transparency log",
+			sign: &cosigntest.SignOptions{
+				Issuer:            "https://token.actions.githubusercontent.com",
+				Subject:           "https://github.com/acorn-io/runtime/.github/workflows/release.yaml@refs/tags/v1.0.0",
+				Annotations:       map[string]interface{}{"qa": "approved"},
+				NoTransparencyLog: true,
+			},
+		},
+	}
+
+	for i, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			img, err := random.Image(1024, 1)
+			require.NoError(t, err)
+			ref, err := name.ParseReference(fmt.Sprintf("%s/acorn/app%d:v1.0.0", u.Host, i))
+			require.NoError(t, err)
+			require.NoError(t, remote.Write(ref, img))
+
+			digest, err := img.Digest()
+			require.NoError(t, err)
+			if tc.sign != nil {
+				require.NoError(t, sigstore.Sign(ref.Context().Digest(digest.String()), *tc.sign))
+			}
+
+			err = checkImageAgainstRules(context.Background(), nil, ref.Name(), digest.String(), iars, cosign.VerifyOpts{
+				Namespace:          "testns",
+				SignatureAlgorithm: "sha256",
+				NoCache:            true,
+			})
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, &ErrImageNotAllowed{Image: ref.Name()})
+			}
+		})
+	}
+}
+
+func TestEvaluateRulesExplainsResults(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	sigstore, err := cosigntest.NewSigstore()
+	require.NoError(t, err)
+
+	keyless := &v1.KeylessSigner{
+		Identities: []v1.KeylessIdentity{
+			{
+				Issuer:  "https://token.actions.githubusercontent.com",
+				Subject: "https://github.com/acorn-io/runtime/.github/workflows/release.yaml@refs/tags/v1.0.0",
+			},
+		},
+		CertificateAuthority: sigstore.CertificateAuthority(),
+		TransparencyLogKey:   sigstore.TransparencyLogKey(),
+	}
+
+	iars := []v1.ImageAllowRuleInstance{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "out-of-scope", Namespace: "testns"},
+			Images:     []string{"docker.io/**"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "wrong-annotations", Namespace: "testns"},
+			Images:     []string{"**"},
+			Signatures: v1.ImageAllowRuleSignatures{
+				Rules: []v1.SignatureRules{{
+					SignedBy:    v1.SignedBy{Keyless: keyless},
+					Annotations: v1.SignatureAnnotations{Match: map[string]string{"qa": "approved"}},
+				}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "signed", Namespace: "testns"},
+			Images:     []string{"**"},
+			Signatures: v1.ImageAllowRuleSignatures{
+				Rules: []v1.SignatureRules{{
+					SignedBy: v1.SignedBy{Keyless: keyless},
+				}},
+			},
+		},
+	}
+
+	push := func(repo string, sign bool) (name.Reference, string) {
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		ref, err := name.ParseReference(fmt.Sprintf("%s/acorn/%s:v1.0.0", u.Host, repo))
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img))
+		digest, err := img.Digest()
+		require.NoError(t, err)
+		if sign {
+			require.NoError(t, sigstore.Sign(ref.Context().Digest(digest.String()), cosigntest.SignOptions{
+				Issuer:  "https://token.actions.githubusercontent.com",
+				Subject: "https://github.com/acorn-io/runtime/.github/workflows/release.yaml@refs/tags/v1.0.0",
+			}))
+		}
+		return ref, digest.String()
+	}
+
+	verifyOpts := cosign.VerifyOpts{
+		Namespace:          "testns",
+		SignatureAlgorithm: "sha256",
+		NoCache:            true,
+	}
+
+	ref, digest := push("signed", true)
+	results, err := evaluateRules(context.Background(), nil, ref.Name(), digest, iars, verifyOpts, false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.False(t, results[0].Allowed)
+	assert.Equal(t, "image is not in scope of the rule", results[0].Reason)
+	assert.False(t, results[1].Allowed)
+	assert.Contains(t, results[1].Reason, "signatures.rules.0.signedBy.keyless")
+	assert.True(t, results[2].Allowed)
+	assert.Equal(t, "signed", results[2].Name)
+
+	// Images without signatures fail the signature rules instead of failing the check
+	ref, digest = push("unsigned", false)
+	results, err = evaluateRules(context.Background(), nil, ref.Name(), digest, iars, verifyOpts, false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for _, result := range results {
+		assert.False(t, result.Allowed, result.Name)
+	}
+	assert.Contains(t, results[2].Reason, "signatures.rules.0")
+	assert.ErrorIs(t, checkImageAgainstRules(context.Background(), nil, ref.Name(), digest, iars, verifyOpts), &ErrImageNotAllowed{Image: ref.Name()})
+}
+
+func TestEvaluateRulesRequiresSBOM(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	iars := []v1.ImageAllowRuleInstance{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "any-sbom", Namespace: "testns"},
+			Images:     []string{"**"},
+			SBOM:       v1.ImageAllowRuleSBOM{Required: true},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cyclonedx-sbom", Namespace: "testns"},
+			Images:     []string{"**"},
+			SBOM:       v1.ImageAllowRuleSBOM{Required: true, Formats: []string{sbom.FormatCycloneDX}},
+		},
+	}
+
+	push := func(repo string, withSBOM bool) (name.Reference, string) {
+		img, err := random.Image(1024, 1)
+		require.NoError(t, err)
+		ref, err := name.ParseReference(fmt.Sprintf("%s/acorn/%s:v1.0.0", u.Host, repo))
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img))
+		digest, err := img.Digest()
+		require.NoError(t, err)
+		if withSBOM {
+			doc, err := sbom.Encode(sbom.FormatSPDX, ref.Name(), nil)
+			require.NoError(t, err)
+			_, err = sbom.Attach(ref.Context().Digest(digest.String()), sbom.TargetAcornfile, sbom.FormatSPDX, doc)
+			require.NoError(t, err)
+		}
+		return ref, digest.String()
+	}
+
+	verifyOpts := cosign.VerifyOpts{
+		Namespace: "testns",
+		NoCache:   true,
+	}
+
+	ref, digest := push("with-sbom", true)
+	results, err := evaluateRules(context.Background(), nil, ref.Name(), digest, iars, verifyOpts, false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.True(t, results[0].Allowed)
+	assert.False(t, results[1].Allowed)
+	assert.Equal(t, "sbom: image has no SBOM in format cyclonedx", results[1].Reason)
+
+	ref, digest = push("without-sbom", false)
+	results, err = evaluateRules(context.Background(), nil, ref.Name(), digest, iars, verifyOpts, false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.False(t, results[0].Allowed)
+	assert.Equal(t, "sbom: image has no SBOM", results[0].Reason)
+	assert.ErrorIs(t, checkImageAgainstRules(context.Background(), nil, ref.Name(), digest, iars, verifyOpts), &ErrImageNotAllowed{Image: ref.Name()})
+}
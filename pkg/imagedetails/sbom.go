@@ -0,0 +1,59 @@
+package imagedetails
+
+import (
+	"context"
+	"sort"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/sbom"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetSBOMs summarizes the SBOMs that are stored next to the app image
+func GetSBOMs(ctx context.Context, c kclient.Client, namespace, imageName, digest string, opts ...remote.Option) ([]apiv1.ImageSBOM, error) {
+	ref, err := images.GetImageReference(ctx, c, namespace, imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err = images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := sbom.List(ref.Context().Digest(digest), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []apiv1.ImageSBOM
+	for _, doc := range docs {
+		data, err := sbom.Read(doc, opts...)
+		if err != nil {
+			return nil, err
+		}
+		pkgs, err := sbom.Decode(doc.Format, data)
+		if err != nil {
+			return nil, err
+		}
+
+		summary := apiv1.ImageSBOM{
+			Target:       doc.Target,
+			Format:       doc.Format,
+			Digest:       doc.Digest.DigestStr(),
+			Packages:     len(pkgs),
+			PackageTypes: map[string]int{},
+		}
+		for _, pkg := range pkgs {
+			summary.PackageTypes[pkg.Type]++
+		}
+		result = append(result, summary)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Target < result[j].Target
+	})
+	return result, nil
+}
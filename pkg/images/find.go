@@ -150,3 +150,17 @@ func FindImageMatch(images apiv1.ImageList, search string) (*apiv1.Image, string
 
 	return nil, "", ErrImageNotFound{ImageSearch: search}
 }
+
+// PinnedReference returns a reference to the app image with the given ID and digest that resolves to the same image,
+// even if the tag it was pulled from has been moved to another image since
+func PinnedReference(id, digest string) (string, error) {
+	if tags2.SHAPattern.MatchString(id) || digest == "" {
+		return id, nil
+	}
+
+	ref, err := name.ParseReference(id)
+	if err != nil {
+		return "", err
+	}
+	return ref.Context().Digest(digest).String(), nil
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/appdefinition"
 	"github.com/acorn-io/runtime/pkg/build"
 	"github.com/acorn-io/runtime/pkg/client"
@@ -21,6 +22,11 @@ type ImageSource struct {
 	Args      []string
 	Profiles  []string
 	Platforms []string
+	// SBOM is the format of the SBOMs to generate when the image is built
+	SBOM string
+	// CacheFrom and CacheTo are the build cache imports and export that are used for every build
+	CacheFrom []string
+	CacheTo   string
 }
 
 func NewImageSource(file string, args, profiles, platforms []string) (result ImageSource) {
@@ -155,10 +161,20 @@ func (i ImageSource) WithImage(image string) ImageSource {
 }
 
 func (i ImageSource) GetImageAndDeployArgs(ctx context.Context, c client.Client) (string, map[string]any, error) {
-	var err error
+	image, _, deployArgs, err := i.GetAppImageAndDeployArgs(ctx, c, nil)
+	return image, deployArgs, err
+}
+
+// GetAppImageAndDeployArgs is GetImageAndDeployArgs that also returns the app image that was built, nil if the image
+// source is not built. The builds of the Acornfile that are unchanged since the build of reuse use its images again.
+func (i ImageSource) GetAppImageAndDeployArgs(ctx context.Context, c client.Client, reuse *v1.ImagesData) (string, *v1.AppImage, map[string]any, error) {
+	var (
+		appImage *v1.AppImage
+		err      error
+	)
 	i.Image, i.File, err = i.ResolveImageAndFile()
 	if err != nil {
-		return "", nil, err
+		return "", nil, nil, err
 	}
 
 	// if file is set, then we must build to get the image, if it's not set, then
@@ -166,34 +182,38 @@ func (i ImageSource) GetImageAndDeployArgs(ctx context.Context, c client.Client)
 	if i.File != "" {
 		creds, err := GetCreds(c)
 		if err != nil {
-			return "", nil, err
+			return "", nil, nil, err
 		}
 
 		_, params, err := i.GetAppDefinition(ctx, c)
 		if err != nil {
-			return "", nil, err
+			return "", nil, nil, err
 		}
 
 		platforms, err := build.ParsePlatforms(i.Platforms)
 		if err != nil {
-			return "", nil, err
+			return "", nil, nil, err
 		}
 
-		image, err := c.AcornImageBuild(ctx, i.File, &client.AcornImageBuildOptions{
+		appImage, err = c.AcornImageBuild(ctx, i.File, &client.AcornImageBuildOptions{
 			Credentials: creds,
 			Cwd:         i.Image,
 			Args:        params,
 			Profiles:    i.Profiles,
 			Platforms:   platforms,
+			SBOM:        i.SBOM,
+			CacheFrom:   i.CacheFrom,
+			CacheTo:     i.CacheTo,
+			Reuse:       reuse,
 		})
 		if err != nil {
-			return "", nil, err
+			return "", nil, nil, err
 		}
-		i.Image = image.ID
+		i.Image = appImage.ID
 	}
 
 	_, deployArgs, err := i.GetAppDefinition(ctx, c)
-	return i.Image, deployArgs, err
+	return i.Image, appImage, deployArgs, err
 }
 
 func GetCreds(c client.Client) (client.CredentialLookup, error) {
@@ -11,12 +11,15 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func BuilderObjects(name, namespace, forNamespace, buildKitImage, pub, privKey, builderUID, forwardAddress string, useCustomCabundle, publishBuilder bool) []client.Object {
+// BuilderObjects returns the objects of a builder. If cacheSize is set the builder gets a PersistentVolumeClaim of that
+// size for the local build caches, so they are kept when the builder pod is replaced.
+func BuilderObjects(name, namespace, forNamespace, buildKitImage, pub, privKey, builderUID, forwardAddress string, useCustomCabundle, publishBuilder bool, cacheSize *resource.Quantity) []client.Object {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -238,5 +241,47 @@ func BuilderObjects(name, namespace, forNamespace, buildKitImage, pub, privKey,
 			},
 		})
 	}
-	return []client.Object{secret, deployment, pdb, svc}
+	objs := []client.Object{secret, deployment, pdb, svc}
+
+	if cacheSize != nil {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-build-cache",
+				Namespace: namespace,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: *cacheSize,
+					},
+				},
+			},
+		}
+
+		// The buildkit client in the service container reads and writes the local caches
+		for i, container := range deployment.Spec.Template.Spec.Containers {
+			if container.Name == "service" {
+				deployment.Spec.Template.Spec.Containers[i].VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+					Name:      "build-cache",
+					MountPath: system.BuildCacheDir,
+				})
+			}
+		}
+		deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: "build-cache",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvc.Name,
+				},
+			},
+		})
+		// The volume can only be attached to one node, the old pod has to be gone before the new one can start
+		deployment.Spec.Strategy = appsv1.DeploymentStrategy{
+			Type: appsv1.RecreateDeploymentStrategyType,
+		}
+		objs = append(objs, pvc)
+	}
+
+	return objs
 }
@@ -0,0 +1,56 @@
+package imagesystem
+
+import (
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestBuilderObjectsCacheVolume(t *testing.T) {
+	objs := BuilderObjects("builder", system.ImagesNamespace, "", "image", "pub", "priv", "", "registry", false, false, nil)
+	for _, obj := range objs {
+		_, ok := obj.(*corev1.PersistentVolumeClaim)
+		assert.False(t, ok, "builder without a cache size must not have a cache volume")
+	}
+
+	size := resource.MustParse("10Gi")
+	objs = BuilderObjects("builder", system.ImagesNamespace, "", "image", "pub", "priv", "", "registry", false, false, &size)
+
+	pvc := find[*corev1.PersistentVolumeClaim](objs)
+	require.NotNil(t, pvc)
+	assert.Equal(t, "builder-build-cache", pvc.Name)
+	assert.Equal(t, size, pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+
+	dep := find[*appsv1.Deployment](objs)
+	require.NotNil(t, dep)
+	assert.Equal(t, appsv1.RecreateDeploymentStrategyType, dep.Spec.Strategy.Type)
+	assert.Contains(t, dep.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: "build-cache",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.Name},
+		},
+	})
+	for _, container := range dep.Spec.Template.Spec.Containers {
+		mount := corev1.VolumeMount{Name: "build-cache", MountPath: system.BuildCacheDir}
+		if container.Name == "service" {
+			assert.Contains(t, container.VolumeMounts, mount)
+		} else {
+			assert.NotContains(t, container.VolumeMounts, mount)
+		}
+	}
+}
+
+func find[T client.Object](objs []client.Object) (result T) {
+	for _, obj := range objs {
+		if o, ok := obj.(T); ok {
+			return o
+		}
+	}
+	return
+}
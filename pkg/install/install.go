@@ -157,6 +157,10 @@ func Install(ctx context.Context, image string, opts *Options) error {
 		return err
 	}
 
+	if err = validateCPUArgs(*finalConfForValidation.WorkloadCPUDefault, *finalConfForValidation.WorkloadCPUMaximum); err != nil {
+		return err
+	}
+
 	if err = validateServiceLBAnnotations(finalConfForValidation.ServiceLBAnnotations); err != nil {
 		return err
 	}
@@ -291,6 +295,23 @@ func validateMemoryArgs(defaultMemory int64, maximumMemory int64) error {
 	return nil
 }
 
+func validateCPUArgs(defaultCPU int64, maximumCPU int64) error {
+	// if default is set to unrestricted cpu (0) and max cpu is not default will be set to maximum
+	if defaultCPU == 0 && maximumCPU != 0 {
+		pterm.Info.Println("workload-cpu-default is being set to workload-cpu-maximum. If this is not intended please specify workload-cpu-default to non-zero value")
+		return nil
+	}
+	// if max cpu is not set to unlimited default must be smaller than maximum
+	if maximumCPU != 0 && defaultCPU > maximumCPU {
+		defaultQuantity := resource.NewMilliQuantity(defaultCPU, resource.DecimalSI).String()
+		maximumQuantity := resource.NewMilliQuantity(maximumCPU, resource.DecimalSI).String()
+
+		return fmt.Errorf("invalid cpu args: workload-cpu-default set to %s which exceeds the workload-cpu-maximum of %s",
+			defaultQuantity, maximumQuantity)
+	}
+	return nil
+}
+
 func TraefikResources() (result []kclient.Object, _ error) {
 	objs, err := objectsFromFile("traefik.yaml")
 	if err != nil {
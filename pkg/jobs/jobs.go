@@ -8,6 +8,7 @@ import (
 
 	"github.com/acorn-io/baaah/pkg/name"
 	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/appdefinition"
 	"github.com/acorn-io/runtime/pkg/encryption/nacl"
@@ -141,9 +142,72 @@ func GetEvent(jobName string, appInstance *v1.AppInstance) string {
 	if appInstance.Spec.Stop != nil && *appInstance.Spec.Stop {
 		return "stop"
 	}
+
+	event := "update"
 	if appInstance.Generation <= 1 || slices.Contains(appInstance.Status.AppSpec.Jobs[jobName].Events, "create") && !appInstance.Status.AppStatus.Jobs[jobName].CreateEventSucceeded {
 		// Create event jobs run at least once. So, if it hasn't succeeded, run it.
-		return "create"
+		event = "create"
+	}
+
+	events := appInstance.Status.AppSpec.Jobs[jobName].Events
+	if event == "update" && slices.Contains(events, "pre-update") {
+		// Pre-update jobs run before the containers are updated, see PreUpdateDependencies
+		return "pre-update"
+	}
+	if !slices.Contains(events, event) && slices.Contains(events, "post-deploy") {
+		// Post-deploy jobs run after the containers are deployed, see PostDeployDependencies
+		return "post-deploy"
+	}
+	return event
+}
+
+// PreUpdateDependencies returns the jobs that run before the given container of the app is updated. The container
+// depends on these jobs, so its new version is only rolled out once all of them succeeded. Jobs that depend on the
+// container, directly or through other containers and jobs, are left out because they could never run.
+func PreUpdateDependencies(appInstance *v1.AppInstance, containerName string) (result []v1.Dependency) {
+	for _, jobName := range typed.SortedKeys(appInstance.Status.AppSpec.Jobs) {
+		if GetEvent(jobName, appInstance) == "pre-update" && !dependsOn(appInstance.Status.AppSpec, jobName, containerName) {
+			result = append(result, v1.Dependency{TargetName: jobName})
+		}
+	}
+	return result
+}
+
+// dependsOn returns true if the container or job named from depends on target, directly or transitively
+func dependsOn(appSpec v1.AppSpec, from, target string) bool {
+	var (
+		seen  = map[string]bool{from: true}
+		queue = []string{from}
+	)
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		dependencies := appSpec.Containers[next].Dependencies
+		if job, ok := appSpec.Jobs[next]; ok {
+			dependencies = job.Dependencies
+		}
+		for _, dep := range dependencies {
+			if dep.TargetName == target {
+				return true
+			}
+			if !seen[dep.TargetName] {
+				seen[dep.TargetName] = true
+				queue = append(queue, dep.TargetName)
+			}
+		}
+	}
+	return false
+}
+
+// PostDeployDependencies returns the dependencies of a post-deploy job, which are all the containers of the app.
+// The job runs once they are all ready, and the app isn't ready until the job succeeded. Containers that depend on the
+// job, directly or through other containers and jobs, are left out because the job could never run.
+func PostDeployDependencies(appInstance *v1.AppInstance, jobName string) (result []v1.Dependency) {
+	for _, containerName := range typed.SortedKeys(appInstance.Status.AppSpec.Containers) {
+		if !dependsOn(appInstance.Status.AppSpec, containerName, jobName) {
+			result = append(result, v1.Dependency{TargetName: containerName})
+		}
 	}
-	return "update"
+	return result
 }
@@ -0,0 +1,141 @@
+package jobs
+
+import (
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetEvent(t *testing.T) {
+	now := metav1.Now()
+	stop := true
+
+	for _, tt := range []struct {
+		name       string
+		events     []string
+		generation int64
+		stop       *bool
+		deleting   bool
+		expected   string
+	}{
+		{name: "create", generation: 1, expected: "create"},
+		{name: "update", generation: 2, expected: "update"},
+		{name: "stop", generation: 2, stop: &stop, expected: "stop"},
+		{name: "delete", generation: 2, deleting: true, expected: "delete"},
+		{name: "pre-update on create", events: []string{"pre-update"}, generation: 1, expected: "create"},
+		{name: "pre-update on update", events: []string{"pre-update"}, generation: 2, expected: "pre-update"},
+		{name: "pre-update on stop", events: []string{"pre-update"}, generation: 2, stop: &stop, expected: "stop"},
+		{name: "post-deploy on create", events: []string{"post-deploy"}, generation: 1, expected: "post-deploy"},
+		{name: "post-deploy on update", events: []string{"post-deploy"}, generation: 2, expected: "post-deploy"},
+		{name: "create and post-deploy on create", events: []string{"create", "post-deploy"}, generation: 1, expected: "create"},
+		{name: "create and post-deploy on update", events: []string{"create", "post-deploy"}, generation: 2, expected: "post-deploy"},
+		{name: "post-deploy on delete", events: []string{"post-deploy"}, generation: 2, deleting: true, expected: "delete"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &v1.AppInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: tt.generation,
+				},
+				Spec: v1.AppInstanceSpec{
+					Stop: tt.stop,
+				},
+				Status: v1.AppInstanceStatus{
+					AppSpec: v1.AppSpec{
+						Jobs: map[string]v1.Container{
+							"job": {Events: tt.events},
+						},
+					},
+					AppStatus: v1.AppStatus{
+						Jobs: map[string]v1.JobStatus{
+							"job": {CreateEventSucceeded: tt.generation > 1},
+						},
+					},
+				},
+			}
+			if tt.deleting {
+				app.DeletionTimestamp = &now
+			}
+			assert.Equal(t, tt.expected, GetEvent("job", app))
+		})
+	}
+}
+
+func TestPreUpdateDependencies(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 2,
+		},
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Jobs: map[string]v1.Container{
+					"seed":     {Events: []string{"create"}},
+					"migrate":  {Events: []string{"pre-update"}},
+					"backup":   {Events: []string{"create", "pre-update"}},
+					"defaults": {},
+				},
+			},
+			AppStatus: v1.AppStatus{
+				Jobs: map[string]v1.JobStatus{
+					"backup": {CreateEventSucceeded: true},
+				},
+			},
+		},
+	}
+	assert.Equal(t, []v1.Dependency{{TargetName: "backup"}, {TargetName: "migrate"}}, PreUpdateDependencies(app, "web"))
+
+	app.Generation = 1
+	assert.Empty(t, PreUpdateDependencies(app, "web"))
+}
+
+func TestPreUpdateDependenciesSkipsDependentJobs(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 2,
+		},
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"db":  {},
+					"api": {Dependencies: v1.Dependencies{{TargetName: "db"}}},
+					"web": {},
+				},
+				Jobs: map[string]v1.Container{
+					// migrate needs the db to be running, so it can't gate the update of db
+					"migrate": {Events: []string{"pre-update"}, Dependencies: v1.Dependencies{{TargetName: "db"}}},
+					// reindex needs db through api
+					"reindex": {Events: []string{"pre-update"}, Dependencies: v1.Dependencies{{TargetName: "api"}}},
+				},
+			},
+		},
+	}
+
+	assert.Empty(t, PreUpdateDependencies(app, "db"))
+	assert.Equal(t, []v1.Dependency{{TargetName: "migrate"}}, PreUpdateDependencies(app, "api"))
+	assert.Equal(t, []v1.Dependency{{TargetName: "migrate"}, {TargetName: "reindex"}}, PreUpdateDependencies(app, "web"))
+}
+
+func TestPostDeployDependenciesSkipsDependentContainers(t *testing.T) {
+	app := &v1.AppInstance{
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"db": {},
+					// web waits for the smoke test, so the smoke test can't wait for web
+					"web": {Dependencies: v1.Dependencies{{TargetName: "smoke-test"}}},
+					// worker waits for the smoke test through web
+					"worker": {Dependencies: v1.Dependencies{{TargetName: "web"}}},
+				},
+				Jobs: map[string]v1.Container{
+					"smoke-test": {Events: []string{"post-deploy"}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []v1.Dependency{{TargetName: "db"}}, PostDeployDependencies(app, "smoke-test"))
+
+	app.Status.AppSpec.Containers["web"] = v1.Container{}
+	assert.Equal(t, []v1.Dependency{{TargetName: "db"}, {TargetName: "web"}, {TargetName: "worker"}}, PostDeployDependencies(app, "smoke-test"))
+}
@@ -0,0 +1,87 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	batchv1 "k8s.io/api/batch/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ManualRunAnnotation is set to ManualRun on the runs of scheduled jobs that were started on demand. It is the
+	// annotation Kubernetes sets on jobs created from a cron job with kubectl.
+	ManualRunAnnotation = "cronjob.kubernetes.io/instantiate"
+	ManualRun           = "manual"
+)
+
+// Run starts a run of a scheduled job of the app now, outside its schedule, and returns the name of the run. The name
+// has a random suffix, so runs started within the same second don't collide. The run is owned by the cron job of the
+// job, so it counts towards its history. If the job forbids concurrent runs, no run is started while another one is
+// active. If it replaces concurrent runs, the active runs are deleted first.
+func Run(ctx context.Context, c kclient.Client, app *v1.AppInstance, jobName string, now time.Time) (string, error) {
+	job, ok := app.Status.AppSpec.Jobs[jobName]
+	if !ok {
+		return "", fmt.Errorf("app %s has no job %s", app.Name, jobName)
+	}
+	if job.Schedule == "" {
+		return "", fmt.Errorf("job %s of app %s is not scheduled, it only runs on app events", jobName, app.Name)
+	}
+
+	cronJob := &batchv1.CronJob{}
+	if err := c.Get(ctx, router.Key(app.Status.Namespace, jobName), cronJob); apierror.IsNotFound(err) {
+		return "", fmt.Errorf("job %s of app %s is not deployed yet", jobName, app.Name)
+	} else if err != nil {
+		return "", err
+	}
+
+	switch cronJob.Spec.ConcurrencyPolicy {
+	case batchv1.ForbidConcurrent:
+		if len(cronJob.Status.Active) > 0 {
+			return "", fmt.Errorf("job %s of app %s forbids concurrent runs and %s is still active", jobName, app.Name, cronJob.Status.Active[0].Name)
+		}
+	case batchv1.ReplaceConcurrent:
+		// Like a scheduled run, the new run replaces the active runs
+		for _, active := range cronJob.Status.Active {
+			namespace := active.Namespace
+			if namespace == "" {
+				namespace = cronJob.Namespace
+			}
+			if err := c.Delete(ctx, &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      active.Name,
+					Namespace: namespace,
+				},
+			}, kclient.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierror.IsNotFound(err) {
+				return "", err
+			}
+		}
+	}
+
+	run := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name.SafeConcatName(jobName, "manual", strconv.FormatInt(now.Unix(), 10), rand.String(5)),
+			Namespace:   cronJob.Namespace,
+			Labels:      labels.Merge(cronJob.Spec.JobTemplate.Labels, nil),
+			Annotations: labels.Merge(cronJob.Spec.JobTemplate.Annotations, map[string]string{ManualRunAnnotation: ManualRun}),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+
+	if err := c.Create(ctx, run); err != nil {
+		return "", err
+	}
+	return run.Name, nil
+}
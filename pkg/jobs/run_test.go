@@ -0,0 +1,179 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newCronJob(concurrency batchv1.ConcurrencyPolicy, active ...string) *batchv1.CronJob {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "app-created-namespace",
+			UID:       "cron-uid",
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:          "@hourly",
+			ConcurrencyPolicy: concurrency,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						labels.AcornManaged: "true",
+						labels.AcornJobName: "backup",
+					},
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "backup", Image: "image-name"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, name := range active {
+		cronJob.Status.Active = append(cronJob.Status.Active, corev1.ObjectReference{Name: name})
+	}
+	return cronJob
+}
+
+func newApp() *v1.AppInstance {
+	return &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-name",
+			Namespace: "app-namespace",
+		},
+		Status: v1.AppInstanceStatus{
+			Namespace: "app-created-namespace",
+			AppSpec: v1.AppSpec{
+				Jobs: map[string]v1.Container{
+					"backup":  {Image: "image-name", Schedule: "hourly"},
+					"migrate": {Image: "image-name"},
+				},
+			},
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	now := time.Unix(1683000000, 0)
+	app := newApp()
+
+	req := tester.NewRequest(t, scheme.Scheme, app, newCronJob(batchv1.ForbidConcurrent))
+	c := req.Client.(*tester.Client)
+
+	runName, err := Run(context.Background(), c, app, "backup", now)
+	require.NoError(t, err)
+	assert.Regexp(t, "^backup-manual-1683000000-[a-z0-9]{5}$", runName)
+
+	require.Len(t, c.Created, 1)
+	run := c.Created[0].(*batchv1.Job)
+	assert.Equal(t, "app-created-namespace", run.Namespace)
+	assert.Equal(t, ManualRun, run.Annotations[ManualRunAnnotation])
+	assert.Equal(t, "backup", run.Labels[labels.AcornJobName])
+	assert.Equal(t, "image-name", run.Spec.Template.Spec.Containers[0].Image)
+	require.Len(t, run.OwnerReferences, 1)
+	assert.Equal(t, "CronJob", run.OwnerReferences[0].Kind)
+	assert.Equal(t, "backup", run.OwnerReferences[0].Name)
+}
+
+func TestRunErrors(t *testing.T) {
+	now := time.Unix(1683000000, 0)
+	app := newApp()
+
+	for _, tt := range []struct {
+		name    string
+		job     string
+		cronJob *batchv1.CronJob
+		err     string
+	}{
+		{
+			name: "unknown job",
+			job:  "restore",
+			err:  "app app-name has no job restore",
+		},
+		{
+			name: "not scheduled",
+			job:  "migrate",
+			err:  "job migrate of app app-name is not scheduled, it only runs on app events",
+		},
+		{
+			name: "not deployed",
+			job:  "backup",
+			err:  "job backup of app app-name is not deployed yet",
+		},
+		{
+			name:    "forbidden concurrent run",
+			job:     "backup",
+			cronJob: newCronJob(batchv1.ForbidConcurrent, "backup-28050000"),
+			err:     "job backup of app app-name forbids concurrent runs and backup-28050000 is still active",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tester.NewRequest(t, scheme.Scheme, app)
+			if tt.cronJob != nil {
+				req = tester.NewRequest(t, scheme.Scheme, app, tt.cronJob)
+			}
+			c := req.Client.(*tester.Client)
+
+			_, err := Run(context.Background(), c, app, tt.job, now)
+			assert.EqualError(t, err, tt.err)
+			assert.Empty(t, c.Created)
+		})
+	}
+}
+
+func TestRunAllowsConcurrentRuns(t *testing.T) {
+	app := newApp()
+	req := tester.NewRequest(t, scheme.Scheme, app, newCronJob(batchv1.AllowConcurrent, "backup-28050000"))
+	c := req.Client.(*tester.Client)
+
+	_, err := Run(context.Background(), c, app, "backup", time.Unix(1683000000, 0))
+	require.NoError(t, err)
+	assert.Len(t, c.Created, 1)
+}
+
+func TestRunTwiceInOneSecond(t *testing.T) {
+	now := time.Unix(1683000000, 0)
+	app := newApp()
+	req := tester.NewRequest(t, scheme.Scheme, app, newCronJob(batchv1.AllowConcurrent))
+	c := req.Client.(*tester.Client)
+
+	first, err := Run(context.Background(), c, app, "backup", now)
+	require.NoError(t, err)
+	second, err := Run(context.Background(), c, app, "backup", now)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func TestRunReplacesActiveRuns(t *testing.T) {
+	app := newApp()
+	active := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup-28050000",
+			Namespace: "app-created-namespace",
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newCronJob(batchv1.ReplaceConcurrent, active.Name), active).Build()
+
+	runName, err := Run(context.Background(), c, app, "backup", time.Unix(1683000000, 0))
+	require.NoError(t, err)
+
+	jobs := &batchv1.JobList{}
+	require.NoError(t, c.List(context.Background(), jobs))
+	require.Len(t, jobs.Items, 1)
+	assert.Equal(t, runName, jobs.Items[0].Name)
+}
@@ -17,6 +17,8 @@ const (
 	AcornAppName                           = Prefix + "app-name"
 	AcornParentAcornName                   = Prefix + "parent-acorn-name"
 	AcornAppPublicName                     = Prefix + "app-public-name"
+	AcornAppUpdatedBy                      = Prefix + "app-updated-by"
+	AcornAppChangeCause                    = Prefix + "app-change-cause"
 	AcornPublicName                        = Prefix + "public-name"
 	AcornAcornName                         = Prefix + "acorn-name"
 	AcornServiceName                       = Prefix + "service-name"
@@ -26,10 +28,21 @@ const (
 	AcornAppUID                            = Prefix + "app-uid"
 	AcornVolumeName                        = Prefix + "volume-name"
 	AcornVolumeClass                       = Prefix + "volume-class"
+	AcornVolumeSnapshotName                = Prefix + "volume-snapshot-name"
 	AcornSecretName                        = Prefix + "secret-name"
 	AcornSecretSourceName                  = Prefix + "secret-source-name"
 	AcornSecretGenerated                   = Prefix + "secret-generated"
+	AcornSecretRefreshedAt                 = Prefix + "secret-refreshed-at"
+	AcornSecretParamsHash                  = Prefix + "secret-params-hash"
+	AcornSecretRefreshFailures             = Prefix + "secret-refresh-failures"
+	AcornSecretRefreshFailedAt             = Prefix + "secret-refresh-failed-at"
+	AcornSecretRotatedAt                   = Prefix + "secret-rotated-at"
+	AcornSecretRotation                    = Prefix + "secret-rotation"
+	AcornSecretRotateRequested             = Prefix + "secret-rotate-requested"
+	AcornSecretPreviousExpires             = Prefix + "secret-previous-expires"
 	AcornContainerName                     = Prefix + "container-name"
+	AcornRolloutRevision                   = Prefix + "rollout-revision"
+	AcornRolloutProxy                      = Prefix + "rollout-proxy"
 	AcornRouterName                        = Prefix + "router-name"
 	AcornJobName                           = Prefix + "job-name"
 	AcornAppImage                          = Prefix + "app-image"
@@ -42,6 +55,7 @@ const (
 	AcornCredential                        = Prefix + "credential"
 	AcornPullSecret                        = Prefix + "pull-secret"
 	AcornSecretRevPrefix                   = "secret-rev." + Prefix
+	AcornSecretRotationPrefix              = "secret-rotation." + Prefix
 	AcornPublishURL                        = Prefix + "publish-url"
 	AcornTargets                           = Prefix + "targets"
 	AcornDNSHash                           = Prefix + "dns-hash"
@@ -55,6 +69,9 @@ const (
 	AcornProjectName                       = Prefix + "project-name"
 	AcornProjectDefaultRegion              = Prefix + "project-default-region"
 	AcornProjectSupportedRegions           = Prefix + "project-supported-regions"
+	AcornProjectLogSink                    = Prefix + "project-log-sink"
+	AcornLogForwarder                      = Prefix + "log-forwarder"
+	AcornEventDeliveries                   = Prefix + "event-deliveries"
 	AcornCalculatedProjectDefaultRegion    = Prefix + "calculated-project-default-region"
 	AcornCalculatedProjectSupportedRegions = Prefix + "calculated-project-supported-regions"
 	ProjectEnforcedQuotaAnnotation         = Prefix + "enforced-quota"
@@ -0,0 +1,179 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+)
+
+// Filter selects the log messages that are sent to the client
+type Filter struct {
+	since   time.Time
+	until   time.Time
+	grep    *regexp.Regexp
+	exclude *regexp.Regexp
+	where   []condition
+}
+
+type condition struct {
+	key    string
+	value  string
+	negate bool
+}
+
+func (c condition) matches(fields map[string]string) bool {
+	value, ok := fields[c.key]
+	if c.negate {
+		return !ok || value != c.value
+	}
+	return ok && value == c.value
+}
+
+// NewFilter validates the filters of the options. Relative times in since and until are relative to now.
+func NewFilter(opts *apiv1.LogOptions, now time.Time) (*Filter, error) {
+	var (
+		filter = &Filter{}
+		err    error
+	)
+	if opts == nil {
+		return filter, nil
+	}
+
+	if filter.since, err = ParseTime(opts.Since, now); err != nil {
+		return nil, fmt.Errorf("invalid since [%s]: %w", opts.Since, err)
+	}
+	if filter.until, err = ParseTime(opts.Until, now); err != nil {
+		return nil, fmt.Errorf("invalid until [%s]: %w", opts.Until, err)
+	}
+	if opts.Follow && opts.Until != "" {
+		// The stream of a followed container never ends, so there is no point at which the lines past until are done
+		return nil, fmt.Errorf("invalid until [%s]: can not be combined with follow", opts.Until)
+	}
+	if opts.Grep != "" {
+		if filter.grep, err = regexp.Compile(opts.Grep); err != nil {
+			return nil, fmt.Errorf("invalid grep [%s]: %w", opts.Grep, err)
+		}
+	}
+	if opts.Exclude != "" {
+		if filter.exclude, err = regexp.Compile(opts.Exclude); err != nil {
+			return nil, fmt.Errorf("invalid exclude [%s]: %w", opts.Exclude, err)
+		}
+	}
+	for _, where := range opts.Where {
+		key, value, ok := strings.Cut(where, "=")
+		if !ok || key == "" || key == "!" {
+			return nil, fmt.Errorf("invalid where [%s]: must be in the form key=value or key!=value", where)
+		}
+		cond := condition{
+			key:   key,
+			value: value,
+		}
+		if strings.HasSuffix(key, "!") {
+			cond.key, cond.negate = strings.TrimSuffix(key, "!"), true
+		}
+		filter.where = append(filter.where, cond)
+	}
+
+	return filter, nil
+}
+
+// Since returns the time before which no lines are returned, or nil if it is not set
+func (f *Filter) Since() *time.Time {
+	if f.since.IsZero() {
+		return nil
+	}
+	return &f.since
+}
+
+// FiltersLines returns true if the filter drops lines other than those before since
+func (f *Filter) FiltersLines() bool {
+	return f != nil && (!f.until.IsZero() || f.grep != nil || f.exclude != nil || len(f.where) > 0)
+}
+
+// Matches returns true if the message should be returned. Messages that carry an error always match.
+func (f *Filter) Matches(msg apiv1.LogMessage) bool {
+	if msg.Error != "" {
+		return true
+	}
+	if !f.since.IsZero() && !msg.Time.IsZero() && msg.Time.Time.Before(f.since) {
+		return false
+	}
+	if !f.until.IsZero() && msg.Time.Time.After(f.until) {
+		return false
+	}
+	if f.grep != nil && !f.grep.MatchString(msg.Line) {
+		return false
+	}
+	if f.exclude != nil && f.exclude.MatchString(msg.Line) {
+		return false
+	}
+	if len(f.where) > 0 {
+		fields := Fields(msg.Line)
+		for _, cond := range f.where {
+			if !cond.matches(fields) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ParseTime parses a RFC3339 timestamp or a duration like 42m that is subtracted from now. The zero time is
+// returned for an empty string.
+func ParseTime(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("must be a duration (e.g. 42m) or a RFC3339 timestamp (e.g. 2006-01-02T15:04:05Z)")
+	}
+	return t, nil
+}
+
+// Fields returns the fields of a line that is a JSON object. Nested objects are flattened with dots in the key,
+// like http.status. Nil is returned for lines that are not JSON objects.
+func Fields(line string) map[string]string {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "{") {
+		return nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(line))
+	decoder.UseNumber()
+	obj := map[string]any{}
+	if err := decoder.Decode(&obj); err != nil {
+		return nil
+	}
+
+	result := map[string]string{}
+	flatten("", obj, result)
+	return result
+}
+
+func flatten(prefix string, obj map[string]any, result map[string]string) {
+	for k, v := range obj {
+		key := prefix + k
+		switch value := v.(type) {
+		case map[string]any:
+			flatten(key+".", value, result)
+		case string:
+			result[key] = value
+		case nil:
+			result[key] = ""
+		case json.Number, bool:
+			result[key] = fmt.Sprint(value)
+		default:
+			if data, err := json.Marshal(value); err == nil {
+				result[key] = string(data)
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package log
+
+import (
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFields(t *testing.T) {
+	assert.Nil(t, Fields("plain text line"))
+	assert.Nil(t, Fields("{not json"))
+	assert.Equal(t, map[string]string{
+		"level":       "error",
+		"msg":         "failed",
+		"http.status": "500",
+		"retry":       "true",
+		"tags":        `["a","b"]`,
+		"user":        "",
+	}, Fields(`{"level":"error","msg":"failed","http":{"status":500},"retry":true,"tags":["a","b"],"user":null}`))
+}
+
+func TestFilter(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	msg := func(line string, ago time.Duration) apiv1.LogMessage {
+		return apiv1.LogMessage{
+			Line: line,
+			Time: metav1.NewTime(now.Add(-ago)),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		opts    apiv1.LogOptions
+		msg     apiv1.LogMessage
+		matches bool
+	}{
+		{
+			name:    "no filter",
+			msg:     msg("hello", 0),
+			matches: true,
+		},
+		{
+			name:    "grep",
+			opts:    apiv1.LogOptions{Grep: "time(out)?"},
+			msg:     msg("request timeout", 0),
+			matches: true,
+		},
+		{
+			name: "grep no match",
+			opts: apiv1.LogOptions{Grep: "timeout"},
+			msg:  msg("request done", 0),
+		},
+		{
+			name: "exclude",
+			opts: apiv1.LogOptions{Exclude: "healthz"},
+			msg:  msg("GET /healthz", 0),
+		},
+		{
+			name:    "since",
+			opts:    apiv1.LogOptions{Since: "10m"},
+			msg:     msg("hello", 5*time.Minute),
+			matches: true,
+		},
+		{
+			name: "before since",
+			opts: apiv1.LogOptions{Since: "10m"},
+			msg:  msg("hello", time.Hour),
+		},
+		{
+			name: "after until",
+			opts: apiv1.LogOptions{Until: "2023-05-01T11:00:00Z"},
+			msg:  msg("hello", 5*time.Minute),
+		},
+		{
+			name:    "before until",
+			opts:    apiv1.LogOptions{Until: "30m"},
+			msg:     msg("hello", time.Hour),
+			matches: true,
+		},
+		{
+			name:    "where",
+			opts:    apiv1.LogOptions{Where: []string{"level=error", "http.status!=404"}},
+			msg:     msg(`{"level":"error","http":{"status":500}}`, 0),
+			matches: true,
+		},
+		{
+			name: "where no match",
+			opts: apiv1.LogOptions{Where: []string{"level=error"}},
+			msg:  msg(`{"level":"info"}`, 0),
+		},
+		{
+			name: "where plain text",
+			opts: apiv1.LogOptions{Where: []string{"level=error"}},
+			msg:  msg("level=error", 0),
+		},
+		{
+			name:    "errors always match",
+			opts:    apiv1.LogOptions{Grep: "timeout"},
+			msg:     apiv1.LogMessage{Error: "failed to get logs"},
+			matches: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := NewFilter(&tt.opts, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matches, filter.Matches(tt.msg))
+		})
+	}
+}
+
+func TestNewFilterInvalid(t *testing.T) {
+	for _, opts := range []apiv1.LogOptions{
+		{Grep: "("},
+		{Exclude: "["},
+		{Since: "yesterday"},
+		{Until: "2023-05-01"},
+		{Where: []string{"level"}},
+		{Where: []string{"!=error"}},
+		{Until: "10m", Follow: true},
+	} {
+		_, err := NewFilter(&opts, time.Now())
+		assert.Error(t, err, "%+v", opts)
+	}
+}
+
+func TestFiltersLines(t *testing.T) {
+	for _, tt := range []struct {
+		opts     *apiv1.LogOptions
+		expected bool
+	}{
+		{opts: nil},
+		{opts: &apiv1.LogOptions{Since: "10m", Follow: true}},
+		{opts: &apiv1.LogOptions{Until: "10m"}, expected: true},
+		{opts: &apiv1.LogOptions{Grep: "timeout"}, expected: true},
+		{opts: &apiv1.LogOptions{Exclude: "healthz"}, expected: true},
+		{opts: &apiv1.LogOptions{Where: []string{"level=error"}}, expected: true},
+	} {
+		filter, err := NewFilter(tt.opts, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, filter.FiltersLines(), "%+v", tt.opts)
+	}
+}
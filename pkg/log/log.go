@@ -24,6 +24,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
 	v12 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
@@ -78,6 +79,9 @@ type Options struct {
 	Follow           bool
 	ContainerReplica string
 	Container        string
+	Since            *metav1.Time
+	// Filter selects the lines the tail is taken from, so the tail holds the last matching lines
+	Filter *Filter
 }
 
 func (o *Options) restConfig() (*rest.Config, error) {
@@ -97,6 +101,10 @@ func (o *Options) Complete() (*Options, error) {
 		o = &Options{}
 	}
 
+	if o.Tail != nil && *o.Tail < 0 {
+		return o, fmt.Errorf("tail must not be negative: %d", *o.Tail)
+	}
+
 	if o.PodClient == nil {
 		cfg, err := o.restConfig()
 		if err != nil {
@@ -124,7 +132,7 @@ func (o *Options) Complete() (*Options, error) {
 	return o, nil
 }
 
-func pipe(input io.ReadCloser, output chan<- Message, pod *corev1.Pod, name string, after *metav1.Time) (*metav1.Time, error) {
+func pipe(input io.ReadCloser, send func(Message), pod *corev1.Pod, name string, after *metav1.Time) (*metav1.Time, error) {
 	defer input.Close()
 
 	var lastTS *metav1.Time
@@ -148,17 +156,56 @@ func pipe(input io.ReadCloser, output chan<- Message, pod *corev1.Pod, name stri
 			continue
 		}
 
-		output <- Message{
+		send(Message{
 			Line:          newLine,
 			Pod:           pod,
 			ContainerName: name,
 			Time:          lastTS.Time,
-		}
+		})
 	}
 
 	return lastTS, scanner.Err()
 }
 
+// tailFiltered sends the last tail lines of the container that match the filter. Kubernetes would take the tail
+// before the lines are filtered, so the logs are read without it and only the matching lines are kept.
+func tailFiltered(ctx context.Context, pod *corev1.Pod, name string, output chan<- Message, options *Options, since *metav1.Time, tail int64) (*metav1.Time, error) {
+	if tail <= 0 {
+		return nil, nil
+	}
+
+	readCloser, err := options.PodClient.Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container:  name,
+		SinceTime:  since,
+		Timestamps: true,
+	}).Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// lines is a ring buffer of the last tail matching lines, next is the index the next line is written to
+	var (
+		lines []Message
+		next  int
+	)
+	lastTS, err := pipe(readCloser, func(msg Message) {
+		if !options.Filter.Matches(apiv1.LogMessage{Line: msg.Line, Time: metav1.NewTime(msg.Time)}) {
+			return
+		}
+		if int64(len(lines)) < tail {
+			lines = append(lines, msg)
+			return
+		}
+		lines[next] = msg
+		next = (next + 1) % len(lines)
+	}, pod, name, since)
+
+	for i := range lines {
+		output <- lines[(next+i)%len(lines)]
+	}
+	return lastTS, err
+}
+
 func Container(ctx context.Context, pod *corev1.Pod, name string, output chan<- Message, options *Options) (err error) {
 	options, err = options.Complete()
 	if err != nil {
@@ -167,10 +214,33 @@ func Container(ctx context.Context, pod *corev1.Pod, name string, output chan<-
 
 	var (
 		first = true
-		since *metav1.Time
+		since = options.Since
 		tail  = options.Tail
+		send  = func(msg Message) {
+			output <- msg
+		}
 	)
 
+	// A tail of 0 holds no lines, so Kubernetes can take it without reading the logs
+	if tail != nil && *tail > 0 && options.Filter.FiltersLines() {
+		lastTS, err := tailFiltered(ctx, pod, name, output, options, since, *tail)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			output <- Message{
+				Time:          time.Now(),
+				Pod:           pod,
+				ContainerName: name,
+				Err:           fmt.Errorf("failed to get logs for container %s on pod %s/%s: %v", name, pod.Namespace, pod.Name, err),
+			}
+		}
+		if !options.Follow {
+			return nil
+		}
+		if lastTS != nil {
+			since = lastTS
+		}
+		tail = nil
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -214,7 +284,7 @@ func Container(ctx context.Context, pod *corev1.Pod, name string, output chan<-
 			continue
 		}
 		// pipe will close the readCloser
-		lastTS, err := pipe(readCloser, output, pod, name, since)
+		lastTS, err := pipe(readCloser, send, pod, name, since)
 		if err != nil && !errors.Is(err, context.Canceled) {
 			output <- Message{
 				Time:          time.Now(),
@@ -357,14 +427,10 @@ func appNoFollow(ctx context.Context, app *apiv1.App, output chan<- Message, opt
 		return nil
 	}
 
-	podSelector := labels.SelectorFromSet(labels.Set{
-		applabels.AcornManaged: "true",
-	})
-
 	pods := &corev1.PodList{}
 	err := options.Client.List(ctx, pods, &client.ListOptions{
 		Namespace:     app.Status.Namespace,
-		LabelSelector: podSelector,
+		LabelSelector: podSelector(),
 	})
 	if err != nil {
 		return err
@@ -381,8 +447,10 @@ func appNoFollow(ctx context.Context, app *apiv1.App, output chan<- Message, opt
 
 	apps := &apiv1.AppList{}
 	err = options.Client.List(ctx, apps, &client.ListOptions{
-		Namespace:     app.Namespace,
-		LabelSelector: podSelector,
+		Namespace: app.Namespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			applabels.AcornManaged: "true",
+		}),
 	})
 	if err != nil {
 		return err
@@ -399,6 +467,18 @@ func appNoFollow(ctx context.Context, app *apiv1.App, output chan<- Message, opt
 	return nil
 }
 
+// podSelector selects the acorn managed pods of the containers and jobs of an app. The log forwarder is left out, so
+// it doesn't forward its own logs and they aren't mixed with the logs of the app.
+func podSelector() labels.Selector {
+	notForwarder, err := labels.NewRequirement(applabels.AcornLogForwarder, selection.DoesNotExist, nil)
+	if err != nil {
+		panic(err)
+	}
+	return labels.SelectorFromSet(labels.Set{
+		applabels.AcornManaged: "true",
+	}).Add(*notForwarder)
+}
+
 func matchesPod(pod *corev1.Pod, options *Options) bool {
 	if options == nil || options.ContainerReplica == "" {
 		return true
@@ -470,7 +550,6 @@ func App(ctx context.Context, app *apiv1.App, output chan<- Message, options *Op
 
 	var (
 		appWatcher = watcher.New[*apiv1.App](options.Client)
-		podWatcher = watcher.New[*corev1.Pod](options.Client)
 		watching   = watching{}
 	)
 
@@ -481,9 +560,6 @@ func App(ctx context.Context, app *apiv1.App, output chan<- Message, options *Op
 		return err
 	}
 
-	podSelector := labels.SelectorFromSet(labels.Set{
-		applabels.AcornManaged: "true",
-	})
 	parentSelector := labels.SelectorFromSet(labels.Set{
 		applabels.AcornParentAcornName: app.Name,
 	})
@@ -513,26 +589,7 @@ func App(ctx context.Context, app *apiv1.App, output chan<- Message, options *Op
 	})
 	eg.Go(func() error {
 		defer cancel()
-		_, err := podWatcher.BySelector(ctx, app.Status.Namespace, podSelector, func(pod *corev1.Pod) (bool, error) {
-			if !matchesPod(pod, options) {
-				return false, nil
-			}
-			if watching.shouldWatch("Pod", pod.Namespace, pod.Name) {
-				eg.Go(func() error {
-					err := Pod(ctx, pod, output, options)
-					if err != nil {
-						output <- Message{
-							Pod:  pod,
-							Time: time.Now(),
-							Err:  err,
-						}
-					}
-					return err
-				})
-			}
-			return false, nil
-		})
-		return err
+		return watchPods(ctx, &eg, app.Status.Namespace, output, options, &watching)
 	})
 	eg.Go(func() error {
 		defer cancel()
@@ -549,3 +606,55 @@ func App(ctx context.Context, app *apiv1.App, output chan<- Message, options *Op
 
 	return err
 }
+
+// watchPods follows the logs of every acorn managed pod in the namespace. The pods are followed in the error group.
+func watchPods(ctx context.Context, eg *errgroup.Group, namespace string, output chan<- Message, options *Options, watching *watching) error {
+	podWatcher := watcher.New[*corev1.Pod](options.Client)
+	_, err := podWatcher.BySelector(ctx, namespace, podSelector(), func(pod *corev1.Pod) (bool, error) {
+		if !matchesPod(pod, options) {
+			return false, nil
+		}
+		if watching.shouldWatch("Pod", pod.Namespace, pod.Name) {
+			eg.Go(func() error {
+				err := Pod(ctx, pod, output, options)
+				if err != nil {
+					output <- Message{
+						Pod:  pod,
+						Time: time.Now(),
+						Err:  err,
+					}
+				}
+				return err
+			})
+		}
+		return false, nil
+	})
+	return err
+}
+
+// Namespace follows the logs of all acorn managed pods in the namespace until the context is canceled. Unlike App it
+// does not follow the logs of nested apps, they run in their own namespace.
+func Namespace(ctx context.Context, namespace string, output chan<- Message, options *Options) error {
+	options, err := options.Complete()
+	if err != nil {
+		return err
+	}
+	follow := *options
+	follow.Follow = true
+	options = &follow
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	eg := errgroup.Group{}
+	eg.Go(func() error {
+		defer cancel()
+		return watchPods(ctx, &eg, namespace, output, options, &watching{})
+	})
+
+	err = eg.Wait()
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
@@ -1,12 +1,17 @@
 package log
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 var (
@@ -198,3 +203,48 @@ func TestMatchesContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestPodSelector(t *testing.T) {
+	assert.True(t, podSelector().Matches(klabels.Set(appWithLinkerdProxy.Labels)))
+	assert.False(t, podSelector().Matches(klabels.Set{
+		labels.AcornManaged:      "true",
+		labels.AcornAppName:      "app-name",
+		labels.AcornLogForwarder: "true",
+	}))
+}
+
+func TestTailFiltered(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod",
+			Namespace: "app-namespace",
+		},
+	}
+	filter, err := NewFilter(&apiv1.LogOptions{Grep: "fake"}, time.Now())
+	if !assert.NoError(t, err) {
+		return
+	}
+	options := &Options{
+		PodClient: fake.NewSimpleClientset().CoreV1(),
+		Filter:    filter,
+	}
+
+	for _, tail := range []int64{0, 1, 2} {
+		output := make(chan Message, 10)
+		_, err := tailFiltered(context.Background(), pod, "container", output, options, nil, tail)
+		close(output)
+		if !assert.NoError(t, err) {
+			continue
+		}
+
+		var lines []string
+		for msg := range output {
+			lines = append(lines, msg.Line)
+		}
+		if tail == 0 {
+			assert.Empty(t, lines)
+		} else {
+			assert.Equal(t, []string{"fake logs"}, lines)
+		}
+	}
+}
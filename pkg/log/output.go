@@ -2,6 +2,8 @@ package log
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -9,6 +11,8 @@ import (
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/pterm/pterm"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -29,12 +33,59 @@ func nextColor() pterm.Color {
 	return c
 }
 
+// OutputFormatJSON prints every log message as a JSON object on its own line
+const OutputFormatJSON = "json"
+
+type jsonMessage struct {
+	Time      metav1.Time       `json:"time"`
+	App       string            `json:"app,omitempty"`
+	Container string            `json:"container,omitempty"`
+	Replica   string            `json:"replica,omitempty"`
+	Line      string            `json:"line"`
+	Fields    map[string]string `json:"fields,omitempty"`
+}
+
 func Output(ctx context.Context, c client.Client, name string, opts *client.LogOptions) error {
-	msgs, err := c.AppLog(ctx, name, opts)
-	if err != nil {
-		return err
+	return OutputApps(ctx, c, []string{name}, "", opts)
+}
+
+// OutputApps prints the logs of all apps or container replicas in names. The logs are printed with a colored prefix
+// per container replica or, if format is json, as JSON objects.
+func OutputApps(ctx context.Context, c client.Client, names []string, format string, opts *client.LogOptions) error {
+	if opts == nil {
+		opts = &client.LogOptions{}
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		eg, egCtx = errgroup.WithContext(ctx)
+		msgs      = make(chan v1.LogMessage)
+	)
+	for _, name := range names {
+		// AppLog sets the container replica of the options, so every name gets its own copy
+		nameOpts := *opts
+		appMsgs, err := c.AppLog(egCtx, name, &nameOpts)
+		if err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			for msg := range appMsgs {
+				select {
+				case msgs <- msg:
+				case <-egCtx.Done():
+					return egCtx.Err()
+				}
+			}
+			return nil
+		})
+	}
+	go func() {
+		_ = eg.Wait()
+		close(msgs)
+	}()
+
 	containerColors := map[string]pterm.Color{}
 
 	for msg := range msgs {
@@ -42,19 +93,39 @@ func Output(ctx context.Context, c client.Client, name string, opts *client.LogO
 		if err != nil {
 			return err
 		}
-		if result {
-			if msg.Error == "" {
-				color, ok := containerColors[msg.ContainerName]
-				if !ok {
-					color = nextColor()
-					containerColors[msg.ContainerName] = color
-				}
-
-				pterm.Printf("%s: %s\n", color.Sprint(msg.ContainerName), msg.Line)
-			} else if !strings.Contains(msg.Error, "context canceled") {
+		if !result {
+			continue
+		}
+		if msg.Error != "" {
+			if !strings.Contains(msg.Error, "context canceled") {
 				logrus.Error(msg.Error)
 			}
+			continue
 		}
+
+		if format == OutputFormatJSON {
+			data, err := json.Marshal(jsonMessage{
+				Time:      msg.Time,
+				App:       msg.AppName,
+				Container: msg.Container,
+				Replica:   msg.ContainerName,
+				Line:      msg.Line,
+				Fields:    Fields(msg.Line),
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(data))
+			continue
+		}
+
+		color, ok := containerColors[msg.ContainerName]
+		if !ok {
+			color = nextColor()
+			containerColors[msg.ContainerName] = color
+		}
+
+		pterm.Printf("%s: %s\n", color.Sprint(msg.ContainerName), msg.Line)
 	}
 
 	return nil
@@ -64,9 +135,9 @@ func SinceLogCheck(since string, msg v1.LogMessage) (bool, error) {
 	if since == "" {
 		return true, nil
 	}
-	beforeTime, err := time.ParseDuration(since)
+	sinceTime, err := ParseTime(since, time.Now())
 	if err != nil {
 		return false, err
 	}
-	return msg.Time.After(time.Now().Add(-beforeTime)), nil
+	return msg.Time.After(sinceTime), nil
 }
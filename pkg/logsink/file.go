@@ -0,0 +1,44 @@
+package logsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+type fileSink struct {
+	file *os.File
+}
+
+func newFileSink(volumeDir, path string) (*fileSink, error) {
+	// Clean the path as an absolute path first, so it can't point outside of the volume
+	path = filepath.Join(volumeDir, filepath.Clean("/"+path))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{
+		file: f,
+	}, nil
+}
+
+func (f *fileSink) Send(_ context.Context, entries []Entry) error {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+	_, err := f.file.Write(buf.Bytes())
+	return err
+}
+
+func (f *fileSink) Close() error {
+	return f.file.Close()
+}
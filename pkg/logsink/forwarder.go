@@ -0,0 +1,177 @@
+package logsink
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/runtime/pkg/log"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	DefaultBufferSize    = 10_000
+	DefaultBatchSize     = 100
+	DefaultFlushInterval = time.Second
+
+	maxRetryInterval = 30 * time.Second
+	closeTimeout     = 5 * time.Second
+)
+
+// Forwarder buffers entries and sends them to a sink in batches. A batch that fails is retried with a backoff until it
+// is delivered. If the buffer is full because the sink is not available the oldest entries are dropped.
+type Forwarder struct {
+	BufferSize    int
+	BatchSize     int
+	FlushInterval time.Duration
+
+	sink    Sink
+	lock    sync.Mutex
+	buffer  []Entry
+	dropped int
+	ready   chan struct{}
+}
+
+func NewForwarder(sink Sink) *Forwarder {
+	return &Forwarder{
+		BufferSize:    DefaultBufferSize,
+		BatchSize:     DefaultBatchSize,
+		FlushInterval: DefaultFlushInterval,
+		sink:          sink,
+		ready:         make(chan struct{}, 1),
+	}
+}
+
+// Add buffers the entry until it is sent by Run
+func (f *Forwarder) Add(entry Entry) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if len(f.buffer) >= f.BufferSize {
+		f.buffer = f.buffer[1:]
+		f.dropped++
+	}
+	f.buffer = append(f.buffer, entry)
+
+	if len(f.buffer) >= f.BatchSize {
+		select {
+		case f.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (f *Forwarder) next() (batch []Entry, dropped int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	n := len(f.buffer)
+	if n > f.BatchSize {
+		n = f.BatchSize
+	}
+	batch = append(batch, f.buffer[:n]...)
+	f.buffer = f.buffer[n:]
+
+	dropped, f.dropped = f.dropped, 0
+	return batch, dropped
+}
+
+// Run sends the buffered entries to the sink until the context is canceled. The entries that are left are sent one
+// last time before the sink is closed.
+func (f *Forwarder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(f.FlushInterval)
+	defer ticker.Stop()
+
+	var (
+		batch []Entry
+		retry = f.FlushInterval
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return f.close(batch)
+		case <-ticker.C:
+		case <-f.ready:
+		}
+
+		for {
+			if len(batch) == 0 {
+				var dropped int
+				batch, dropped = f.next()
+				if dropped > 0 {
+					logrus.Warnf("Dropped %d log entries because the log sink is not keeping up", dropped)
+				}
+				if len(batch) == 0 {
+					break
+				}
+			}
+
+			if err := f.sink.Send(ctx, batch); err != nil {
+				logrus.Warnf("Failed to send %d log entries, retrying in %s: %v", len(batch), retry, err)
+				select {
+				case <-ctx.Done():
+					return f.close(batch)
+				case <-time.After(retry):
+				}
+				retry *= 2
+				if retry > maxRetryInterval {
+					retry = maxRetryInterval
+				}
+				continue
+			}
+
+			batch = nil
+			retry = f.FlushInterval
+		}
+	}
+}
+
+func (f *Forwarder) close(batch []Entry) error {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	for {
+		if len(batch) > 0 {
+			if err := f.sink.Send(ctx, batch); err != nil {
+				logrus.Warnf("Failed to send %d log entries before closing the log sink: %v", len(batch), err)
+				break
+			}
+		}
+		if batch, _ = f.next(); len(batch) == 0 {
+			break
+		}
+	}
+
+	return f.sink.Close()
+}
+
+// Forward sends the logs of all containers, jobs and sidecars in the namespace to the sink until the context is
+// canceled
+func Forward(ctx context.Context, namespace string, sink Sink, options *log.Options) error {
+	var (
+		forwarder = NewForwarder(sink)
+		msgs      = make(chan log.Message)
+		eg, egCtx = errgroup.WithContext(ctx)
+	)
+
+	eg.Go(func() error {
+		defer close(msgs)
+		return log.Namespace(egCtx, namespace, msgs, options)
+	})
+	eg.Go(func() error {
+		for msg := range msgs {
+			if msg.Err != nil {
+				logrus.Warnf("Failed to read logs: %v", msg.Err)
+				continue
+			}
+			forwarder.Add(toEntry(msg))
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		return forwarder.Run(egCtx)
+	})
+
+	return eg.Wait()
+}
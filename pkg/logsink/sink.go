@@ -0,0 +1,63 @@
+package logsink
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/log"
+)
+
+// Entry is a log line with the names of the project, app and container that produced it
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Project   string    `json:"project,omitempty"`
+	App       string    `json:"app,omitempty"`
+	Container string    `json:"container,omitempty"`
+	Replica   string    `json:"replica,omitempty"`
+	Line      string    `json:"line"`
+}
+
+// Sink delivers batches of entries. If Send returns an error the whole batch is sent again, so entries are delivered
+// at least once.
+type Sink interface {
+	Send(ctx context.Context, entries []Entry) error
+	Close() error
+}
+
+// New returns the sink of the spec. The paths of file sinks are relative to volumeDir, the directory the volume of the
+// sink is mounted at.
+func New(spec v1.LogSink, volumeDir string) (Sink, error) {
+	switch {
+	case spec.HTTP != nil:
+		return newHTTPSink(spec.HTTP.URL), nil
+	case spec.Syslog != nil:
+		return newSyslogSink(spec.Syslog.Protocol, spec.Syslog.Address)
+	case spec.File != nil:
+		return newFileSink(volumeDir, spec.File.Path)
+	}
+	return nil, fmt.Errorf("log sink has no http, syslog or file endpoint")
+}
+
+func toEntry(msg log.Message) Entry {
+	entry := Entry{
+		Time:      msg.Time,
+		Container: msg.ContainerName,
+		Line:      msg.Line,
+	}
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	if msg.Pod != nil {
+		entry.Project = msg.Pod.Labels[labels.AcornAppNamespace]
+		entry.App = msg.Pod.Labels[labels.AcornAppName]
+		entry.Replica = msg.Pod.Name
+		if msg.ContainerName != msg.Pod.Labels[labels.AcornContainerName] &&
+			msg.ContainerName != msg.Pod.Labels[labels.AcornJobName] {
+			entry.Replica += "." + msg.ContainerName
+		}
+	}
+	return entry
+}
@@ -0,0 +1,211 @@
+package logsink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var testTime = time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func testEntries(n int) (result []Entry) {
+	for i := 0; i < n; i++ {
+		result = append(result, Entry{
+			Time:      testTime.Add(time.Duration(i) * time.Second),
+			Project:   "acorn",
+			App:       "app",
+			Container: "web",
+			Replica:   "web-abc-123",
+			Line:      fmt.Sprintf("line %d", i),
+		})
+	}
+	return
+}
+
+// httpStandIn is a local HTTP log endpoint that fails the first requests
+type httpStandIn struct {
+	lock     sync.Mutex
+	failures int
+	received []Entry
+}
+
+func (h *httpStandIn) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.failures > 0 {
+		h.failures--
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var entries []Entry
+	if req.Header.Get("Content-Type") != "application/json" || json.NewDecoder(req.Body).Decode(&entries) != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	h.received = append(h.received, entries...)
+}
+
+func (h *httpStandIn) entries() []Entry {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return append([]Entry(nil), h.received...)
+}
+
+func TestForwarderHTTPRetry(t *testing.T) {
+	standIn := &httpStandIn{failures: 2}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	sink, err := New(v1.LogSink{HTTP: &v1.HTTPLogSink{URL: server.URL}}, "")
+	require.NoError(t, err)
+
+	forwarder := NewForwarder(sink)
+	forwarder.BatchSize = 2
+	forwarder.FlushInterval = 10 * time.Millisecond
+
+	entries := testEntries(5)
+	for _, entry := range entries {
+		forwarder.Add(entry)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- forwarder.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(standIn.entries()) == len(entries)
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	assert.Equal(t, entries, standIn.entries())
+}
+
+func TestForwarderFlushOnClose(t *testing.T) {
+	standIn := &httpStandIn{}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	forwarder := NewForwarder(newHTTPSink(server.URL))
+	forwarder.FlushInterval = time.Hour
+
+	entries := testEntries(3)
+	for _, entry := range entries {
+		forwarder.Add(entry)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, forwarder.Run(ctx))
+	assert.Equal(t, entries, standIn.entries())
+}
+
+func TestForwarderDropsOldest(t *testing.T) {
+	forwarder := NewForwarder(nil)
+	forwarder.BufferSize = 3
+
+	entries := testEntries(5)
+	for _, entry := range entries {
+		forwarder.Add(entry)
+	}
+
+	batch, dropped := forwarder.next()
+	assert.Equal(t, 2, dropped)
+	assert.Equal(t, entries[2:], batch)
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := New(v1.LogSink{File: &v1.FileLogSink{Volume: "logs", Path: "../app/logs.json"}}, dir)
+	require.NoError(t, err)
+
+	entries := testEntries(2)
+	require.NoError(t, sink.Send(context.Background(), entries[:1]))
+	require.NoError(t, sink.Send(context.Background(), entries[1:]))
+	require.NoError(t, sink.Close())
+
+	// The path can't point outside of the volume
+	data, err := os.ReadFile(filepath.Join(dir, "app", "logs.json"))
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	for i, line := range lines {
+		var entry Entry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		assert.Equal(t, entries[i], entry)
+	}
+}
+
+func TestSyslogSink(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink, err := New(v1.LogSink{Syslog: &v1.SyslogLogSink{Address: conn.LocalAddr().String()}}, "")
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Send(context.Background(), testEntries(1)))
+
+	buf := make([]byte, 1024)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	assert.Equal(t, `<14>1 2023-05-01T12:00:00Z web-abc-123 app - web [acorn@32473 project="acorn" app="app" container="web" replica="web-abc-123"] line 0`, string(buf[:n]))
+}
+
+func TestFormatSyslogEscaping(t *testing.T) {
+	assert.Equal(t, `<14>1 2023-05-01T12:00:00Z - my_app - - [acorn@32473 project="a\"b\]" app="my app" container="" replica=""] hello`,
+		formatSyslog(Entry{
+			Time:    testTime,
+			Project: `a"b]`,
+			App:     "my app",
+			Line:    "hello",
+		}))
+}
+
+func TestToEntry(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web-abc-123",
+			Labels: map[string]string{
+				labels.AcornAppName:       "app",
+				labels.AcornAppNamespace:  "acorn",
+				labels.AcornContainerName: "web",
+			},
+		},
+	}
+
+	assert.Equal(t, Entry{
+		Time:      testTime,
+		Project:   "acorn",
+		App:       "app",
+		Container: "web",
+		Replica:   "web-abc-123",
+		Line:      "hello",
+	}, toEntry(log.Message{Line: "hello", Pod: pod, ContainerName: "web", Time: testTime}))
+
+	assert.Equal(t, "web-abc-123.sidecar", toEntry(log.Message{Line: "hello", Pod: pod, ContainerName: "sidecar", Time: testTime}).Replica)
+}
@@ -0,0 +1,109 @@
+package logsink
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+)
+
+const (
+	// syslogPriority is the facility user (1) and the severity informational (6)
+	syslogPriority = 1*8 + 6
+	// syslogSDID is the ID of the structured data with the acorn labels, 32473 is the enterprise number for examples
+	syslogSDID = "acorn@32473"
+)
+
+// syslogSink sends entries as RFC 5424 messages. The standard library syslog package can't be used, it doesn't
+// support Windows and always writes the local hostname.
+type syslogSink struct {
+	protocol string
+	address  string
+	conn     net.Conn
+}
+
+func newSyslogSink(protocol, address string) (*syslogSink, error) {
+	switch protocol {
+	case "":
+		protocol = v1.SyslogProtocolUDP
+	case v1.SyslogProtocolUDP, v1.SyslogProtocolTCP:
+	default:
+		return nil, fmt.Errorf("invalid syslog protocol [%s], must be %s or %s", protocol, v1.SyslogProtocolUDP, v1.SyslogProtocolTCP)
+	}
+	return &syslogSink{
+		protocol: protocol,
+		address:  address,
+	}, nil
+}
+
+func (s *syslogSink) Send(ctx context.Context, entries []Entry) error {
+	if s.conn == nil {
+		dialer := net.Dialer{Timeout: 10 * time.Second}
+		conn, err := dialer.DialContext(ctx, s.protocol, s.address)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+
+	for _, entry := range entries {
+		msg := formatSyslog(entry)
+		if s.protocol == v1.SyslogProtocolTCP {
+			// Octet counting framing of RFC 6587
+			msg = fmt.Sprintf("%d %s", len(msg), msg)
+		}
+		_ = s.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+		if _, err := s.conn.Write([]byte(msg)); err != nil {
+			_ = s.conn.Close()
+			s.conn = nil
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syslogSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+func formatSyslog(entry Entry) string {
+	return fmt.Sprintf("<%d>1 %s %s %s - %s [%s project=\"%s\" app=\"%s\" container=\"%s\" replica=\"%s\"] %s",
+		syslogPriority,
+		entry.Time.UTC().Format(time.RFC3339Nano),
+		syslogHeader(entry.Replica, 255),
+		syslogHeader(entry.App, 48),
+		syslogHeader(entry.Container, 32),
+		syslogSDID,
+		escapeSDParam(entry.Project),
+		escapeSDParam(entry.App),
+		escapeSDParam(entry.Container),
+		escapeSDParam(entry.Replica),
+		entry.Line)
+}
+
+// syslogHeader returns the value for a header field, which can't be empty or contain spaces
+func syslogHeader(value string, maxLength int) string {
+	value = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return '_'
+		}
+		return r
+	}, value)
+	if value == "" {
+		return "-"
+	}
+	if len(value) > maxLength {
+		return value[:maxLength]
+	}
+	return value
+}
+
+func escapeSDParam(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
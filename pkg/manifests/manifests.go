@@ -0,0 +1,342 @@
+package manifests
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/controller/appdefinition"
+	"github.com/acorn-io/runtime/pkg/controller/namespace"
+	"github.com/acorn-io/runtime/pkg/controller/networkpolicy"
+	controllersecrets "github.com/acorn-io/runtime/pkg/controller/secrets"
+	"github.com/acorn-io/runtime/pkg/controller/service"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/secrets"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// BuildImagePrefix prefixes the image of the containers that are built from source. These containers have no image
+// until the app is built, so their image is the prefix followed by the name of the container, like build://web.
+const BuildImagePrefix = "build://"
+
+type Options struct {
+	// Config is the acorn config the controller runs with. The defaults are used if it is nil.
+	Config *apiv1.Config
+	// Seed is what the values of generated token and basic secrets are derived from, so rendering the same app twice
+	// yields the same secrets. Anyone who knows the seed can derive the values, so it has to be kept secret. Rendering
+	// an app with generated secrets fails if it is empty.
+	Seed string
+}
+
+// Render returns the Kubernetes objects the controller creates for an app, without a cluster. The handlers of the
+// controller run against the app and an in-memory client, every handler sees the objects returned by the ones that
+// ran before it. The app must have a name, a namespace and its app spec in its status. Objects of the acorn API
+// groups, like the AppInstances of nested acorns, are not returned and a warning is logged for every nested acorn.
+func Render(ctx context.Context, app *v1.AppInstance, opts Options) ([]kclient.Object, error) {
+	app = app.DeepCopy()
+	setBuildImages(app)
+	if app.Status.AppImage.ID == "" {
+		app.Status.AppImage.ID = app.Name
+	}
+	if app.UID == "" {
+		// Some names are derived from the UID, it is stable so that rendering the same app yields the same objects
+		app.UID = types.UID(fmt.Sprintf("%x", sha256.Sum256([]byte(app.Namespace+"/"+app.Name))))
+	}
+
+	existing := []kclient.Object{
+		app,
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: app.Namespace,
+				Labels: map[string]string{
+					labels.AcornProject: "true",
+				},
+			},
+		},
+	}
+	if opts.Config != nil {
+		cm, err := config.AsConfigMap(opts.Config)
+		if err != nil {
+			return nil, err
+		}
+		existing = append(existing, cm)
+	}
+
+	ctx = secrets.WithRandomSource(ctx, func(secretName, key string) io.Reader {
+		if opts.Seed == "" {
+			return errorReader{err: fmt.Errorf("secret %s is generated, a seed is required to render its value", secretName)}
+		}
+		return &seededReader{
+			seed:   []byte(opts.Seed),
+			prefix: fmt.Sprintf("%s/%s/%s/%s", app.Namespace, app.Name, secretName, key),
+		}
+	})
+
+	for _, acornName := range typed.SortedKeys(app.Status.AppSpec.Acorns) {
+		logrus.Warnf("Nested acorn %s of app %s is not rendered, it is deployed as a separate app", acornName, app.Name)
+	}
+
+	r := &renderer{
+		ctx:     ctx,
+		objects: map[objectKey]kclient.Object{},
+	}
+	r.client = &recordingClient{
+		Client:   fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing...).Build(),
+		renderer: r,
+		uid:      string(app.UID),
+	}
+
+	for _, handler := range []router.HandlerFunc{
+		appdefinition.AssignNamespace,
+		namespace.AddNamespace,
+		controllersecrets.CreateSecrets(event.RecorderFunc(func(context.Context, *apiv1.Event) error { return nil })),
+		appdefinition.DeploySpec,
+		networkpolicy.ForApp,
+	} {
+		if err := r.handle(app, handler); err != nil {
+			return nil, err
+		}
+	}
+
+	// The handlers record most errors on the conditions of the app instead of returning them
+	for _, cond := range app.Status.Conditions {
+		if cond.Error {
+			return nil, fmt.Errorf("rendering app %s: %s", app.Name, cond.Message)
+		}
+	}
+	for _, secretName := range typed.SortedKeys(app.Status.AppStatus.Secrets) {
+		if errs := app.Status.AppStatus.Secrets[secretName].LookupErrors; len(errs) > 0 {
+			return nil, fmt.Errorf("rendering app %s: %s", app.Name, strings.Join(errs, ", "))
+		}
+	}
+
+	for _, obj := range r.rendered() {
+		if svc, ok := obj.(*v1.ServiceInstance); ok {
+			if err := r.handle(svc, service.RenderServices); apierror.IsNotFound(err) {
+				// The service refers to an object that isn't rendered, like a service of a nested acorn
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, obj := range r.rendered() {
+		switch o := obj.(type) {
+		case *corev1.Service:
+			if err := r.handle(o, networkpolicy.ForService); err != nil {
+				return nil, err
+			}
+		case *networkingv1.Ingress:
+			if err := r.handle(o, networkpolicy.ForIngress); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var result []kclient.Object
+	for _, obj := range r.rendered() {
+		if !strings.HasSuffix(obj.GetObjectKind().GroupVersionKind().Group, "acorn.io") {
+			result = append(result, obj)
+		}
+	}
+	return result, nil
+}
+
+// setBuildImages sets the image of the containers, sidecars and jobs that are built from source
+func setBuildImages(app *v1.AppInstance) {
+	for _, containers := range []map[string]v1.Container{app.Status.AppSpec.Containers, app.Status.AppSpec.Jobs} {
+		for name, container := range containers {
+			if container.Image == "" && container.Build != nil {
+				container.Image = BuildImagePrefix + name
+			}
+			for sidecarName, sidecar := range container.Sidecars {
+				if sidecar.Image == "" && sidecar.Build != nil {
+					sidecar.Image = BuildImagePrefix + name + "." + sidecarName
+					container.Sidecars[sidecarName] = sidecar
+				}
+			}
+			containers[name] = container
+		}
+	}
+}
+
+// seededReader returns the HMAC-SHA256 of the prefix and a counter, keyed with the seed, as an endless stream of bytes
+type seededReader struct {
+	seed    []byte
+	prefix  string
+	counter int
+	buf     []byte
+}
+
+func (s *seededReader) Read(p []byte) (int, error) {
+	for len(s.buf) < len(p) {
+		mac := hmac.New(sha256.New, s.seed)
+		mac.Write([]byte(fmt.Sprintf("%s/%d", s.prefix, s.counter)))
+		s.buf = mac.Sum(s.buf)
+		s.counter++
+	}
+	n := copy(p, s.buf)
+	s.buf = s.buf[n:]
+	return n, nil
+}
+
+type errorReader struct {
+	err error
+}
+
+func (e errorReader) Read([]byte) (int, error) {
+	return 0, e.err
+}
+
+type objectKey struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+	name      string
+}
+
+type renderer struct {
+	ctx     context.Context
+	client  kclient.Client
+	objects map[objectKey]kclient.Object
+	order   []objectKey
+}
+
+// rendered returns the objects rendered so far, in the order they were first rendered
+func (r *renderer) rendered() (result []kclient.Object) {
+	for _, key := range r.order {
+		result = append(result, r.objects[key])
+	}
+	return result
+}
+
+func (r *renderer) handle(obj kclient.Object, handler router.HandlerFunc) error {
+	gvk, err := apiutil.GVKForObject(obj, scheme.Scheme)
+	if err != nil {
+		return err
+	}
+
+	resp := &response{}
+	if err := handler(router.Request{
+		Client:    r.client,
+		Object:    obj,
+		Ctx:       r.ctx,
+		GVK:       gvk,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Key:       router.Key(obj.GetNamespace(), obj.GetName()).String(),
+	}, resp); err != nil {
+		return fmt.Errorf("rendering %s %s: %w", gvk.Kind, obj.GetName(), err)
+	}
+
+	for _, obj := range resp.objects {
+		if err := r.apply(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply stores an object in the client, so the handlers that run later see it, and records it
+func (r *renderer) apply(obj kclient.Object) error {
+	stored := obj.DeepCopyObject().(kclient.Object)
+	existing := obj.DeepCopyObject().(kclient.Object)
+	if err := r.client.Get(r.ctx, kclient.ObjectKeyFromObject(obj), existing); apierror.IsNotFound(err) {
+		if err := r.client.Create(r.ctx, stored); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	} else {
+		stored.SetResourceVersion(existing.GetResourceVersion())
+		if err := r.client.Update(r.ctx, stored); err != nil {
+			return err
+		}
+	}
+	return r.record(obj)
+}
+
+func (r *renderer) record(obj kclient.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, scheme.Scheme)
+	if err != nil {
+		return err
+	}
+
+	obj = obj.DeepCopyObject().(kclient.Object)
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetResourceVersion("")
+
+	key := objectKey{
+		gvk:       gvk,
+		namespace: obj.GetNamespace(),
+		name:      obj.GetName(),
+	}
+	if _, ok := r.objects[key]; !ok {
+		r.order = append(r.order, key)
+	}
+	r.objects[key] = obj
+	return nil
+}
+
+// recordingClient records the objects that handlers create or update directly, like generated secrets
+type recordingClient struct {
+	kclient.Client
+	renderer *renderer
+	uid      string
+}
+
+func hash(parts ...string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(parts, "/"))))
+}
+
+func (c *recordingClient) Create(ctx context.Context, obj kclient.Object, opts ...kclient.CreateOption) error {
+	if obj.GetName() == "" && obj.GetGenerateName() != "" {
+		// The fake client adds a random suffix, the name is derived from the app instead so that it is stable
+		obj.SetName(name.SafeConcatName(strings.TrimSuffix(obj.GetGenerateName(), "-"),
+			hash(c.uid, obj.GetNamespace(), obj.GetGenerateName())[:5]))
+	}
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	return c.renderer.record(obj)
+}
+
+func (c *recordingClient) Update(ctx context.Context, obj kclient.Object, opts ...kclient.UpdateOption) error {
+	if err := c.Client.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	return c.renderer.record(obj)
+}
+
+type response struct {
+	objects []kclient.Object
+}
+
+func (r *response) DisablePrune() {}
+
+func (r *response) RetryAfter(time.Duration) {}
+
+func (r *response) Objects(obj ...kclient.Object) {
+	r.objects = append(r.objects, obj...)
+}
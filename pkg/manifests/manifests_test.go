@@ -0,0 +1,149 @@
+package manifests
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRender(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "acorn",
+		},
+		Spec: v1.AppInstanceSpec{
+			TargetNamespace: "app",
+		},
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {
+						Build: &v1.Build{Context: "."},
+						Ports: []v1.PortDef{{TargetPort: 80, Protocol: v1.ProtocolHTTP}},
+					},
+				},
+				Acorns: map[string]v1.Acorn{
+					"nested": {Image: "nested-image"},
+				},
+			},
+		},
+	}
+
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	objs, err := Render(context.Background(), app, Options{})
+	require.NoError(t, err)
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, "Nested acorn nested of app app is not rendered, it is deployed as a separate app", hook.LastEntry().Message)
+
+	kinds := map[string]int{}
+	for _, obj := range objs {
+		kinds[obj.GetObjectKind().GroupVersionKind().Kind]++
+		assert.Empty(t, obj.GetResourceVersion())
+	}
+	assert.Equal(t, 1, kinds["Namespace"])
+	assert.Equal(t, 1, kinds["Deployment"])
+	assert.Equal(t, 1, kinds["Service"])
+	assert.Zero(t, kinds["AppInstance"])
+	assert.Zero(t, kinds["ServiceInstance"])
+
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case *corev1.Namespace:
+			assert.Equal(t, "app", o.Name)
+		case *appsv1.Deployment:
+			assert.Equal(t, "app", o.Namespace)
+			assert.Equal(t, "build://web", o.Spec.Template.Spec.Containers[0].Image)
+		}
+	}
+
+	again, err := Render(context.Background(), app, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, objs, again)
+
+	// The app passed in isn't changed
+	assert.Empty(t, app.Status.Namespace)
+	assert.Empty(t, app.Status.AppSpec.Containers["web"].Image)
+}
+
+func TestRenderSecrets(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "acorn",
+		},
+		Spec: v1.AppInstanceSpec{
+			TargetNamespace: "app",
+		},
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Secrets: map[string]v1.Secret{
+					"token": {
+						Type: "token",
+						Params: v1.GenericMap{
+							"length":     int64(16),
+							"characters": "abcdef",
+						},
+					},
+					"basic": {Type: "basic"},
+					"fixed": {
+						Type: "token",
+						Params: v1.GenericMap{
+							"length":     int64(16),
+							"characters": "abcdef",
+						},
+						Data: map[string]string{"token": "from-the-acornfile"},
+					},
+				},
+			},
+		},
+	}
+
+	secretsOf := func(objs []kclient.Object) map[string]*corev1.Secret {
+		result := map[string]*corev1.Secret{}
+		for _, obj := range objs {
+			if secret, ok := obj.(*corev1.Secret); ok && secret.Namespace == "app" {
+				result[secret.Name] = secret
+			}
+		}
+		return result
+	}
+
+	_, err := Render(context.Background(), app, Options{})
+	assert.ErrorContains(t, err, "a seed is required to render its value")
+
+	objs, err := Render(context.Background(), app, Options{Seed: "seed"})
+	require.NoError(t, err)
+	secrets := secretsOf(objs)
+	require.Len(t, secrets, 3)
+	assert.Regexp(t, "^[a-f]{16}$", string(secrets["token"].Data["token"]))
+	assert.Len(t, secrets["basic"].Data["username"], 8)
+	assert.Len(t, secrets["basic"].Data["password"], 16)
+	assert.Equal(t, "from-the-acornfile", string(secrets["fixed"].Data["token"]))
+
+	again, err := Render(context.Background(), app, Options{Seed: "seed"})
+	require.NoError(t, err)
+	assert.Equal(t, objs, again)
+
+	seeded, err := Render(context.Background(), app, Options{Seed: "other"})
+	require.NoError(t, err)
+	assert.NotEqual(t, secrets["token"].Data, secretsOf(seeded)["token"].Data)
+	assert.NotEqual(t, secrets["basic"].Data, secretsOf(seeded)["basic"].Data)
+
+	// Secrets with values in the Acornfile don't need a seed
+	delete(app.Status.AppSpec.Secrets, "token")
+	delete(app.Status.AppSpec.Secrets, "basic")
+	objs, err = Render(context.Background(), app, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, "from-the-acornfile", string(secretsOf(objs)["fixed"].Data["token"]))
+}
@@ -187,6 +187,20 @@ func (mr *MockClientMockRecorder) AppLog(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppLog", reflect.TypeOf((*MockClient)(nil).AppLog), arg0, arg1, arg2)
 }
 
+// AppPromote mocks base method.
+func (m *MockClient) AppPromote(arg0 context.Context, arg1 string, arg2 *client.AppPromoteOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppPromote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppPromote indicates an expected call of AppPromote.
+func (mr *MockClientMockRecorder) AppPromote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppPromote", reflect.TypeOf((*MockClient)(nil).AppPromote), arg0, arg1, arg2)
+}
+
 // AppPullImage mocks base method.
 func (m *MockClient) AppPullImage(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -201,6 +215,20 @@ func (mr *MockClientMockRecorder) AppPullImage(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppPullImage", reflect.TypeOf((*MockClient)(nil).AppPullImage), arg0, arg1)
 }
 
+// AppRollback mocks base method.
+func (m *MockClient) AppRollback(arg0 context.Context, arg1 string, arg2 *client.AppRollbackOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppRollback", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppRollback indicates an expected call of AppRollback.
+func (mr *MockClientMockRecorder) AppRollback(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRollback", reflect.TypeOf((*MockClient)(nil).AppRollback), arg0, arg1, arg2)
+}
+
 // AppRun mocks base method.
 func (m *MockClient) AppRun(arg0 context.Context, arg1 string, arg2 *client.AppRunOptions) (*v1.App, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +244,21 @@ func (mr *MockClientMockRecorder) AppRun(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRun", reflect.TypeOf((*MockClient)(nil).AppRun), arg0, arg1, arg2)
 }
 
+// AppRunJob mocks base method.
+func (m *MockClient) AppRunJob(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppRunJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppRunJob indicates an expected call of AppRunJob.
+func (mr *MockClientMockRecorder) AppRunJob(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRunJob", reflect.TypeOf((*MockClient)(nil).AppRunJob), arg0, arg1, arg2)
+}
+
 // AppStart mocks base method.
 func (m *MockClient) AppStart(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -616,6 +659,21 @@ func (mr *MockClientMockRecorder) ImagePush(arg0, arg1, arg2 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePush", reflect.TypeOf((*MockClient)(nil).ImagePush), arg0, arg1, arg2)
 }
 
+// ImageSign mocks base method.
+func (m *MockClient) ImageSign(arg0 context.Context, arg1 string, arg2 []byte, arg3, arg4 string) (*v1.ImageSign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageSign", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*v1.ImageSign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageSign indicates an expected call of ImageSign.
+func (mr *MockClientMockRecorder) ImageSign(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageSign", reflect.TypeOf((*MockClient)(nil).ImageSign), arg0, arg1, arg2, arg3, arg4)
+}
+
 // ImageTag mocks base method.
 func (m *MockClient) ImageTag(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -630,6 +688,21 @@ func (mr *MockClientMockRecorder) ImageTag(arg0, arg1, arg2 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageTag", reflect.TypeOf((*MockClient)(nil).ImageTag), arg0, arg1, arg2)
 }
 
+// ImageVerify mocks base method.
+func (m *MockClient) ImageVerify(arg0 context.Context, arg1 string, arg2 *client.ImageVerifyOptions) (*v1.ImageVerify, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageVerify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.ImageVerify)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageVerify indicates an expected call of ImageVerify.
+func (mr *MockClientMockRecorder) ImageVerify(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageVerify", reflect.TypeOf((*MockClient)(nil).ImageVerify), arg0, arg1, arg2)
+}
+
 // Info mocks base method.
 func (m *MockClient) Info(arg0 context.Context) ([]v1.Info, error) {
 	m.ctrl.T.Helper()
@@ -825,6 +898,21 @@ func (mr *MockClientMockRecorder) SecretReveal(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretReveal", reflect.TypeOf((*MockClient)(nil).SecretReveal), arg0, arg1)
 }
 
+// SecretRotate mocks base method.
+func (m *MockClient) SecretRotate(arg0 context.Context, arg1 string) (*v1.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretRotate", arg0, arg1)
+	ret0, _ := ret[0].(*v1.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretRotate indicates an expected call of SecretRotate.
+func (mr *MockClientMockRecorder) SecretRotate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretRotate", reflect.TypeOf((*MockClient)(nil).SecretRotate), arg0, arg1)
+}
+
 // SecretUpdate mocks base method.
 func (m *MockClient) SecretUpdate(arg0 context.Context, arg1 string, arg2 map[string][]byte) (*v1.Secret, error) {
 	m.ctrl.T.Helper()
@@ -840,6 +928,21 @@ func (mr *MockClientMockRecorder) SecretUpdate(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretUpdate", reflect.TypeOf((*MockClient)(nil).SecretUpdate), arg0, arg1, arg2)
 }
 
+// VolumeArchive mocks base method.
+func (m *MockClient) VolumeArchive(arg0 context.Context, arg1 string, arg2 *client.VolumeArchiveOptions) (*term.ExecIO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeArchive", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*term.ExecIO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeArchive indicates an expected call of VolumeArchive.
+func (mr *MockClientMockRecorder) VolumeArchive(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeArchive", reflect.TypeOf((*MockClient)(nil).VolumeArchive), arg0, arg1, arg2)
+}
+
 // VolumeClassGet mocks base method.
 func (m *MockClient) VolumeClassGet(arg0 context.Context, arg1 string) (*v1.VolumeClass, error) {
 	m.ctrl.T.Helper()
@@ -915,6 +1018,66 @@ func (mr *MockClientMockRecorder) VolumeList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeList", reflect.TypeOf((*MockClient)(nil).VolumeList), arg0)
 }
 
+// VolumeSnapshotCreate mocks base method.
+func (m *MockClient) VolumeSnapshotCreate(arg0 context.Context, arg1 string, arg2 *client.VolumeSnapshotCreateOptions) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotCreate indicates an expected call of VolumeSnapshotCreate.
+func (mr *MockClientMockRecorder) VolumeSnapshotCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotCreate", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotCreate), arg0, arg1, arg2)
+}
+
+// VolumeSnapshotDelete mocks base method.
+func (m *MockClient) VolumeSnapshotDelete(arg0 context.Context, arg1 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotDelete", arg0, arg1)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotDelete indicates an expected call of VolumeSnapshotDelete.
+func (mr *MockClientMockRecorder) VolumeSnapshotDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotDelete", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotDelete), arg0, arg1)
+}
+
+// VolumeSnapshotGet mocks base method.
+func (m *MockClient) VolumeSnapshotGet(arg0 context.Context, arg1 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotGet", arg0, arg1)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotGet indicates an expected call of VolumeSnapshotGet.
+func (mr *MockClientMockRecorder) VolumeSnapshotGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotGet", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotGet), arg0, arg1)
+}
+
+// VolumeSnapshotList mocks base method.
+func (m *MockClient) VolumeSnapshotList(arg0 context.Context) ([]v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotList", arg0)
+	ret0, _ := ret[0].([]v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotList indicates an expected call of VolumeSnapshotList.
+func (mr *MockClientMockRecorder) VolumeSnapshotList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotList", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotList), arg0)
+}
+
 // MockProjectClientFactory is a mock of ProjectClientFactory interface.
 type MockProjectClientFactory struct {
 	ctrl     *gomock.Controller
@@ -16,207 +16,262 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterComputeClass":                      schema_pkg_apis_adminacornio_v1_ClusterComputeClass(ref),
-		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterComputeClassList":                  schema_pkg_apis_adminacornio_v1_ClusterComputeClassList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterVolumeClass":                       schema_pkg_apis_adminacornio_v1_ClusterVolumeClass(ref),
-		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterVolumeClassList":                   schema_pkg_apis_adminacornio_v1_ClusterVolumeClassList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectComputeClass":                      schema_pkg_apis_adminacornio_v1_ProjectComputeClass(ref),
-		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectComputeClassList":                  schema_pkg_apis_adminacornio_v1_ProjectComputeClassList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectVolumeClass":                       schema_pkg_apis_adminacornio_v1_ProjectVolumeClass(ref),
-		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectVolumeClassList":                   schema_pkg_apis_adminacornio_v1_ProjectVolumeClassList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AcornImageBuild":                            schema_pkg_apis_apiacornio_v1_AcornImageBuild(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AcornImageBuildList":                        schema_pkg_apis_apiacornio_v1_AcornImageBuildList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Acornfile":                                  schema_pkg_apis_apiacornio_v1_Acornfile(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.App":                                        schema_pkg_apis_apiacornio_v1_App(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppList":                                    schema_pkg_apis_apiacornio_v1_AppList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppPullImage":                               schema_pkg_apis_apiacornio_v1_AppPullImage(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Builder":                                    schema_pkg_apis_apiacornio_v1_Builder(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.BuilderList":                                schema_pkg_apis_apiacornio_v1_BuilderList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.BuilderPortOptions":                         schema_pkg_apis_apiacornio_v1_BuilderPortOptions(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ComputeClass":                               schema_pkg_apis_apiacornio_v1_ComputeClass(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ComputeClassList":                           schema_pkg_apis_apiacornio_v1_ComputeClassList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Config":                                     schema_pkg_apis_apiacornio_v1_Config(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ConfirmUpgrade":                             schema_pkg_apis_apiacornio_v1_ConfirmUpgrade(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplica":                           schema_pkg_apis_apiacornio_v1_ContainerReplica(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaColumns":                    schema_pkg_apis_apiacornio_v1_ContainerReplicaColumns(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaExecOptions":                schema_pkg_apis_apiacornio_v1_ContainerReplicaExecOptions(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaList":                       schema_pkg_apis_apiacornio_v1_ContainerReplicaList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaPortForwardOptions":         schema_pkg_apis_apiacornio_v1_ContainerReplicaPortForwardOptions(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaSpec":                       schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaStatus":                     schema_pkg_apis_apiacornio_v1_ContainerReplicaStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Credential":                                 schema_pkg_apis_apiacornio_v1_Credential(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.CredentialList":                             schema_pkg_apis_apiacornio_v1_CredentialList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.DevSession":                                 schema_pkg_apis_apiacornio_v1_DevSession(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.DevSessionList":                             schema_pkg_apis_apiacornio_v1_DevSessionList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EmbeddedContainer":                          schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EncryptionKey":                              schema_pkg_apis_apiacornio_v1_EncryptionKey(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Event":                                      schema_pkg_apis_apiacornio_v1_Event(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventList":                                  schema_pkg_apis_apiacornio_v1_EventList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.IgnoreCleanup":                              schema_pkg_apis_apiacornio_v1_IgnoreCleanup(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Image":                                      schema_pkg_apis_apiacornio_v1_Image(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageAllowRule":                             schema_pkg_apis_apiacornio_v1_ImageAllowRule(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageAllowRuleList":                         schema_pkg_apis_apiacornio_v1_ImageAllowRuleList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageDetails":                               schema_pkg_apis_apiacornio_v1_ImageDetails(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageList":                                  schema_pkg_apis_apiacornio_v1_ImageList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImagePull":                                  schema_pkg_apis_apiacornio_v1_ImagePull(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImagePush":                                  schema_pkg_apis_apiacornio_v1_ImagePush(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageTag":                                   schema_pkg_apis_apiacornio_v1_ImageTag(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Info":                                       schema_pkg_apis_apiacornio_v1_Info(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoList":                                   schema_pkg_apis_apiacornio_v1_InfoList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec":                                   schema_pkg_apis_apiacornio_v1_InfoSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.LogMessage":                                 schema_pkg_apis_apiacornio_v1_LogMessage(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.LogOptions":                                 schema_pkg_apis_apiacornio_v1_LogOptions(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.PortForwardOptions":                         schema_pkg_apis_apiacornio_v1_PortForwardOptions(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Project":                                    schema_pkg_apis_apiacornio_v1_Project(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ProjectList":                                schema_pkg_apis_apiacornio_v1_ProjectList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ProjectSpec":                                schema_pkg_apis_apiacornio_v1_ProjectSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ProjectStatus":                              schema_pkg_apis_apiacornio_v1_ProjectStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Region":                                     schema_pkg_apis_apiacornio_v1_Region(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegionList":                                 schema_pkg_apis_apiacornio_v1_RegionList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegionSpec":                                 schema_pkg_apis_apiacornio_v1_RegionSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegionStatus":                               schema_pkg_apis_apiacornio_v1_RegionStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth":                               schema_pkg_apis_apiacornio_v1_RegistryAuth(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Secret":                                     schema_pkg_apis_apiacornio_v1_Secret(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.SecretList":                                 schema_pkg_apis_apiacornio_v1_SecretList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Service":                                    schema_pkg_apis_apiacornio_v1_Service(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ServiceList":                                schema_pkg_apis_apiacornio_v1_ServiceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Volume":                                     schema_pkg_apis_apiacornio_v1_Volume(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeClass":                                schema_pkg_apis_apiacornio_v1_VolumeClass(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeClassList":                            schema_pkg_apis_apiacornio_v1_VolumeClassList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeColumns":                              schema_pkg_apis_apiacornio_v1_VolumeColumns(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeCreateOptions":                        schema_pkg_apis_apiacornio_v1_VolumeCreateOptions(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeList":                                 schema_pkg_apis_apiacornio_v1_VolumeList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSpec":                                 schema_pkg_apis_apiacornio_v1_VolumeSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeStatus":                               schema_pkg_apis_apiacornio_v1_VolumeStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Acorn":                                 schema_pkg_apis_internalacornio_v1_Acorn(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuild":                            schema_pkg_apis_internalacornio_v1_AcornBuild(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuilderSpec":                      schema_pkg_apis_internalacornio_v1_AcornBuilderSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstance":               schema_pkg_apis_internalacornio_v1_AcornImageBuildInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstanceList":           schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstanceSpec":           schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstanceStatus":         schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornStatus":                           schema_pkg_apis_internalacornio_v1_AcornStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Alias":                                 schema_pkg_apis_internalacornio_v1_Alias(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns":                            schema_pkg_apis_internalacornio_v1_AppColumns(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage":                              schema_pkg_apis_internalacornio_v1_AppImage(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstance":                           schema_pkg_apis_internalacornio_v1_AppInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceList":                       schema_pkg_apis_internalacornio_v1_AppInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec":                       schema_pkg_apis_internalacornio_v1_AppInstanceSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceStatus":                     schema_pkg_apis_internalacornio_v1_AppInstanceStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec":                               schema_pkg_apis_internalacornio_v1_AppSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus":                             schema_pkg_apis_internalacornio_v1_AppStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build":                                 schema_pkg_apis_internalacornio_v1_Build(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuildRecord":                           schema_pkg_apis_internalacornio_v1_BuildRecord(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstance":                       schema_pkg_apis_internalacornio_v1_BuilderInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstanceList":                   schema_pkg_apis_internalacornio_v1_BuilderInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstanceStatus":                 schema_pkg_apis_internalacornio_v1_BuilderInstanceStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderSpec":                           schema_pkg_apis_internalacornio_v1_BuilderSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.CommonStatus":                          schema_pkg_apis_internalacornio_v1_CommonStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition":                             schema_pkg_apis_internalacornio_v1_Condition(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container":                             schema_pkg_apis_internalacornio_v1_Container(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerData":                         schema_pkg_apis_internalacornio_v1_ContainerData(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerImageBuilderSpec":             schema_pkg_apis_internalacornio_v1_ContainerImageBuilderSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerStatus":                       schema_pkg_apis_internalacornio_v1_ContainerStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults":                              schema_pkg_apis_internalacornio_v1_Defaults(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency":                            schema_pkg_apis_internalacornio_v1_Dependency(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyNotFound":                    schema_pkg_apis_internalacornio_v1_DependencyNotFound(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus":                      schema_pkg_apis_internalacornio_v1_DependencyStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionImageSource":                 schema_pkg_apis_internalacornio_v1_DevSessionImageSource(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstance":                    schema_pkg_apis_internalacornio_v1_DevSessionInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceClient":              schema_pkg_apis_internalacornio_v1_DevSessionInstanceClient(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceExpireAction":        schema_pkg_apis_internalacornio_v1_DevSessionInstanceExpireAction(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceList":                schema_pkg_apis_internalacornio_v1_DevSessionInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec":                schema_pkg_apis_internalacornio_v1_DevSessionInstanceSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceStatus":              schema_pkg_apis_internalacornio_v1_DevSessionInstanceStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Endpoint":                              schema_pkg_apis_internalacornio_v1_Endpoint(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar":                                schema_pkg_apis_internalacornio_v1_EnvVar(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventInstance":                         schema_pkg_apis_internalacornio_v1_EventInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventInstanceList":                     schema_pkg_apis_internalacornio_v1_EventInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSource":                           schema_pkg_apis_internalacornio_v1_EventSource(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExecProbe":                             schema_pkg_apis_internalacornio_v1_ExecProbe(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError":                       schema_pkg_apis_internalacornio_v1_ExpressionError(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File":                                  schema_pkg_apis_internalacornio_v1_File(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GeneratedService":                      schema_pkg_apis_internalacornio_v1_GeneratedService(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HTTPProbe":                             schema_pkg_apis_internalacornio_v1_HTTPProbe(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Image":                                 schema_pkg_apis_internalacornio_v1_Image(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleInstance":                schema_pkg_apis_internalacornio_v1_ImageAllowRuleInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleInstanceList":            schema_pkg_apis_internalacornio_v1_ImageAllowRuleInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSignatures":              schema_pkg_apis_internalacornio_v1_ImageAllowRuleSignatures(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageBuilderSpec":                      schema_pkg_apis_internalacornio_v1_ImageBuilderSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageData":                             schema_pkg_apis_internalacornio_v1_ImageData(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageInstance":                         schema_pkg_apis_internalacornio_v1_ImageInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageInstanceList":                     schema_pkg_apis_internalacornio_v1_ImageInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImagesData":                            schema_pkg_apis_internalacornio_v1_ImagesData(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.JobStatus":                             schema_pkg_apis_internalacornio_v1_JobStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef":                            schema_pkg_apis_internalacornio_v1_MetricsDef(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MicroTime":                             schema_pkg_apis_internalacornio_v1_MicroTime(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue":                             schema_pkg_apis_internalacornio_v1_NameValue(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Param":                                 schema_pkg_apis_internalacornio_v1_Param(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ParamSpec":                             schema_pkg_apis_internalacornio_v1_ParamSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions":                           schema_pkg_apis_internalacornio_v1_Permissions(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Platform":                              schema_pkg_apis_internalacornio_v1_Platform(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PolicyRule":                            schema_pkg_apis_internalacornio_v1_PolicyRule(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding":                           schema_pkg_apis_internalacornio_v1_PortBinding(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef":                               schema_pkg_apis_internalacornio_v1_PortDef(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPublish":                           schema_pkg_apis_internalacornio_v1_PortPublish(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe":                                 schema_pkg_apis_internalacornio_v1_Probe(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Profile":                               schema_pkg_apis_internalacornio_v1_Profile(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ReplicasSummary":                       schema_pkg_apis_internalacornio_v1_ReplicasSummary(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Route":                                 schema_pkg_apis_internalacornio_v1_Route(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Router":                                schema_pkg_apis_internalacornio_v1_Router(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouterStatus":                          schema_pkg_apis_internalacornio_v1_RouterStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Scheduling":                            schema_pkg_apis_internalacornio_v1_Scheduling(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel":                           schema_pkg_apis_internalacornio_v1_ScopedLabel(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Secret":                                schema_pkg_apis_internalacornio_v1_Secret(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding":                         schema_pkg_apis_internalacornio_v1_SecretBinding(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference":                       schema_pkg_apis_internalacornio_v1_SecretReference(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretStatus":                          schema_pkg_apis_internalacornio_v1_SecretStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Service":                               schema_pkg_apis_internalacornio_v1_Service(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding":                        schema_pkg_apis_internalacornio_v1_ServiceBinding(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstance":                       schema_pkg_apis_internalacornio_v1_ServiceInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceList":                   schema_pkg_apis_internalacornio_v1_ServiceInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceSpec":                   schema_pkg_apis_internalacornio_v1_ServiceInstanceSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceStatus":                 schema_pkg_apis_internalacornio_v1_ServiceInstanceStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceStatus":                         schema_pkg_apis_internalacornio_v1_ServiceStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations":                  schema_pkg_apis_internalacornio_v1_SignatureAnnotations(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureRules":                        schema_pkg_apis_internalacornio_v1_SignatureRules(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignedBy":                              schema_pkg_apis_internalacornio_v1_SignedBy(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.TCPProbe":                              schema_pkg_apis_internalacornio_v1_TCPProbe(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VCS":                                   schema_pkg_apis_internalacornio_v1_VCS(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding":                         schema_pkg_apis_internalacornio_v1_VolumeBinding(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeDefault":                         schema_pkg_apis_internalacornio_v1_VolumeDefault(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount":                           schema_pkg_apis_internalacornio_v1_VolumeMount(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRequest":                         schema_pkg_apis_internalacornio_v1_VolumeRequest(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSecretMount":                     schema_pkg_apis_internalacornio_v1_VolumeSecretMount(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeStatus":                          schema_pkg_apis_internalacornio_v1_VolumeStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.acornAliases":                          schema_pkg_apis_internalacornio_v1_acornAliases(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.containerAliases":                      schema_pkg_apis_internalacornio_v1_containerAliases(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.envVal":                                schema_pkg_apis_internalacornio_v1_envVal(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.policyRuleAliases":                     schema_pkg_apis_internalacornio_v1_policyRuleAliases(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.routeTarget":                           schema_pkg_apis_internalacornio_v1_routeTarget(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.secretReference":                       schema_pkg_apis_internalacornio_v1_secretReference(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterComputeClassInstance":     schema_pkg_apis_internaladminacornio_v1_ClusterComputeClassInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterComputeClassInstanceList": schema_pkg_apis_internaladminacornio_v1_ClusterComputeClassInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterVolumeClassInstance":      schema_pkg_apis_internaladminacornio_v1_ClusterVolumeClassInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterVolumeClassInstanceList":  schema_pkg_apis_internaladminacornio_v1_ClusterVolumeClassInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory":              schema_pkg_apis_internaladminacornio_v1_ComputeClassMemory(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectComputeClassInstance":     schema_pkg_apis_internaladminacornio_v1_ProjectComputeClassInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectComputeClassInstanceList": schema_pkg_apis_internaladminacornio_v1_ProjectComputeClassInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectVolumeClassInstance":      schema_pkg_apis_internaladminacornio_v1_ProjectVolumeClassInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectVolumeClassInstanceList":  schema_pkg_apis_internaladminacornio_v1_ProjectVolumeClassInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestInstance":            schema_pkg_apis_internaladminacornio_v1_QuotaRequestInstance(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestInstanceList":        schema_pkg_apis_internaladminacornio_v1_QuotaRequestInstanceList(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestInstanceSpec":        schema_pkg_apis_internaladminacornio_v1_QuotaRequestInstanceSpec(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestInstanceStatus":      schema_pkg_apis_internaladminacornio_v1_QuotaRequestInstanceStatus(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.Resources":                       schema_pkg_apis_internaladminacornio_v1_Resources(ref),
-		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize":                 schema_pkg_apis_internaladminacornio_v1_VolumeClassSize(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                             schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		"k8s.io/api/core/v1.Affinity":                                                                     schema_k8sio_api_core_v1_Affinity(ref),
-		"k8s.io/api/core/v1.AttachedVolume":                                                               schema_k8sio_api_core_v1_AttachedVolume(ref),
-		"k8s.io/api/core/v1.AvoidPods":                                                                    schema_k8sio_api_core_v1_AvoidPods(ref),
-		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                                        schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                              schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFileVolumeSource":                                                        schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterAppPolicyRule":                      schema_pkg_apis_adminacornio_v1_ClusterAppPolicyRule(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterAppPolicyRuleList":                  schema_pkg_apis_adminacornio_v1_ClusterAppPolicyRuleList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterComputeClass":                       schema_pkg_apis_adminacornio_v1_ClusterComputeClass(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterComputeClassList":                   schema_pkg_apis_adminacornio_v1_ClusterComputeClassList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterVolumeClass":                        schema_pkg_apis_adminacornio_v1_ClusterVolumeClass(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterVolumeClassList":                    schema_pkg_apis_adminacornio_v1_ClusterVolumeClassList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectAppPolicyRule":                      schema_pkg_apis_adminacornio_v1_ProjectAppPolicyRule(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectAppPolicyRuleList":                  schema_pkg_apis_adminacornio_v1_ProjectAppPolicyRuleList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectComputeClass":                       schema_pkg_apis_adminacornio_v1_ProjectComputeClass(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectComputeClassList":                   schema_pkg_apis_adminacornio_v1_ProjectComputeClassList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectVolumeClass":                        schema_pkg_apis_adminacornio_v1_ProjectVolumeClass(ref),
+		"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectVolumeClassList":                    schema_pkg_apis_adminacornio_v1_ProjectVolumeClassList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AcornImageBuild":                             schema_pkg_apis_apiacornio_v1_AcornImageBuild(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AcornImageBuildList":                         schema_pkg_apis_apiacornio_v1_AcornImageBuildList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Acornfile":                                   schema_pkg_apis_apiacornio_v1_Acornfile(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.App":                                         schema_pkg_apis_apiacornio_v1_App(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppList":                                     schema_pkg_apis_apiacornio_v1_AppList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppPromote":                                  schema_pkg_apis_apiacornio_v1_AppPromote(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppPullImage":                                schema_pkg_apis_apiacornio_v1_AppPullImage(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppRollback":                                 schema_pkg_apis_apiacornio_v1_AppRollback(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppRunJob":                                   schema_pkg_apis_apiacornio_v1_AppRunJob(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Builder":                                     schema_pkg_apis_apiacornio_v1_Builder(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.BuilderList":                                 schema_pkg_apis_apiacornio_v1_BuilderList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.BuilderPortOptions":                          schema_pkg_apis_apiacornio_v1_BuilderPortOptions(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ComputeClass":                                schema_pkg_apis_apiacornio_v1_ComputeClass(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ComputeClassList":                            schema_pkg_apis_apiacornio_v1_ComputeClassList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Config":                                      schema_pkg_apis_apiacornio_v1_Config(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ConfirmUpgrade":                              schema_pkg_apis_apiacornio_v1_ConfirmUpgrade(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplica":                            schema_pkg_apis_apiacornio_v1_ContainerReplica(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaColumns":                     schema_pkg_apis_apiacornio_v1_ContainerReplicaColumns(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaExecOptions":                 schema_pkg_apis_apiacornio_v1_ContainerReplicaExecOptions(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaList":                        schema_pkg_apis_apiacornio_v1_ContainerReplicaList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaPortForwardOptions":          schema_pkg_apis_apiacornio_v1_ContainerReplicaPortForwardOptions(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaSpec":                        schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaStatus":                      schema_pkg_apis_apiacornio_v1_ContainerReplicaStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Credential":                                  schema_pkg_apis_apiacornio_v1_Credential(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.CredentialList":                              schema_pkg_apis_apiacornio_v1_CredentialList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.DevSession":                                  schema_pkg_apis_apiacornio_v1_DevSession(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.DevSessionList":                              schema_pkg_apis_apiacornio_v1_DevSessionList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EmbeddedContainer":                           schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EncryptionKey":                               schema_pkg_apis_apiacornio_v1_EncryptionKey(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Event":                                       schema_pkg_apis_apiacornio_v1_Event(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventList":                                   schema_pkg_apis_apiacornio_v1_EventList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventSubscription":                           schema_pkg_apis_apiacornio_v1_EventSubscription(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventSubscriptionList":                       schema_pkg_apis_apiacornio_v1_EventSubscriptionList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.IgnoreCleanup":                               schema_pkg_apis_apiacornio_v1_IgnoreCleanup(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Image":                                       schema_pkg_apis_apiacornio_v1_Image(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageAllowRule":                              schema_pkg_apis_apiacornio_v1_ImageAllowRule(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageAllowRuleList":                          schema_pkg_apis_apiacornio_v1_ImageAllowRuleList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageAllowRuleResult":                        schema_pkg_apis_apiacornio_v1_ImageAllowRuleResult(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageDetails":                                schema_pkg_apis_apiacornio_v1_ImageDetails(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageList":                                   schema_pkg_apis_apiacornio_v1_ImageList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImagePull":                                   schema_pkg_apis_apiacornio_v1_ImagePull(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImagePush":                                   schema_pkg_apis_apiacornio_v1_ImagePush(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSBOM":                                   schema_pkg_apis_apiacornio_v1_ImageSBOM(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSign":                                   schema_pkg_apis_apiacornio_v1_ImageSign(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageTag":                                    schema_pkg_apis_apiacornio_v1_ImageTag(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageVerify":                                 schema_pkg_apis_apiacornio_v1_ImageVerify(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Info":                                        schema_pkg_apis_apiacornio_v1_Info(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoList":                                    schema_pkg_apis_apiacornio_v1_InfoList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec":                                    schema_pkg_apis_apiacornio_v1_InfoSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.LogMessage":                                  schema_pkg_apis_apiacornio_v1_LogMessage(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.LogOptions":                                  schema_pkg_apis_apiacornio_v1_LogOptions(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.PortForwardOptions":                          schema_pkg_apis_apiacornio_v1_PortForwardOptions(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Project":                                     schema_pkg_apis_apiacornio_v1_Project(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ProjectList":                                 schema_pkg_apis_apiacornio_v1_ProjectList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ProjectSpec":                                 schema_pkg_apis_apiacornio_v1_ProjectSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ProjectStatus":                               schema_pkg_apis_apiacornio_v1_ProjectStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Region":                                      schema_pkg_apis_apiacornio_v1_Region(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegionList":                                  schema_pkg_apis_apiacornio_v1_RegionList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegionSpec":                                  schema_pkg_apis_apiacornio_v1_RegionSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegionStatus":                                schema_pkg_apis_apiacornio_v1_RegionStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth":                                schema_pkg_apis_apiacornio_v1_RegistryAuth(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Secret":                                      schema_pkg_apis_apiacornio_v1_Secret(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.SecretList":                                  schema_pkg_apis_apiacornio_v1_SecretList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Service":                                     schema_pkg_apis_apiacornio_v1_Service(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ServiceList":                                 schema_pkg_apis_apiacornio_v1_ServiceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Volume":                                      schema_pkg_apis_apiacornio_v1_Volume(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeArchiveOptions":                        schema_pkg_apis_apiacornio_v1_VolumeArchiveOptions(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeClass":                                 schema_pkg_apis_apiacornio_v1_VolumeClass(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeClassList":                             schema_pkg_apis_apiacornio_v1_VolumeClassList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeColumns":                               schema_pkg_apis_apiacornio_v1_VolumeColumns(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeCreateOptions":                         schema_pkg_apis_apiacornio_v1_VolumeCreateOptions(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeList":                                  schema_pkg_apis_apiacornio_v1_VolumeList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshot":                              schema_pkg_apis_apiacornio_v1_VolumeSnapshot(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshotList":                          schema_pkg_apis_apiacornio_v1_VolumeSnapshotList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSpec":                                  schema_pkg_apis_apiacornio_v1_VolumeSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeStatus":                                schema_pkg_apis_apiacornio_v1_VolumeStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Acorn":                                  schema_pkg_apis_internalacornio_v1_Acorn(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuild":                             schema_pkg_apis_internalacornio_v1_AcornBuild(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuilderSpec":                       schema_pkg_apis_internalacornio_v1_AcornBuilderSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstance":                schema_pkg_apis_internalacornio_v1_AcornImageBuildInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstanceList":            schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstanceSpec":            schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstanceStatus":          schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornStatus":                            schema_pkg_apis_internalacornio_v1_AcornStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Alias":                                  schema_pkg_apis_internalacornio_v1_Alias(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns":                             schema_pkg_apis_internalacornio_v1_AppColumns(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage":                               schema_pkg_apis_internalacornio_v1_AppImage(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstance":                            schema_pkg_apis_internalacornio_v1_AppInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceList":                        schema_pkg_apis_internalacornio_v1_AppInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec":                        schema_pkg_apis_internalacornio_v1_AppInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceStatus":                      schema_pkg_apis_internalacornio_v1_AppInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevision":                            schema_pkg_apis_internalacornio_v1_AppRevision(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec":                                schema_pkg_apis_internalacornio_v1_AppSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus":                              schema_pkg_apis_internalacornio_v1_AppStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale":                              schema_pkg_apis_internalacornio_v1_Autoscale(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleMetric":                        schema_pkg_apis_internalacornio_v1_AutoscaleMetric(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleStatus":                        schema_pkg_apis_internalacornio_v1_AutoscaleStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build":                                  schema_pkg_apis_internalacornio_v1_Build(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuildCache":                             schema_pkg_apis_internalacornio_v1_BuildCache(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuildRecord":                            schema_pkg_apis_internalacornio_v1_BuildRecord(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstance":                        schema_pkg_apis_internalacornio_v1_BuilderInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstanceList":                    schema_pkg_apis_internalacornio_v1_BuilderInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstanceStatus":                  schema_pkg_apis_internalacornio_v1_BuilderInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderSpec":                            schema_pkg_apis_internalacornio_v1_BuilderSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.CommonStatus":                           schema_pkg_apis_internalacornio_v1_CommonStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition":                              schema_pkg_apis_internalacornio_v1_Condition(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container":                              schema_pkg_apis_internalacornio_v1_Container(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerData":                          schema_pkg_apis_internalacornio_v1_ContainerData(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerImageBuilderSpec":              schema_pkg_apis_internalacornio_v1_ContainerImageBuilderSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerRolloutStatus":                 schema_pkg_apis_internalacornio_v1_ContainerRolloutStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerStatus":                        schema_pkg_apis_internalacornio_v1_ContainerStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults":                               schema_pkg_apis_internalacornio_v1_Defaults(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency":                             schema_pkg_apis_internalacornio_v1_Dependency(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyNotFound":                     schema_pkg_apis_internalacornio_v1_DependencyNotFound(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus":                       schema_pkg_apis_internalacornio_v1_DependencyStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevConfig":                              schema_pkg_apis_internalacornio_v1_DevConfig(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevOnChange":                            schema_pkg_apis_internalacornio_v1_DevOnChange(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionImageSource":                  schema_pkg_apis_internalacornio_v1_DevSessionImageSource(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstance":                     schema_pkg_apis_internalacornio_v1_DevSessionInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceClient":               schema_pkg_apis_internalacornio_v1_DevSessionInstanceClient(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceExpireAction":         schema_pkg_apis_internalacornio_v1_DevSessionInstanceExpireAction(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceList":                 schema_pkg_apis_internalacornio_v1_DevSessionInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec":                 schema_pkg_apis_internalacornio_v1_DevSessionInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceStatus":               schema_pkg_apis_internalacornio_v1_DevSessionInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Endpoint":                               schema_pkg_apis_internalacornio_v1_Endpoint(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar":                                 schema_pkg_apis_internalacornio_v1_EnvVar(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventInstance":                          schema_pkg_apis_internalacornio_v1_EventInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventInstanceList":                      schema_pkg_apis_internalacornio_v1_EventInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSource":                            schema_pkg_apis_internalacornio_v1_EventSource(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstance":              schema_pkg_apis_internalacornio_v1_EventSubscriptionInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceList":          schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec":          schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus":        schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventWebhook":                           schema_pkg_apis_internalacornio_v1_EventWebhook(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExecProbe":                              schema_pkg_apis_internalacornio_v1_ExecProbe(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError":                        schema_pkg_apis_internalacornio_v1_ExpressionError(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File":                                   schema_pkg_apis_internalacornio_v1_File(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.FileLogSink":                            schema_pkg_apis_internalacornio_v1_FileLogSink(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GeneratedService":                       schema_pkg_apis_internalacornio_v1_GeneratedService(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HTTPLogSink":                            schema_pkg_apis_internalacornio_v1_HTTPLogSink(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HTTPProbe":                              schema_pkg_apis_internalacornio_v1_HTTPProbe(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Image":                                  schema_pkg_apis_internalacornio_v1_Image(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleInstance":                 schema_pkg_apis_internalacornio_v1_ImageAllowRuleInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleInstanceList":             schema_pkg_apis_internalacornio_v1_ImageAllowRuleInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSBOM":                     schema_pkg_apis_internalacornio_v1_ImageAllowRuleSBOM(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSignatures":               schema_pkg_apis_internalacornio_v1_ImageAllowRuleSignatures(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageBuilderSpec":                       schema_pkg_apis_internalacornio_v1_ImageBuilderSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageData":                              schema_pkg_apis_internalacornio_v1_ImageData(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageInstance":                          schema_pkg_apis_internalacornio_v1_ImageInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageInstanceList":                      schema_pkg_apis_internalacornio_v1_ImageInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImagesData":                             schema_pkg_apis_internalacornio_v1_ImagesData(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.JobRun":                                 schema_pkg_apis_internalacornio_v1_JobRun(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.JobStatus":                              schema_pkg_apis_internalacornio_v1_JobStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.KeylessIdentity":                        schema_pkg_apis_internalacornio_v1_KeylessIdentity(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.KeylessSigner":                          schema_pkg_apis_internalacornio_v1_KeylessSigner(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.LogSink":                                schema_pkg_apis_internalacornio_v1_LogSink(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef":                             schema_pkg_apis_internalacornio_v1_MetricsDef(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MicroTime":                              schema_pkg_apis_internalacornio_v1_MicroTime(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue":                              schema_pkg_apis_internalacornio_v1_NameValue(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Param":                                  schema_pkg_apis_internalacornio_v1_Param(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ParamSpec":                              schema_pkg_apis_internalacornio_v1_ParamSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions":                            schema_pkg_apis_internalacornio_v1_Permissions(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Platform":                               schema_pkg_apis_internalacornio_v1_Platform(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PolicyRule":                             schema_pkg_apis_internalacornio_v1_PolicyRule(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding":                            schema_pkg_apis_internalacornio_v1_PortBinding(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef":                                schema_pkg_apis_internalacornio_v1_PortDef(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPublish":                            schema_pkg_apis_internalacornio_v1_PortPublish(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe":                                  schema_pkg_apis_internalacornio_v1_Probe(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Profile":                                schema_pkg_apis_internalacornio_v1_Profile(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ReplicasSummary":                        schema_pkg_apis_internalacornio_v1_ReplicasSummary(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout":                                schema_pkg_apis_internalacornio_v1_Rollout(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutRevision":                        schema_pkg_apis_internalacornio_v1_RolloutRevision(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStatus":                          schema_pkg_apis_internalacornio_v1_RolloutStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStep":                            schema_pkg_apis_internalacornio_v1_RolloutStep(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Route":                                  schema_pkg_apis_internalacornio_v1_Route(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteRedirect":                          schema_pkg_apis_internalacornio_v1_RouteRedirect(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget":                            schema_pkg_apis_internalacornio_v1_RouteTarget(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Router":                                 schema_pkg_apis_internalacornio_v1_Router(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouterStatus":                           schema_pkg_apis_internalacornio_v1_RouterStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Scheduling":                             schema_pkg_apis_internalacornio_v1_Scheduling(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel":                            schema_pkg_apis_internalacornio_v1_ScopedLabel(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Secret":                                 schema_pkg_apis_internalacornio_v1_Secret(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding":                          schema_pkg_apis_internalacornio_v1_SecretBinding(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference":                        schema_pkg_apis_internalacornio_v1_SecretReference(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretRotate":                           schema_pkg_apis_internalacornio_v1_SecretRotate(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretStatus":                           schema_pkg_apis_internalacornio_v1_SecretStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Service":                                schema_pkg_apis_internalacornio_v1_Service(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding":                         schema_pkg_apis_internalacornio_v1_ServiceBinding(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstance":                        schema_pkg_apis_internalacornio_v1_ServiceInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceList":                    schema_pkg_apis_internalacornio_v1_ServiceInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceSpec":                    schema_pkg_apis_internalacornio_v1_ServiceInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceStatus":                  schema_pkg_apis_internalacornio_v1_ServiceInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceStatus":                          schema_pkg_apis_internalacornio_v1_ServiceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations":                   schema_pkg_apis_internalacornio_v1_SignatureAnnotations(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureRules":                         schema_pkg_apis_internalacornio_v1_SignatureRules(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignedBy":                               schema_pkg_apis_internalacornio_v1_SignedBy(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SyslogLogSink":                          schema_pkg_apis_internalacornio_v1_SyslogLogSink(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.TCPProbe":                               schema_pkg_apis_internalacornio_v1_TCPProbe(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VCS":                                    schema_pkg_apis_internalacornio_v1_VCS(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding":                          schema_pkg_apis_internalacornio_v1_VolumeBinding(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeDefault":                          schema_pkg_apis_internalacornio_v1_VolumeDefault(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount":                            schema_pkg_apis_internalacornio_v1_VolumeMount(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRequest":                          schema_pkg_apis_internalacornio_v1_VolumeRequest(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRestore":                          schema_pkg_apis_internalacornio_v1_VolumeRestore(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSecretMount":                      schema_pkg_apis_internalacornio_v1_VolumeSecretMount(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstance":                 schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceList":             schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec":             schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus":           schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeStatus":                           schema_pkg_apis_internalacornio_v1_VolumeStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.acornAliases":                           schema_pkg_apis_internalacornio_v1_acornAliases(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.containerAliases":                       schema_pkg_apis_internalacornio_v1_containerAliases(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.envVal":                                 schema_pkg_apis_internalacornio_v1_envVal(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.policyRuleAliases":                      schema_pkg_apis_internalacornio_v1_policyRuleAliases(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.routeValue":                             schema_pkg_apis_internalacornio_v1_routeValue(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.secretReference":                        schema_pkg_apis_internalacornio_v1_secretReference(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.AppPolicyRuleExpression":          schema_pkg_apis_internaladminacornio_v1_AppPolicyRuleExpression(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterAppPolicyRuleInstance":     schema_pkg_apis_internaladminacornio_v1_ClusterAppPolicyRuleInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterAppPolicyRuleInstanceList": schema_pkg_apis_internaladminacornio_v1_ClusterAppPolicyRuleInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterComputeClassInstance":      schema_pkg_apis_internaladminacornio_v1_ClusterComputeClassInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterComputeClassInstanceList":  schema_pkg_apis_internaladminacornio_v1_ClusterComputeClassInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterVolumeClassInstance":       schema_pkg_apis_internaladminacornio_v1_ClusterVolumeClassInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterVolumeClassInstanceList":   schema_pkg_apis_internaladminacornio_v1_ClusterVolumeClassInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU":                  schema_pkg_apis_internaladminacornio_v1_ComputeClassCPU(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory":               schema_pkg_apis_internaladminacornio_v1_ComputeClassMemory(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectAppPolicyRuleInstance":     schema_pkg_apis_internaladminacornio_v1_ProjectAppPolicyRuleInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectAppPolicyRuleInstanceList": schema_pkg_apis_internaladminacornio_v1_ProjectAppPolicyRuleInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectComputeClassInstance":      schema_pkg_apis_internaladminacornio_v1_ProjectComputeClassInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectComputeClassInstanceList":  schema_pkg_apis_internaladminacornio_v1_ProjectComputeClassInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectVolumeClassInstance":       schema_pkg_apis_internaladminacornio_v1_ProjectVolumeClassInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectVolumeClassInstanceList":   schema_pkg_apis_internaladminacornio_v1_ProjectVolumeClassInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestInstance":             schema_pkg_apis_internaladminacornio_v1_QuotaRequestInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestInstanceList":         schema_pkg_apis_internaladminacornio_v1_QuotaRequestInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestInstanceSpec":         schema_pkg_apis_internaladminacornio_v1_QuotaRequestInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestInstanceStatus":       schema_pkg_apis_internaladminacornio_v1_QuotaRequestInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.Resources":                        schema_pkg_apis_internaladminacornio_v1_Resources(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize":                  schema_pkg_apis_internaladminacornio_v1_VolumeClassSize(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                              schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"k8s.io/api/core/v1.Affinity":                                    schema_k8sio_api_core_v1_Affinity(ref),
+		"k8s.io/api/core/v1.AttachedVolume":                              schema_k8sio_api_core_v1_AttachedVolume(ref),
+		"k8s.io/api/core/v1.AvoidPods":                                   schema_k8sio_api_core_v1_AvoidPods(ref),
+		"k8s.io/api/core/v1.AzureDiskVolumeSource":                       schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":             schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFileVolumeSource":                       schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
 		"k8s.io/api/core/v1.Binding":                                     schema_k8sio_api_core_v1_Binding(ref),
 		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                   schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
 		"k8s.io/api/core/v1.CSIVolumeSource":                             schema_k8sio_api_core_v1_CSIVolumeSource(ref),
@@ -494,6 +549,113 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_pkg_apis_adminacornio_v1_ClusterAppPolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.AppPolicyRuleExpression"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.AppPolicyRuleExpression", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_adminacornio_v1_ClusterAppPolicyRuleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterAppPolicyRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ClusterAppPolicyRule", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_adminacornio_v1_ClusterComputeClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -563,6 +725,12 @@ func schema_pkg_apis_adminacornio_v1_ClusterComputeClass(ref common.ReferenceCal
 							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory"),
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU"),
+						},
+					},
 					"supportedRegions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -588,7 +756,7 @@ func schema_pkg_apis_adminacornio_v1_ClusterComputeClass(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -783,6 +951,113 @@ func schema_pkg_apis_adminacornio_v1_ClusterVolumeClassList(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_adminacornio_v1_ProjectAppPolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.AppPolicyRuleExpression"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.AppPolicyRuleExpression", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_adminacornio_v1_ProjectAppPolicyRuleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectAppPolicyRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1.ProjectAppPolicyRule", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_adminacornio_v1_ProjectComputeClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -852,6 +1127,12 @@ func schema_pkg_apis_adminacornio_v1_ProjectComputeClass(ref common.ReferenceCal
 							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory"),
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU"),
+						},
+					},
 					"supportedRegions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -877,7 +1158,7 @@ func schema_pkg_apis_adminacornio_v1_ProjectComputeClass(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1314,11 +1595,16 @@ func schema_pkg_apis_apiacornio_v1_Acornfile(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Acorn", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Image", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Router", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Secret", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Service", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRequest"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Acorn", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Image", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Router", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Secret", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Service", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRequest"},
 	}
 }
 
@@ -1416,7 +1702,7 @@ func schema_pkg_apis_apiacornio_v1_AppList(ref common.ReferenceCallback) common.
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_AppPullImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_AppPromote(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1442,6 +1728,13 @@ func schema_pkg_apis_apiacornio_v1_AppPullImage(ref common.ReferenceCallback) co
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
+					"full": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Full promotes the new revision without going through the remaining steps of the rollout",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1450,7 +1743,7 @@ func schema_pkg_apis_apiacornio_v1_AppPullImage(ref common.ReferenceCallback) co
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_Builder(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_AppPullImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1476,21 +1769,15 @@ func schema_pkg_apis_apiacornio_v1_Builder(ref common.ReferenceCallback) common.
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstanceStatus"),
-						},
-					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_BuilderList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_AppRollback(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1513,32 +1800,25 @@ func schema_pkg_apis_apiacornio_v1_BuilderList(ref common.ReferenceCallback) com
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Builder"),
-									},
-								},
-							},
+							Description: "Revision is the revision of the app history to go back to. If it is not set the rollout in progress is aborted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Builder", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_BuilderPortOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_AppRunJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1558,11 +1838,147 @@ func schema_pkg_apis_apiacornio_v1_BuilderPortOptions(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"jobName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobName is the name of the scheduled job of the app to run",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunName is the name of the run that was started, it is set in the response",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_Builder(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstanceStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuilderInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_BuilderList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Builder"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Builder", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_BuilderPortOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
 
 func schema_pkg_apis_apiacornio_v1_ComputeClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
@@ -1596,6 +2012,12 @@ func schema_pkg_apis_apiacornio_v1_ComputeClass(ref common.ReferenceCallback) co
 							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory"),
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU"),
+						},
+					},
 					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -1628,7 +2050,7 @@ func schema_pkg_apis_apiacornio_v1_ComputeClass(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1787,6 +2209,12 @@ func schema_pkg_apis_apiacornio_v1_Config(ref common.ReferenceCallback) common.O
 							Format: "",
 						},
 					},
+					"builderCacheSize": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"internalRegistryPrefix": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -1839,6 +2267,18 @@ func schema_pkg_apis_apiacornio_v1_Config(ref common.ReferenceCallback) common.O
 							Format: "int64",
 						},
 					},
+					"workloadCPUDefault": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"workloadCPUMaximum": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
 					"useCustomCABundle": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
@@ -1953,7 +2393,7 @@ func schema_pkg_apis_apiacornio_v1_Config(ref common.ReferenceCallback) common.O
 						},
 					},
 				},
-				Required: []string{"ingressClassName", "clusterDomains", "letsEncrypt", "letsEncryptEmail", "letsEncryptTOSAgree", "setPodSecurityEnforceProfile", "podSecurityEnforceProfile", "httpEndpointPattern", "internalClusterDomain", "acornDNS", "acornDNSEndpoint", "autoUpgradeInterval", "recordBuilds", "publishBuilders", "builderPerProject", "internalRegistryPrefix", "ignoreUserLabelsAndAnnotations", "allowUserLabels", "allowUserAnnotations", "workloadMemoryDefault", "workloadMemoryMaximum", "useCustomCABundle", "propagateProjectAnnotations", "propagateProjectLabels", "manageVolumeClasses", "networkPolicies", "ingressControllerNamespace", "allowTrafficFromNamespace", "serviceLBAnnotations", "awsIdentityProviderArn", "eventTTL", "features", "certManagerIssuer"},
+				Required: []string{"ingressClassName", "clusterDomains", "letsEncrypt", "letsEncryptEmail", "letsEncryptTOSAgree", "setPodSecurityEnforceProfile", "podSecurityEnforceProfile", "httpEndpointPattern", "internalClusterDomain", "acornDNS", "acornDNSEndpoint", "autoUpgradeInterval", "recordBuilds", "publishBuilders", "builderPerProject", "builderCacheSize", "internalRegistryPrefix", "ignoreUserLabelsAndAnnotations", "allowUserLabels", "allowUserAnnotations", "workloadMemoryDefault", "workloadMemoryMaximum", "workloadCPUDefault", "workloadCPUMaximum", "useCustomCABundle", "propagateProjectAnnotations", "propagateProjectLabels", "manageVolumeClasses", "networkPolicies", "ingressControllerNamespace", "allowTrafficFromNamespace", "serviceLBAnnotations", "awsIdentityProviderArn", "eventTTL", "features", "certManagerIssuer"},
 			},
 		},
 	}
@@ -2380,6 +2820,12 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCall
 							Format: "int64",
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is a quantity such as \"500m\" or 2. As with Kubernetes, a bare number is a count of cores.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 					"metrics": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Metrics is available on containers and jobs, but not sidecars",
@@ -2394,6 +2840,12 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCall
 							Format:      "int32",
 						},
 					},
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscale is only available on containers, not sidecars or jobs. It is set when scale is defined as an object instead of a fixed replica count.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale"),
+						},
+					},
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Schedule is only available on jobs",
@@ -2401,6 +2853,40 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Concurrency is only available on scheduled jobs. It decides what happens when a run is due while the previous run is still active, replace is the default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend is only available on scheduled jobs. No new runs are scheduled while it is set.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"deadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadlineSeconds is only available on jobs. A run that is active for longer is stopped and fails.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"successfulHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessfulHistory and FailedHistory are only available on scheduled jobs. They are the number of finished runs that are kept, 1 successful and 3 failed runs by default.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedHistory": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
 					"events": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Events is only available on jobs",
@@ -2423,6 +2909,12 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"dev": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dev configures how dev mode applies source changes to the container, it is ignored outside of dev mode",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevConfig"),
+						},
+					},
 					"sidecars": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Sidecars are not available on sidecars",
@@ -2473,7 +2965,7 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevConfig", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -2968,6 +3460,12 @@ func schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref common.ReferenceCallbac
 							Format: "int64",
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is a quantity such as \"500m\" or 2. As with Kubernetes, a bare number is a count of cores.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 					"metrics": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Metrics is available on containers and jobs, but not sidecars",
@@ -2982,6 +3480,12 @@ func schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref common.ReferenceCallbac
 							Format:      "int32",
 						},
 					},
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscale is only available on containers, not sidecars or jobs. It is set when scale is defined as an object instead of a fixed replica count.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale"),
+						},
+					},
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Schedule is only available on jobs",
@@ -2989,6 +3493,40 @@ func schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Concurrency is only available on scheduled jobs. It decides what happens when a run is due while the previous run is still active, replace is the default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend is only available on scheduled jobs. No new runs are scheduled while it is set.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"deadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadlineSeconds is only available on jobs. A run that is active for longer is stopped and fails.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"successfulHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessfulHistory and FailedHistory are only available on scheduled jobs. They are the number of finished runs that are kept, 1 successful and 3 failed runs by default.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedHistory": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
 					"events": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Events is only available on jobs",
@@ -3011,6 +3549,12 @@ func schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"dev": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dev configures how dev mode applies source changes to the container, it is ignored outside of dev mode",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevConfig"),
+						},
+					},
 					"sidecars": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Sidecars are not available on sidecars",
@@ -3031,7 +3575,7 @@ func schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevConfig", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -3155,6 +3699,13 @@ func schema_pkg_apis_apiacornio_v1_Event(ref common.ReferenceCallback) common.Op
 							},
 						},
 					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of repeated events that were collapsed into this one. It's only set when events are queried with collapse.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"type", "actor", "source", "observed"},
 			},
@@ -3212,7 +3763,7 @@ func schema_pkg_apis_apiacornio_v1_EventList(ref common.ReferenceCallback) commo
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_IgnoreCleanup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_EventSubscription(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3238,15 +3789,27 @@ func schema_pkg_apis_apiacornio_v1_IgnoreCleanup(ref common.ReferenceCallback) c
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_Image(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_EventSubscriptionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3269,12 +3832,94 @@ func schema_pkg_apis_apiacornio_v1_Image(ref common.ReferenceCallback) common.Op
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"remote": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventSubscription"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventSubscription", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_IgnoreCleanup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_Image(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"remote": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
@@ -3359,11 +4004,17 @@ func schema_pkg_apis_apiacornio_v1_ImageAllowRule(ref common.ReferenceCallback)
 							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSignatures"),
 						},
 					},
+					"sbom": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSBOM"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSignatures", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSBOM", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSignatures", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -3415,6 +4066,36 @@ func schema_pkg_apis_apiacornio_v1_ImageAllowRuleList(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_ImageAllowRuleResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"allowed": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_ImageDetails(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3481,6 +4162,12 @@ func schema_pkg_apis_apiacornio_v1_ImageDetails(ref common.ReferenceCallback) co
 							Ref: ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth"),
 						},
 					},
+					"includeSBOM": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
 					"appImage": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Output Params",
@@ -3504,11 +4191,24 @@ func schema_pkg_apis_apiacornio_v1_ImageDetails(ref common.ReferenceCallback) co
 							Format: "",
 						},
 					},
+					"sboms": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSBOM"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ParamSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageSBOM", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ParamSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -3626,47 +4326,62 @@ func schema_pkg_apis_apiacornio_v1_ImagePush(ref common.ReferenceCallback) commo
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_ImageTag(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_ImageSBOM(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ImageSBOM summarizes a SBOM that is stored next to an app image",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Target is the part of the app image the SBOM describes, like acornfile or containers/web",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"format": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"metadata": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"tag": {
+					"packages": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Packages is the number of packages in the SBOM",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"packageTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PackageTypes is the number of packages of each type, like apk, deb or oci",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_Info(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_ImageSign(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3692,44 +4407,41 @@ func schema_pkg_apis_apiacornio_v1_Info(ref common.ReferenceCallback) common.Ope
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"regions": {
+					"payload": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec"),
-									},
-								},
-							},
+							Description: "Input Params",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
-					"extraData": {
+					"signature": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"publicKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"signatureDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Output Params",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_InfoList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_ImageTag(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3752,59 +4464,261 @@ func schema_pkg_apis_apiacornio_v1_InfoList(ref common.ReferenceCallback) common
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"tag": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Info"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Info", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_InfoSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_ImageVerify(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tag": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"gitCommit": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"dirty": {
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Input Params",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth"),
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations"),
+						},
+					},
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules are the names of the ImageAllowRules to verify the image against, all rules of the project if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Output Params",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verified": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"results": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageAllowRuleResult"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ImageAllowRuleResult", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_Info(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"regions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec"),
+									},
+								},
+							},
+						},
+					},
+					"extraData": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.InfoSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_InfoList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Info"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Info", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_InfoSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"tag": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"gitCommit": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"dirty": {
 						SchemaProps: spec.SchemaProps{
 							Default: false,
 							Type:    []string{"boolean"},
@@ -3884,8 +4798,16 @@ func schema_pkg_apis_apiacornio_v1_LogMessage(ref common.ReferenceCallback) comm
 					},
 					"containerName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ContainerName is the name of the container replica that produced the line",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Container is the name of the container, sidecar or job in the Acornfile",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"time": {
@@ -3958,6 +4880,41 @@ func schema_pkg_apis_apiacornio_v1_LogOptions(ref common.ReferenceCallback) comm
 							Format: "",
 						},
 					},
+					"until": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"grep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Grep is a regular expression, only lines that match it are returned",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exclude": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exclude is a regular expression, lines that match it are dropped",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"where": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Where are conditions like level=error or level!=debug on the fields of lines that are JSON objects",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -4134,9 +5091,17 @@ func schema_pkg_apis_apiacornio_v1_ProjectSpec(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"logSink": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogSink receives the logs of all apps in the project that do not define their own log sink",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.LogSink"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.LogSink"},
 	}
 }
 
@@ -4603,7 +5568,7 @@ func schema_pkg_apis_apiacornio_v1_Volume(ref common.ReferenceCallback) common.O
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_VolumeClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_VolumeArchiveOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -4623,13 +5588,46 @@ func schema_pkg_apis_apiacornio_v1_VolumeClass(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Path is the directory inside the volume to archive, defaults to the root of the volume",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"storageClassName": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_VolumeClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"storageClassName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
@@ -4844,6 +5842,100 @@ func schema_pkg_apis_apiacornio_v1_VolumeList(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_VolumeSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_VolumeSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshot"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_VolumeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5113,6 +6205,19 @@ func schema_pkg_apis_internalacornio_v1_Acorn(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
 					"computeClasses": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
@@ -5132,7 +6237,7 @@ func schema_pkg_apis_internalacornio_v1_Acorn(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuild", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuild", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -5370,11 +6475,30 @@ func schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceSpec(ref common.R
 							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VCS"),
 						},
 					},
+					"sbom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SBOM is the format (spdx or cyclonedx) of the SBOMs that are stored next to the app image, none if empty",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache is added to the cache configuration of every build of the Acornfile",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuildCache"),
+						},
+					},
+					"reuse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reuse are the images of a previous build of the Acornfile. The containers, sidecars, jobs and images whose build is unchanged since then use these images instead of being built again.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImagesData"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Platform", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VCS"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuildCache", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImagesData", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Platform", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VCS"},
 	}
 }
 
@@ -5553,6 +6677,18 @@ func schema_pkg_apis_internalacornio_v1_AppColumns(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"current": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"message": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -5952,11 +7088,29 @@ func schema_pkg_apis_internalacornio_v1_AppInstanceSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"logSink": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.LogSink"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.LogSink", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -6076,40 +7230,108 @@ func schema_pkg_apis_internalacornio_v1_AppInstanceStatus(ref common.ReferenceCa
 							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults"),
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStatus"),
+						},
+					},
+					"revisions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevision"),
+									},
+								},
+							},
+						},
+					},
+					"volumeRestores": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRestore"),
+									},
+								},
+							},
+						},
+					},
+					"changeCause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangeCause is set by auto-upgrades and rollbacks that change the app image without an update of the app. It is recorded as the trigger of the next revision.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollbackRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RollbackRevision is the revision the app is rolled back to. The image of the revision is pulled by its digest and deployed under the image reference of the revision.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevision"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Scheduling"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevision", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Scheduling", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRestore"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_AppSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_AppRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AppRevision is a deployed combination of app image, deploy args and profiles. The newest revision is the last one.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"labels": {
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"imageID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"deployArgs": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Type:   []string{"object"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"annotations": {
+					"profiles": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -6120,18 +7342,80 @@ func schema_pkg_apis_internalacornio_v1_AppSpec(ref common.ReferenceCallback) co
 							},
 						},
 					},
-					"containers": {
+					"trigger": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"updatedBy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AppSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"containers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container"),
+									},
+								},
+							},
 						},
 					},
 					"jobs": {
@@ -6232,11 +7516,16 @@ func schema_pkg_apis_internalacornio_v1_AppSpec(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Acorn", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Image", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Router", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Secret", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Service", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRequest"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Acorn", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Image", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Router", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Secret", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Service", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRequest"},
 	}
 }
 
@@ -6371,6 +7660,123 @@ func schema_pkg_apis_internalacornio_v1_AppStatus(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_Autoscale(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"targetCPU": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"targetMemory": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"metric": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleMetric"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleMetric"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AutoscaleMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutoscaleMetric scales on a per pod custom metric scraped from the port and path defined in the container's metrics",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"targetAverageValue": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AutoscaleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"currentReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"desiredReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"lastScaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastDecision": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_Build(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6437,6 +7843,46 @@ func schema_pkg_apis_internalacornio_v1_Build(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuildCache"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuildCache"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_BuildCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BuildCache configures the buildkit cache that is imported before and exported after a build. Entries use the buildx syntax, like \"type=registry,ref=ghcr.io/acme/app:cache\" or \"type=local,dest=app\", a plain image reference is a registry cache.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 				},
 			},
 		},
@@ -7029,6 +8475,12 @@ func schema_pkg_apis_internalacornio_v1_Container(ref common.ReferenceCallback)
 							Format: "int64",
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is a quantity such as \"500m\" or 2. As with Kubernetes, a bare number is a count of cores.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 					"metrics": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Metrics is available on containers and jobs, but not sidecars",
@@ -7043,6 +8495,12 @@ func schema_pkg_apis_internalacornio_v1_Container(ref common.ReferenceCallback)
 							Format:      "int32",
 						},
 					},
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscale is only available on containers, not sidecars or jobs. It is set when scale is defined as an object instead of a fixed replica count.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale"),
+						},
+					},
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Schedule is only available on jobs",
@@ -7050,6 +8508,40 @@ func schema_pkg_apis_internalacornio_v1_Container(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Concurrency is only available on scheduled jobs. It decides what happens when a run is due while the previous run is still active, replace is the default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend is only available on scheduled jobs. No new runs are scheduled while it is set.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"deadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadlineSeconds is only available on jobs. A run that is active for longer is stopped and fails.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"successfulHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessfulHistory and FailedHistory are only available on scheduled jobs. They are the number of finished runs that are kept, 1 successful and 3 failed runs by default.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedHistory": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
 					"events": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Events is only available on jobs",
@@ -7072,6 +8564,12 @@ func schema_pkg_apis_internalacornio_v1_Container(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"dev": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dev configures how dev mode applies source changes to the container, it is ignored outside of dev mode",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevConfig"),
+						},
+					},
 					"sidecars": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Sidecars are not available on sidecars",
@@ -7092,7 +8590,7 @@ func schema_pkg_apis_internalacornio_v1_Container(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevConfig", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -7170,25 +8668,62 @@ func schema_pkg_apis_internalacornio_v1_ContainerImageBuilderSpec(ref common.Ref
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_ContainerRolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ContainerRolloutStatus is the status of the deployment of the new revision of a container during a rollout",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ready": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"upToDate": {
+					"readyCount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"defined": {
+					"desiredCount": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"upToDate": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"defined": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
 							Format: "",
@@ -7285,11 +8820,21 @@ func schema_pkg_apis_internalacornio_v1_ContainerStatus(ref common.ReferenceCall
 							},
 						},
 					},
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleStatus"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerRolloutStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerRolloutStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError"},
 	}
 }
 
@@ -7327,6 +8872,20 @@ func schema_pkg_apis_internalacornio_v1_Defaults(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"integer"},
+										Format: "int64",
+									},
+								},
+							},
+						},
+					},
 					"region": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -7413,6 +8972,60 @@ func schema_pkg_apis_internalacornio_v1_DependencyStatus(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_DevConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"onChange": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevOnChange"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevOnChange"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_DevOnChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DevOnChange applies source changes to a running container in dev mode, instead of rebuilding its image",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sync are the local directories that are synced into the container, in the format \"LOCAL:REMOTE\", like \"./src:/app/src\". The local directory is relative to the Acornfile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"run": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Run is the shell command that runs in the container after the synced changes are copied into it",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_DevSessionImageSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7816,6 +9429,13 @@ func schema_pkg_apis_internalacornio_v1_EventInstance(ref common.ReferenceCallba
 							},
 						},
 					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of repeated events that were collapsed into this one. It's only set when events are queried with collapse.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"type", "actor", "source", "observed"},
 			},
@@ -7911,102 +9531,197 @@ func schema_pkg_apis_internalacornio_v1_EventSource(ref common.ReferenceCallback
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_ExecProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_EventSubscriptionInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"command": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_ExpressionError(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dependencyNotFound": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyNotFound"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"expression": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"error": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstance"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyNotFound"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstance", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_File(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"mode": {
+					"types": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Types of the events to deliver, all types are delivered if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"content": {
+					"severities": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Severities of the events to deliver, all severities are delivered if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"secret": {
+					"sourceKinds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceKinds of the events to deliver, like \"app\", all kinds are delivered if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"apps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Apps are the names of the apps whose events are delivered, the events of all apps are delivered if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"webhook": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"),
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventWebhook"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventWebhook"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_GeneratedService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"job": {
+					"lastDelivered": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastFailed": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
@@ -8015,10 +9730,12 @@ func schema_pkg_apis_internalacornio_v1_GeneratedService(ref common.ReferenceCal
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_HTTPProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_EventWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -8026,13 +9743,195 @@ func schema_pkg_apis_internalacornio_v1_HTTPProbe(ref common.ReferenceCallback)
 				Properties: map[string]spec.Schema{
 					"url": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "URL receives a POST request with the event as JSON",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"headers": {
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "SecretName is a secret in the project. The value of its \"token\" key is used to sign the requests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_ExecProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_ExpressionError(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dependencyNotFound": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyNotFound"),
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyNotFound"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_File(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_FileLogSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_GeneratedService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_HTTPLogSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret is the name of a secret in the project with the URL in the url key. URLs with credentials should be stored in a secret, so they aren't visible in the spec of the app or project.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_HTTPProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -8128,11 +10027,17 @@ func schema_pkg_apis_internalacornio_v1_ImageAllowRuleInstance(ref common.Refere
 							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSignatures"),
 						},
 					},
+					"sbom": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSBOM"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSignatures", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSBOM", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleSignatures", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -8184,6 +10089,41 @@ func schema_pkg_apis_internalacornio_v1_ImageAllowRuleInstanceList(ref common.Re
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_ImageAllowRuleSBOM(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImageAllowRuleSBOM describes the SBOMs that images must have, see acorn build --sbom",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required denies images that do not have a SBOM stored next to them",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"formats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Formats the SBOM must be in (spdx, cyclonedx), any format if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_ImageAllowRuleSignatures(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8457,6 +10397,57 @@ func schema_pkg_apis_internalacornio_v1_ImagesData(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_JobRun(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Kubernetes job of the run",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"manual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manual is set for runs that were started with acorn job run instead of the schedule",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the reason a failed run failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_JobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8566,11 +10557,152 @@ func schema_pkg_apis_internalacornio_v1_JobStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
-				},
+					"suspended": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspended is set for scheduled jobs that don't schedule new runs",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Runs are the runs of the job that are kept, newest first",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.JobRun"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.JobRun"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_KeylessIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KeylessIdentity matches the OIDC issuer and the subject (e.g. email or workflow URI) of a signing certificate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuer": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"issuerRegexp": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"subjectRegexp": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_KeylessSigner(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KeylessSigner describes the identities that keyless signatures must be made by and the roots that their certificates and transparency log entries are verified against",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"identities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Identities the signing certificate must match, one match is good enough",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.KeylessIdentity"),
+									},
+								},
+							},
+						},
+					},
+					"certificateAuthority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthority is the PEM encoded root (and optional intermediate) certificates that signing certificates must chain up to. Defaults to the public Sigstore Fulcio roots.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"transparencyLogKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransparencyLogKey is the PEM encoded public key of the transparency log that signatures must be included in. Defaults to the public Sigstore Rekor key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ignoreTransparencyLog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IgnoreTransparencyLog skips the check that the signature was included in the transparency log",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.KeylessIdentity"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_LogSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogSink is an endpoint that receives the logs of all containers, jobs and sidecars of an app",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP posts batches of log entries as a JSON array to an endpoint",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HTTPLogSink"),
+						},
+					},
+					"syslog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Syslog sends every log entry as a RFC 5424 message to a syslog server",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SyslogLogSink"),
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File appends log entries as JSON lines to a file on a volume of the app",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.FileLogSink"),
+						},
+					},
+				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.FileLogSink", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HTTPLogSink", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SyslogLogSink"},
 	}
 }
 
@@ -9213,264 +11345,225 @@ func schema_pkg_apis_internalacornio_v1_ReplicasSummary(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_Route(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_Rollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Rollout defines how the containers of the app are updated when the app image changes",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"path": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"targetServiceName": {
+					"strategy": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"targetPort": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"pathType": {
+					"steps": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStep"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStep"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_Router(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_RolloutRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "RolloutRevision is the app image and the spec parsed from it that containers are deployed from",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"labels": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"annotations": {
+					"appImage": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage"),
 						},
 					},
-					"routes": {
+					"appSpec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Route"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Route"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_RouterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ready": {
+					"strategy": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"upToDate": {
+					"stable": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Stable is the revision that is kept running until the rollout of a new app image is promoted",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutRevision"),
 						},
 					},
-					"defined": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Digest is the digest of the app image being rolled out",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"linkOverride": {
+					"step": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"stepStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is true when all containers of the new revision are ready",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"promote": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Promote and PromoteFull are set by the apps/promote subresource and cleared once the rollout has moved on",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"promoteFull": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"transitioningMessages": {
+					"rolledBackImage": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "RolledBackImage and RolledBackDigest are the app image of the last rollout that was rolled back. Auto-upgrades skip this image so that the app is not upgraded to it again.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"errorMessages": {
+					"rolledBackDigest": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutRevision", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_Scheduling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_RolloutStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "RolloutStep sends Weight percent of the traffic to the new revision of a canary rollout. Once the new revision is ready the rollout moves to the next step after Pause, or waits to be promoted if Pause is not set.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"requirements": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/api/core/v1.ResourceRequirements"),
-						},
-					},
-					"affinity": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.Affinity"),
-						},
-					},
-					"tolerations": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"priorityClassName": {
+					"pause": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_ScopedLabel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_Route(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resourceType": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"resourceName": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"key": {
+					"targetServiceName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"value": {
+					"targetPort": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_internalacornio_v1_Secret(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"external": {
+					"pathType": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"alias": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Targets split the requests of the route by weight across multiple services, instead of TargetServiceName",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget"),
+									},
+								},
+							},
 						},
 					},
-					"labels": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "Headers and Query are the request headers and query parameters that must have exactly these values, in addition to the path, for a request to match the route. The first route that matches a request is used.",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -9483,7 +11576,7 @@ func schema_pkg_apis_internalacornio_v1_Secret(ref common.ReferenceCallback) com
 							},
 						},
 					},
-					"annotations": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
@@ -9498,63 +11591,51 @@ func schema_pkg_apis_internalacornio_v1_Secret(ref common.ReferenceCallback) com
 							},
 						},
 					},
-					"type": {
+					"rewrite": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Rewrite replaces the matched path before the request is sent to the target, \"/\" strips it",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"params": {
+					"redirect": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"object"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Redirect responds with a redirect instead of sending the request to a target",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteRedirect"),
 						},
 					},
-					"data": {
+					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "TimeoutSeconds is how long the router waits for the target to send or receive data, 60 seconds if not set",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteRedirect", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_RouteRedirect(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secret": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"target": {
+					"statusCode": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "StatusCode is 301, 302, 303, 307 or 308, 302 if not set",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
@@ -9563,28 +11644,29 @@ func schema_pkg_apis_internalacornio_v1_SecretBinding(ref common.ReferenceCallba
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_RouteTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"targetServiceName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"key": {
+					"targetPort": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"onChange": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Weight is the share of the requests of the route that the target receives, relative to the weights of the other targets. It is 1 if not set, targets with a weight of 0 receive no requests.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
@@ -9593,40 +11675,32 @@ func schema_pkg_apis_internalacornio_v1_SecretReference(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_SecretStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_Router(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ready": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"upToDate": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"defined": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"linkOverride": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"transitioningMessages": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -9637,53 +11711,58 @@ func schema_pkg_apis_internalacornio_v1_SecretStatus(ref common.ReferenceCallbac
 							},
 						},
 					},
-					"errorMessages": {
+					"routes": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Route"),
 									},
 								},
 							},
 						},
 					},
-					"secretName": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Route"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_RouterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ready": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"jobName": {
+					"upToDate": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"jobReady": {
+					"defined": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"lookupErrors": {
+					"linkOverride": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"lookupTransitioning": {
+					"transitioningMessages": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -9697,7 +11776,7 @@ func schema_pkg_apis_internalacornio_v1_SecretStatus(ref common.ReferenceCallbac
 							},
 						},
 					},
-					"dataKeys": {
+					"errorMessages": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -9717,52 +11796,431 @@ func schema_pkg_apis_internalacornio_v1_SecretStatus(ref common.ReferenceCallbac
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_Service(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_Scheduling(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"labels": {
+					"requirements": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
-					"annotations": {
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"tolerations": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel"),
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
 									},
 								},
 							},
 						},
 					},
-					"default": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"external": {
+					"priorityClassName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"alias": {
-						SchemaProps: spec.SchemaProps{
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_ScopedLabel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_Secret(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"external": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"params": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"object"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"rotate": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretRotate"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretRotate"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"onChange": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_SecretRotate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretRotate replaces the value of a generated or token secret every interval. The previous value is kept under the previous key for the grace period, so consumers can switch over.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"every": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"grace": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_SecretStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"upToDate": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"defined": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"linkOverride": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"transitioningMessages": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"errorMessages": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"jobName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"jobReady": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"lookupErrors": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lookupTransitioning": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"dataKeys": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_Service(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel"),
+									},
+								},
+							},
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"external": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"alias": {
+						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
@@ -9907,11 +12365,24 @@ func schema_pkg_apis_internalacornio_v1_Service(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuild", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GeneratedService", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuild", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GeneratedService", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -10449,47 +12920,309 @@ func schema_pkg_apis_internalacornio_v1_SignatureAnnotations(ref common.Referenc
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement"},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_SignatureRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"signedBy": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignedBy"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignedBy"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_SignedBy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"anyOf": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"allOf": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"keyless": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Keyless matches signatures made with short-lived certificates that were issued to an OIDC identity, e.g. by Fulcio",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.KeylessSigner"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.KeylessSigner"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_SyslogLogSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is udp or tcp, udp is the default",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_TCPProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_VCS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"remotes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"modified": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_VolumeBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"accessModes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_VolumeDefault(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"accessModes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_SignatureRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_VolumeMount(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"signedBy": {
+					"volume": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignedBy"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"annotations": {
+					"subPath": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"contextDir": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secret": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations"),
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSecretMount"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureAnnotations", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignedBy"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSecretMount"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_SignedBy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_VolumeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"anyOf": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -10500,7 +13233,34 @@ func schema_pkg_apis_internalacornio_v1_SignedBy(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"allOf": {
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"accessModes": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -10514,59 +13274,81 @@ func schema_pkg_apis_internalacornio_v1_SignedBy(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_TCPProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_VolumeRestore(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VolumeRestore records the snapshot a volume is restored from. The snapshot is only looked up once, so it can be deleted after the restore without affecting the app.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"snapshot": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Snapshot is the name of the VolumeSnapshotInstance the volume is restored from",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is how the snapshot was taken, either \"csi\" or \"copy\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"snapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SnapshotName is the name of the VolumeSnapshot or PersistentVolumeClaim in the app namespace holding the data",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"restoreSize": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"completed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Completed is true once the data has been restored into the volume",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_VCS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_VolumeSecretMount(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"remotes": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"revision": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"modified": {
+					"onChange": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
@@ -10576,189 +13358,190 @@ func schema_pkg_apis_internalacornio_v1_VCS(ref common.ReferenceCallback) common
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_VolumeBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"volume": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"target": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"size": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"accessModes": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec"),
 						},
 					},
-					"class": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_VolumeDefault(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"class": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"size": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"accessModes": {
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstance"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstance", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_VolumeMount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"volume": {
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the volume (as shown by \"acorn volumes\") to snapshot",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is how the snapshot was taken, either \"csi\" or \"copy\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"subPath": {
+					"appNamespace": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"contextDir": {
+					"volumeName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"secret": {
+					"claimName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSecretMount"),
+							Description: "ClaimName is the PersistentVolumeClaim in the app namespace that was snapshotted",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSecretMount"},
-	}
-}
-
-func schema_pkg_apis_internalacornio_v1_VolumeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"labels": {
+					"className": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ClassName is the VolumeSnapshotClass for the \"csi\" method or the StorageClass of the copy for the \"copy\" method",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"annotations": {
+					"snapshotName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "SnapshotName is the name of the VolumeSnapshot or PersistentVolumeClaim in the app namespace holding the data",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"class": {
+					"readyToUse": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"size": {
+					"restoreSize": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"accessModes": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition"),
 									},
 								},
 							},
@@ -10767,30 +13550,8 @@ func schema_pkg_apis_internalacornio_v1_VolumeRequest(ref common.ReferenceCallba
 				},
 			},
 		},
-	}
-}
-
-func schema_pkg_apis_internalacornio_v1_VolumeSecretMount(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"onChange": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-				},
-			},
-		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -11047,71 +13808,295 @@ func schema_pkg_apis_internalacornio_v1_envVal(ref common.ReferenceCallback) com
 							Format: "",
 						},
 					},
-					"value": {
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_policyRuleAliases(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"verb": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"apiGroup": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_routeValue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "routeValue is a route without its path, the value of the map form of routes",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"targetServiceName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"targetPort": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"pathType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets split the requests of the route by weight across multiple services, instead of TargetServiceName",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget"),
+									},
+								},
+							},
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers and Query are the request headers and query parameters that must have exactly these values, in addition to the path, for a request to match the route. The first route that matches a request is used.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"rewrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rewrite replaces the matched path before the request is sent to the target, \"/\" strips it",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"redirect": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Redirect responds with a redirect instead of sending the request to a target",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteRedirect"),
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is how long the router waits for the target to send or receive data, 60 seconds if not set",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteRedirect", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_secretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"SecretReference": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"),
+						},
+					},
+					"Mode": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"SecretReference", "Mode"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_internaladminacornio_v1_AppPolicyRuleExpression(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AppPolicyRuleExpression is a CEL expression that must evaluate to true for an app to be allowed. The expression can use the variables \"app\" (the spec of the app), \"spec\" (the app spec resolved from the image, deploy args and profiles), \"name\" and \"project\", the namespace of the app.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Message is returned when the expression evaluates to false, the expression is returned if not set",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secret": {
+					"field": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"),
+							Description: "Field is the path of the field the violation is reported for, like \"spec.containers\"",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"expression"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_policyRuleAliases(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internaladminacornio_v1_ClusterAppPolicyRuleInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"verb": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"apiGroup": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resource": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"resourceName": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"scope": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"namespaces": {
+					"rules": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.AppPolicyRuleExpression"),
 									},
 								},
 							},
@@ -11120,64 +14105,56 @@ func schema_pkg_apis_internalacornio_v1_policyRuleAliases(ref common.ReferenceCa
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.AppPolicyRuleExpression", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_routeTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internaladminacornio_v1_ClusterAppPolicyRuleInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"pathType": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"targetPort": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targetServiceName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_internalacornio_v1_secretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"SecretReference": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"Mode": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterAppPolicyRuleInstance"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"SecretReference", "Mode"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterAppPolicyRuleInstance", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
@@ -11250,6 +14227,12 @@ func schema_pkg_apis_internaladminacornio_v1_ClusterComputeClassInstance(ref com
 							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory"),
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU"),
+						},
+					},
 					"supportedRegions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -11275,7 +14258,7 @@ func schema_pkg_apis_internaladminacornio_v1_ClusterComputeClassInstance(ref com
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -11470,6 +14453,50 @@ func schema_pkg_apis_internaladminacornio_v1_ClusterVolumeClassInstanceList(ref
 	}
 }
 
+func schema_pkg_apis_internaladminacornio_v1_ComputeClassCPU(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internaladminacornio_v1_ComputeClassMemory(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11514,6 +14541,113 @@ func schema_pkg_apis_internaladminacornio_v1_ComputeClassMemory(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_internaladminacornio_v1_ProjectAppPolicyRuleInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.AppPolicyRuleExpression"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.AppPolicyRuleExpression", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_internaladminacornio_v1_ProjectAppPolicyRuleInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectAppPolicyRuleInstance"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectAppPolicyRuleInstance", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_internaladminacornio_v1_ProjectComputeClassInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11583,6 +14717,12 @@ func schema_pkg_apis_internaladminacornio_v1_ProjectComputeClassInstance(ref com
 							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory"),
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU"),
+						},
+					},
 					"supportedRegions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -11608,7 +14748,7 @@ func schema_pkg_apis_internaladminacornio_v1_ProjectComputeClassInstance(ref com
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
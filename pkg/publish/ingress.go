@@ -302,7 +302,7 @@ func getIngressRule(svc *v1.ServiceInstance, host string, port int32) networking
 	// strip possible port in host
 	host, _, _ = strings.Cut(host, ":")
 
-	if len(svc.Spec.Routes) > 0 {
+	if len(svc.Spec.Routes) > 0 && !proxiedRoutes(svc.Spec.Routes) {
 		return routerRule(host, svc.Spec.Routes)
 	}
 
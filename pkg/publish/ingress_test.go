@@ -308,3 +308,60 @@ func Test_setupCertManager(t *testing.T) {
 		})
 	}
 }
+
+func Test_getIngressRule(t *testing.T) {
+	backend := func(name string, port int32) networkingv1.IngressBackend {
+		return networkingv1.IngressBackend{
+			Service: &networkingv1.IngressServiceBackend{
+				Name: name,
+				Port: networkingv1.ServiceBackendPort{Number: port},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		routes []v1.Route
+		want   []networkingv1.HTTPIngressPath
+	}{
+		{
+			name: "routes",
+			routes: []v1.Route{{
+				Path:              "/api",
+				PathType:          v1.PathTypeExact,
+				TargetServiceName: "api",
+				TargetPort:        8080,
+			}},
+			want: []networkingv1.HTTPIngressPath{{
+				Path:     "/api",
+				PathType: &[]networkingv1.PathType{networkingv1.PathTypeExact}[0],
+				Backend:  backend("api", 8080),
+			}},
+		},
+		{
+			name: "proxied routes",
+			routes: []v1.Route{{
+				Path:     "/api",
+				PathType: v1.PathTypeExact,
+				Targets:  []v1.RouteTarget{{TargetServiceName: "api"}, {TargetServiceName: "api-canary"}},
+			}},
+			want: []networkingv1.HTTPIngressPath{{
+				Path:     "/",
+				PathType: &[]networkingv1.PathType{networkingv1.PathTypePrefix}[0],
+				Backend:  backend("router", 80),
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &v1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "router"},
+				Spec:       v1.ServiceInstanceSpec{Routes: tt.routes},
+			}
+			got := getIngressRule(svc, "host:8080", 80)
+			if got.Host != "host" || !reflect.DeepEqual(got.HTTP.Paths, tt.want) {
+				t.Errorf("getIngressRule() = %v, want %v", got.HTTP.Paths, tt.want)
+			}
+		})
+	}
+}
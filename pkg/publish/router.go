@@ -5,6 +5,17 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 )
 
+// proxiedRoutes returns true if one of the routes needs the router proxy, then the ingress sends all requests to the
+// router proxy instead of straight to the targets of the routes
+func proxiedRoutes(routes []v1.Route) bool {
+	for _, route := range routes {
+		if route.Proxied() {
+			return true
+		}
+	}
+	return false
+}
+
 func routerRule(host string, routes []v1.Route) networkingv1.IngressRule {
 	rule := networkingv1.IngressRule{
 		Host: host,
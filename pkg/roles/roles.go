@@ -48,11 +48,13 @@ var (
 					"devsessions",
 					"images",
 					"volumes",
+					"volumesnapshots",
 					"containerreplicas",
 					"credentials",
 					"secrets",
 					"services",
 					"events",
+					"eventsubscriptions",
 				},
 			},
 			{
@@ -68,6 +70,7 @@ var (
 				Verbs: []string{"get", "create"},
 				Resources: []string{
 					"images/details",
+					"images/verify",
 				},
 			},
 			{
@@ -93,6 +96,8 @@ var (
 					"devsessions",
 					"credentials",
 					"secrets",
+					"volumesnapshots",
+					"eventsubscriptions",
 				},
 			},
 			{
@@ -105,9 +110,13 @@ var (
 				Verbs: []string{"create"},
 				Resources: []string{
 					"images/tag",
+					"images/sign",
 					"apps/confirmupgrade",
 					"apps/pullimage",
 					"apps/ignorecleanup",
+					"apps/promote",
+					"apps/rollback",
+					"apps/runjob",
 				},
 			},
 			{
@@ -125,6 +134,7 @@ var (
 					"images/pull",
 					"containerreplicas/exec",
 					"secrets/reveal",
+					"volumes/archive",
 				},
 			},
 		},
@@ -151,6 +161,8 @@ var (
 					"clustervolumeclasses",
 					"projectcomputeclasses",
 					"clustercomputeclasses",
+					"projectapppolicyrules",
+					"clusterapppolicyrules",
 				},
 				APIGroups: []string{admin_acorn_io.Group},
 			},
@@ -0,0 +1,352 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FormatSPDX      = "spdx"
+	FormatCycloneDX = "cyclonedx"
+
+	MediaTypeSPDX      = "application/spdx+json"
+	MediaTypeCycloneDX = "application/vnd.cyclonedx+json"
+
+	toolName = "acorn"
+)
+
+var (
+	Formats = []string{FormatSPDX, FormatCycloneDX}
+
+	spdxIDInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+)
+
+// MediaType returns the media type of the format, or an error if the format is not supported
+func MediaType(format string) (string, error) {
+	switch format {
+	case FormatSPDX:
+		return MediaTypeSPDX, nil
+	case FormatCycloneDX:
+		return MediaTypeCycloneDX, nil
+	}
+	return "", fmt.Errorf("unsupported SBOM format %q, must be one of %s", format, strings.Join(Formats, ", "))
+}
+
+// FormatForMediaType returns the format of the media type, or an empty string if it is not an SBOM media type
+func FormatForMediaType(mediaType string) string {
+	switch mediaType {
+	case MediaTypeSPDX:
+		return FormatSPDX
+	case MediaTypeCycloneDX:
+		return FormatCycloneDX
+	}
+	return ""
+}
+
+// Encode returns the SBOM document in the format, describing the subject with the packages
+func Encode(format, subject string, pkgs []Package) ([]byte, error) {
+	switch format {
+	case FormatSPDX:
+		return json.Marshal(toSPDX(subject, pkgs, time.Now()))
+	case FormatCycloneDX:
+		return json.Marshal(toCycloneDX(subject, pkgs, time.Now()))
+	}
+	_, err := MediaType(format)
+	return nil, err
+}
+
+// Decode returns the packages of a SBOM document in the format
+func Decode(format string, data []byte) ([]Package, error) {
+	switch format {
+	case FormatSPDX:
+		doc := spdxDocument{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		return doc.packages(), nil
+	case FormatCycloneDX:
+		doc := cycloneDXDocument{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		return doc.packages(), nil
+	}
+	_, err := MediaType(format)
+	return nil, err
+}
+
+// typeFromPURL returns the package type from a package URL like pkg:deb/debian/bash@5.1
+func typeFromPURL(purl string) string {
+	t, _, _ := strings.Cut(strings.TrimPrefix(purl, "pkg:"), "/")
+	return t
+}
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages,omitempty"`
+	Relationships     []spdxRelationship `json:"relationships,omitempty"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	CopyrightText    string            `json:"copyrightText"`
+	Checksums        []spdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+	PrimaryPurpose   string            `json:"primaryPackagePurpose,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+const spdxRootID = "SPDXRef-Subject"
+
+func toSPDX(subject string, pkgs []Package, now time.Time) spdxDocument {
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              subject,
+		DocumentNamespace: "https://acorn.io/spdx/" + spdxIDInvalidChars.ReplaceAllString(subject, "-") + "-" + uuid.NewString(),
+		CreationInfo: spdxCreationInfo{
+			Created:  now.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + toolName},
+		},
+		Packages: []spdxPackage{{
+			Name:             subject,
+			SPDXID:           spdxRootID,
+			DownloadLocation: "NOASSERTION",
+			LicenseConcluded: "NOASSERTION",
+			LicenseDeclared:  "NOASSERTION",
+			CopyrightText:    "NOASSERTION",
+			PrimaryPurpose:   "CONTAINER",
+		}},
+		Relationships: []spdxRelationship{{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: spdxRootID,
+		}},
+	}
+
+	for i, pkg := range pkgs {
+		p := spdxPackage{
+			Name:             pkg.Name,
+			SPDXID:           fmt.Sprintf("SPDXRef-Package-%s-%s-%d", pkg.Type, spdxIDInvalidChars.ReplaceAllString(pkg.Name, "-"), i),
+			VersionInfo:      pkg.Version,
+			DownloadLocation: "NOASSERTION",
+			LicenseConcluded: "NOASSERTION",
+			LicenseDeclared:  "NOASSERTION",
+			CopyrightText:    "NOASSERTION",
+		}
+		if pkg.License != "" {
+			// License strings of package managers are not always valid SPDX expressions, so they are only declared
+			p.LicenseDeclared = pkg.License
+		}
+		if alg, value, ok := strings.Cut(pkg.Digest, ":"); ok {
+			p.Checksums = append(p.Checksums, spdxChecksum{
+				Algorithm:     strings.ToUpper(alg),
+				ChecksumValue: value,
+			})
+		}
+		if purl := pkg.PURL(); purl != "" {
+			p.ExternalRefs = append(p.ExternalRefs, spdxExternalRef{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  purl,
+			})
+		}
+		switch pkg.Type {
+		case PackageTypeOCI:
+			p.PrimaryPurpose = "CONTAINER"
+		case PackageTypeAcornfile:
+			p.PrimaryPurpose = "FILE"
+		default:
+			p.PrimaryPurpose = "LIBRARY"
+		}
+
+		doc.Packages = append(doc.Packages, p)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      spdxRootID,
+			RelationshipType:   "CONTAINS",
+			RelatedSPDXElement: p.SPDXID,
+		})
+	}
+
+	return doc
+}
+
+func (d spdxDocument) packages() (result []Package) {
+	for _, p := range d.Packages {
+		if p.SPDXID == spdxRootID {
+			continue
+		}
+		pkg := Package{
+			Name:    p.Name,
+			Version: p.VersionInfo,
+		}
+		if p.LicenseDeclared != "NOASSERTION" {
+			pkg.License = p.LicenseDeclared
+		}
+		for _, ref := range p.ExternalRefs {
+			if ref.ReferenceType == "purl" {
+				pkg.Type = typeFromPURL(ref.ReferenceLocator)
+			}
+		}
+		if pkg.Type == "" && p.PrimaryPurpose == "FILE" {
+			pkg.Type = PackageTypeAcornfile
+		}
+		result = append(result, pkg)
+	}
+	return
+}
+
+type cycloneDXDocument struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components,omitempty"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools,omitempty"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Name string `json:"name"`
+}
+
+type cycloneDXComponent struct {
+	Type       string              `json:"type"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	PURL       string              `json:"purl,omitempty"`
+	Hashes     []cycloneDXHash     `json:"hashes,omitempty"`
+	Licenses   []cycloneDXLicense  `json:"licenses,omitempty"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDXLicense struct {
+	License cycloneDXLicenseName `json:"license"`
+}
+
+type cycloneDXLicenseName struct {
+	Name string `json:"name"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+const cycloneDXTypeProperty = "acorn:package:type"
+
+func toCycloneDX(subject string, pkgs []Package, now time.Time) cycloneDXDocument {
+	doc := cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.4",
+		SerialNumber: "urn:uuid:" + uuid.NewString(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: now.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Name: toolName}},
+			Component: cycloneDXComponent{
+				Type: "container",
+				Name: subject,
+			},
+		},
+	}
+
+	for _, pkg := range pkgs {
+		c := cycloneDXComponent{
+			Type:    "library",
+			Name:    pkg.Name,
+			Version: pkg.Version,
+			PURL:    pkg.PURL(),
+			Properties: []cycloneDXProperty{{
+				Name:  cycloneDXTypeProperty,
+				Value: pkg.Type,
+			}},
+		}
+		switch pkg.Type {
+		case PackageTypeOCI:
+			c.Type = "container"
+		case PackageTypeAcornfile:
+			c.Type = "file"
+		}
+		if alg, value, ok := strings.Cut(pkg.Digest, ":"); ok {
+			c.Hashes = append(c.Hashes, cycloneDXHash{
+				Alg:     strings.ToUpper(strings.Replace(alg, "sha", "SHA-", 1)),
+				Content: value,
+			})
+		}
+		if pkg.License != "" {
+			c.Licenses = append(c.Licenses, cycloneDXLicense{License: cycloneDXLicenseName{Name: pkg.License}})
+		}
+		doc.Components = append(doc.Components, c)
+	}
+
+	return doc
+}
+
+func (d cycloneDXDocument) packages() (result []Package) {
+	for _, c := range d.Components {
+		pkg := Package{
+			Name:    c.Name,
+			Version: c.Version,
+			Type:    typeFromPURL(c.PURL),
+		}
+		for _, p := range c.Properties {
+			if p.Name == cycloneDXTypeProperty {
+				pkg.Type = p.Value
+			}
+		}
+		if len(c.Licenses) > 0 {
+			pkg.License = c.Licenses[0].License.Name
+		}
+		result = append(result, pkg)
+	}
+	return
+}
@@ -0,0 +1,56 @@
+package sbom
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPackages = []Package{
+	{Name: "Acornfile", Type: PackageTypeAcornfile, Digest: "sha256:1234"},
+	{Name: "busybox", Version: "1.36.0-r9", Type: PackageTypeAPK, Arch: "x86_64", License: "GPL-2.0-only", Distro: "alpine"},
+	{Name: "containers/web", Type: PackageTypeOCI, Digest: "sha256:5678"},
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, format := range Formats {
+		t.Run(format, func(t *testing.T) {
+			data, err := Encode(format, "index.docker.io/library/app", testPackages)
+			require.NoError(t, err)
+			assert.True(t, json.Valid(data))
+
+			pkgs, err := Decode(format, data)
+			require.NoError(t, err)
+			require.Len(t, pkgs, len(testPackages))
+			for i, pkg := range pkgs {
+				assert.Equal(t, testPackages[i].Name, pkg.Name)
+				assert.Equal(t, testPackages[i].Version, pkg.Version)
+				assert.Equal(t, testPackages[i].Type, pkg.Type)
+				assert.Equal(t, testPackages[i].License, pkg.License)
+			}
+		})
+	}
+}
+
+func TestSPDXDocument(t *testing.T) {
+	data, err := Encode(FormatSPDX, "app", testPackages)
+	require.NoError(t, err)
+
+	doc := map[string]any{}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, "SPDX-2.3", doc["spdxVersion"])
+	// The subject and every package
+	assert.Len(t, doc["packages"], len(testPackages)+1)
+	assert.Len(t, doc["relationships"], len(testPackages)+1)
+}
+
+func TestUnsupportedFormat(t *testing.T) {
+	_, err := MediaType("syft")
+	assert.EqualError(t, err, `unsupported SBOM format "syft", must be one of spdx, cyclonedx`)
+	_, err = Encode("syft", "app", nil)
+	assert.Error(t, err)
+	assert.Equal(t, "", FormatForMediaType("application/json"))
+	assert.Equal(t, FormatCycloneDX, FormatForMediaType(MediaTypeCycloneDX))
+}
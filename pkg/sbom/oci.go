@@ -0,0 +1,159 @@
+package sbom
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	// AnnotationTarget is the part of the app image that the SBOM describes, like acornfile or containers/web
+	AnnotationTarget = "acorn.io/sbom-target"
+
+	TargetAcornfile = "acornfile"
+)
+
+// Document is a reference to a SBOM that is stored next to an image
+type Document struct {
+	Target string
+	Format string
+	Digest name.Digest
+}
+
+// Attach stores the SBOM document as an OCI artifact that refers to the subject. Registries that do not support the
+// referrers API are handled with the referrers tag schema.
+func Attach(subject name.Digest, target, format string, doc []byte, opts ...remote.Option) (name.Digest, error) {
+	mediaType, err := MediaType(format)
+	if err != nil {
+		return name.Digest{}, err
+	}
+
+	subjectDesc, err := remote.Head(subject, opts...)
+	if err != nil {
+		return name.Digest{}, fmt.Errorf("failed to get %s: %w", subject, err)
+	}
+
+	artifact := mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	artifact = mutate.ConfigMediaType(artifact, types.MediaType(mediaType))
+	artifact, err = mutate.Append(artifact, mutate.Addendum{
+		Layer:     static.NewLayer(doc, types.MediaType(mediaType)),
+		MediaType: types.MediaType(mediaType),
+	})
+	if err != nil {
+		return name.Digest{}, err
+	}
+	artifact = mutate.Annotations(artifact, map[string]string{AnnotationTarget: target}).(ggcrv1.Image)
+	artifact = mutate.Subject(artifact, ggcrv1.Descriptor{
+		MediaType: subjectDesc.MediaType,
+		Digest:    subjectDesc.Digest,
+		Size:      subjectDesc.Size,
+	}).(ggcrv1.Image)
+
+	digest, err := artifact.Digest()
+	if err != nil {
+		return name.Digest{}, err
+	}
+
+	ref := subject.Context().Digest(digest.String())
+	if err := remote.Write(ref, artifact, opts...); err != nil {
+		return name.Digest{}, fmt.Errorf("failed to write SBOM for %s: %w", target, err)
+	}
+	return ref, nil
+}
+
+// List returns the SBOMs that are stored next to the subject. SBOMs whose manifest does not refer to the subject are
+// left out.
+func List(subject name.Digest, opts ...remote.Option) ([]Document, error) {
+	referrers, err := remote.Referrers(subject, opts...)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list referrers of %s: %w", subject, err)
+	}
+
+	var result []Document
+	for _, desc := range referrers.Manifests {
+		format := FormatForMediaType(desc.ArtifactType)
+		if format == "" {
+			continue
+		}
+
+		// The referrers of the tag schema are only an index that anyone who can push to the repository can change, so
+		// the manifest is read to check that the SBOM refers to the subject. The tag schema also does not keep the
+		// annotations of the manifests.
+		ref := subject.Context().Digest(desc.Digest.String())
+		artifact, err := remote.Image(ref, opts...)
+		if err != nil {
+			return nil, err
+		}
+		manifest, err := artifact.Manifest()
+		if err != nil {
+			return nil, err
+		}
+		if manifest.Subject == nil || manifest.Subject.Digest.String() != subject.DigestStr() {
+			continue
+		}
+
+		result = append(result, Document{
+			Target: manifest.Annotations[AnnotationTarget],
+			Format: format,
+			Digest: ref,
+		})
+	}
+
+	return result, nil
+}
+
+// Read returns the content of the SBOM document
+func Read(doc Document, opts ...remote.Option) ([]byte, error) {
+	artifact, err := remote.Image(doc.Digest, opts...)
+	if err != nil {
+		return nil, err
+	}
+	layers, err := artifact.Layers()
+	if err != nil {
+		return nil, err
+	}
+	if len(layers) == 0 {
+		return nil, fmt.Errorf("invalid SBOM %s, no layers", doc.Digest)
+	}
+	r, err := layers[0].Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// Copy copies the SBOMs of the image to another repository, e.g. when the image is pushed. It returns the number of
+// SBOMs that were copied.
+func Copy(src name.Digest, dst name.Repository, opts ...remote.Option) (int, error) {
+	docs, err := List(src, opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, doc := range docs {
+		artifact, err := remote.Image(doc.Digest, opts...)
+		if err != nil {
+			return 0, err
+		}
+		if err := remote.Write(dst.Digest(doc.Digest.DigestStr()), artifact, opts...); err != nil {
+			return 0, fmt.Errorf("failed to copy SBOM %s to %s: %w", doc.Target, dst, err)
+		}
+	}
+
+	return len(docs), nil
+}
@@ -0,0 +1,121 @@
+package sbom
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pushRandomImage(t *testing.T, repo name.Repository) name.Digest {
+	t.Helper()
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	ref := repo.Digest(digest.String())
+	require.NoError(t, remote.Write(ref, img))
+	return ref
+}
+
+func TestAttachListReadCopy(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	repo, err := name.NewRepository(u.Host + "/library/app")
+	require.NoError(t, err)
+	subject := pushRandomImage(t, repo)
+
+	docs, err := List(subject)
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+
+	spdx, err := Encode(FormatSPDX, subject.Name(), testPackages)
+	require.NoError(t, err)
+	_, err = Attach(subject, TargetAcornfile, FormatSPDX, spdx)
+	require.NoError(t, err)
+	cdx, err := Encode(FormatCycloneDX, subject.Name(), testPackages[1:])
+	require.NoError(t, err)
+	_, err = Attach(subject, "containers/web", FormatCycloneDX, cdx)
+	require.NoError(t, err)
+
+	docs, err = List(subject)
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+	byTarget := map[string]Document{}
+	for _, doc := range docs {
+		byTarget[doc.Target] = doc
+	}
+	assert.Equal(t, FormatSPDX, byTarget[TargetAcornfile].Format)
+	assert.Equal(t, FormatCycloneDX, byTarget["containers/web"].Format)
+
+	data, err := Read(byTarget[TargetAcornfile])
+	require.NoError(t, err)
+	assert.Equal(t, spdx, data)
+
+	// SBOMs of other images are not listed
+	docs, err = List(pushRandomImage(t, repo))
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+
+	dst, err := name.NewRepository(u.Host + "/library/pushed")
+	require.NoError(t, err)
+	copied, err := Copy(subject, dst)
+	require.NoError(t, err)
+	assert.Equal(t, 2, copied)
+
+	docs, err = List(dst.Digest(subject.DigestStr()))
+	require.NoError(t, err)
+	assert.Len(t, docs, 2)
+}
+
+func TestListReferrersTagSchema(t *testing.T) {
+	reg := registry.New(registry.Logger(log.New(io.Discard, "", 0)))
+	// A registry without the referrers API, so the referrers tag schema is used
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if strings.Contains(req.URL.Path, "/referrers/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		reg.ServeHTTP(w, req)
+	}))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	repo, err := name.NewRepository(u.Host + "/library/app")
+	require.NoError(t, err)
+	subject := pushRandomImage(t, repo)
+	other := pushRandomImage(t, repo)
+
+	spdx, err := Encode(FormatSPDX, other.Name(), testPackages)
+	require.NoError(t, err)
+	_, err = Attach(other, TargetAcornfile, FormatSPDX, spdx)
+	require.NoError(t, err)
+
+	docs, err := List(other)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, TargetAcornfile, docs[0].Target)
+
+	// The referrers of the other image are tagged as the referrers of the subject, but the SBOM doesn't refer to it
+	referrers, err := remote.Index(repo.Tag(strings.Replace(other.DigestStr(), ":", "-", 1)))
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(repo.Tag(strings.Replace(subject.DigestStr(), ":", "-", 1)), referrers))
+
+	docs, err = List(subject)
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+}
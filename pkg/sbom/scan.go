@@ -0,0 +1,267 @@
+package sbom
+
+import (
+	"archive/tar"
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+)
+
+const (
+	PackageTypeAPK       = "apk"
+	PackageTypeDeb       = "deb"
+	PackageTypeOCI       = "oci"
+	PackageTypeAcornfile = "acornfile"
+)
+
+// Package is a single piece of software that was found in an image
+type Package struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Arch    string `json:"arch,omitempty"`
+	License string `json:"license,omitempty"`
+	// Distro is the ID of the OS distribution the package belongs to, e.g. alpine or debian
+	Distro string `json:"distro,omitempty"`
+	// Digest is set for packages that are images or files
+	Digest string `json:"digest,omitempty"`
+}
+
+// PURL returns the package URL of the package, see https://github.com/package-url/purl-spec
+func (p Package) PURL() string {
+	switch p.Type {
+	case PackageTypeAPK, PackageTypeDeb:
+		purl := "pkg:" + p.Type + "/"
+		if p.Distro != "" {
+			purl += p.Distro + "/"
+		}
+		purl += p.Name + "@" + p.Version
+		if p.Arch != "" {
+			purl += "?arch=" + p.Arch
+		}
+		return purl
+	case PackageTypeOCI:
+		return "pkg:oci/" + p.Name + "@" + strings.ReplaceAll(p.Digest, ":", "%3A")
+	}
+	return ""
+}
+
+func (p Package) key() string {
+	return strings.Join([]string{p.Type, p.Distro, p.Name, p.Version, p.Arch}, "/")
+}
+
+// ScanIndex returns the OS packages of all images in the index, e.g. of all platforms of a multi-arch image. Only the
+// packages in the apk and dpkg databases are found, application dependencies and copied binaries are not.
+func ScanIndex(index ggcrv1.ImageIndex) ([]Package, error) {
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Package
+	for _, desc := range manifest.Manifests {
+		if desc.Platform != nil && desc.Platform.OS == "unknown" {
+			// attestation manifests that buildkit adds to images
+			continue
+		}
+		var pkgs []Package
+		if desc.MediaType.IsIndex() {
+			child, err := index.ImageIndex(desc.Digest)
+			if err != nil {
+				return nil, err
+			}
+			pkgs, err = ScanIndex(child)
+			if err != nil {
+				return nil, err
+			}
+		} else if desc.MediaType.IsImage() {
+			img, err := index.Image(desc.Digest)
+			if err != nil {
+				return nil, err
+			}
+			pkgs, err = Scan(img)
+			if err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, pkgs...)
+	}
+
+	return dedupe(result), nil
+}
+
+// Scan returns the OS packages that are installed in the image. The package databases of apk (Alpine) and
+// dpkg (Debian, Ubuntu and distroless) are supported.
+func Scan(img ggcrv1.Image) ([]Package, error) {
+	fs := mutate.Extract(img)
+	defer fs.Close()
+
+	var (
+		result []Package
+		distro string
+		tr     = tar.NewReader(fs)
+	)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read image filesystem: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		var pkgs []Package
+		switch name := path.Clean("/" + header.Name); {
+		case name == "/etc/os-release" || name == "/usr/lib/os-release":
+			if id := parseOSReleaseID(tr); id != "" {
+				distro = id
+			}
+		case name == "/lib/apk/db/installed":
+			pkgs, err = parseAPKDatabase(tr)
+		case name == "/var/lib/dpkg/status":
+			pkgs, err = parseDPKGStatus(tr)
+		case path.Dir(name) == "/var/lib/dpkg/status.d" && !strings.HasSuffix(name, ".md5sums"):
+			pkgs, err = parseDPKGStatus(tr)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", header.Name, err)
+		}
+		result = append(result, pkgs...)
+	}
+
+	for i := range result {
+		result[i].Distro = distro
+	}
+	return dedupe(result), nil
+}
+
+func dedupe(pkgs []Package) []Package {
+	seen := map[string]bool{}
+	result := make([]Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		if seen[pkg.key()] {
+			continue
+		}
+		seen[pkg.key()] = true
+		result = append(result, pkg)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].key() < result[j].key()
+	})
+	return result
+}
+
+func parseOSReleaseID(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if k, v, ok := strings.Cut(scanner.Text(), "="); ok && k == "ID" {
+			return strings.Trim(v, `"'`)
+		}
+	}
+	return ""
+}
+
+// parseAPKDatabase parses the installed database of apk, in which packages are separated by empty lines and every
+// line is a single letter key followed by a colon and the value
+func parseAPKDatabase(r io.Reader) ([]Package, error) {
+	var (
+		result  []Package
+		current Package
+		scanner = bufio.NewScanner(r)
+	)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	flush := func() {
+		if current.Name != "" {
+			current.Type = PackageTypeAPK
+			result = append(result, current)
+		}
+		current = Package{}
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+			continue
+		}
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "P":
+			current.Name = v
+		case "V":
+			current.Version = v
+		case "A":
+			current.Arch = v
+		case "L":
+			current.License = v
+		}
+	}
+	flush()
+
+	return result, scanner.Err()
+}
+
+// parseDPKGStatus parses the status database of dpkg, in which packages are separated by empty lines and fields are
+// RFC 822 style headers. Only installed packages are returned.
+func parseDPKGStatus(r io.Reader) ([]Package, error) {
+	var (
+		result    []Package
+		current   Package
+		installed = true
+		scanner   = bufio.NewScanner(r)
+	)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	flush := func() {
+		if current.Name != "" && installed {
+			current.Type = PackageTypeDeb
+			result = append(result, current)
+		}
+		current = Package{}
+		installed = true
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+			continue
+		}
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			// continuation of a multi-line field, like the description
+			continue
+		}
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		v = strings.TrimSpace(v)
+		switch k {
+		case "Package":
+			current.Name = v
+		case "Version":
+			current.Version = v
+		case "Architecture":
+			current.Arch = v
+		case "Status":
+			installed = strings.HasSuffix(v, " installed")
+		}
+	}
+	flush()
+
+	return result, scanner.Err()
+}
@@ -0,0 +1,104 @@
+package sbom
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	apkInstalled = `C:Q1abc=
+P:musl
+V:1.2.3-r4
+A:x86_64
+L:MIT
+
+P:busybox
+V:1.36.0-r9
+A:x86_64
+L:GPL-2.0-only
+`
+	dpkgStatus = `Package: bash
+Status: install ok installed
+Architecture: amd64
+Version: 5.1-2+deb11u1
+Description: GNU Bourne Again SHell
+ Bash is an sh-compatible command language interpreter.
+
+Package: removed
+Status: deinstall ok config-files
+Architecture: amd64
+Version: 1.0
+`
+)
+
+func imageWithFiles(t *testing.T, files map[string]string) ggcrv1.Image {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	require.NoError(t, err)
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	require.NoError(t, err)
+	return img
+}
+
+func TestScanAlpine(t *testing.T) {
+	img := imageWithFiles(t, map[string]string{
+		"etc/os-release":        "NAME=\"Alpine Linux\"\nID=alpine\n",
+		"lib/apk/db/installed":  apkInstalled,
+		"usr/share/misc/readme": "not a package database",
+	})
+
+	pkgs, err := Scan(img)
+	require.NoError(t, err)
+	assert.Equal(t, []Package{
+		{Name: "busybox", Version: "1.36.0-r9", Type: PackageTypeAPK, Arch: "x86_64", License: "GPL-2.0-only", Distro: "alpine"},
+		{Name: "musl", Version: "1.2.3-r4", Type: PackageTypeAPK, Arch: "x86_64", License: "MIT", Distro: "alpine"},
+	}, pkgs)
+	assert.Equal(t, "pkg:apk/alpine/musl@1.2.3-r4?arch=x86_64", pkgs[1].PURL())
+}
+
+func TestScanDebian(t *testing.T) {
+	img := imageWithFiles(t, map[string]string{
+		"usr/lib/os-release":              "ID=\"debian\"\n",
+		"var/lib/dpkg/status":             dpkgStatus,
+		"var/lib/dpkg/status.d/base":      "Package: base-files\nStatus: install ok installed\nVersion: 11.1\n",
+		"var/lib/dpkg/status.d/x.md5sums": "Package: ignored\n",
+	})
+
+	pkgs, err := Scan(img)
+	require.NoError(t, err)
+	assert.Equal(t, []Package{
+		{Name: "base-files", Version: "11.1", Type: PackageTypeDeb, Distro: "debian"},
+		{Name: "bash", Version: "5.1-2+deb11u1", Type: PackageTypeDeb, Arch: "amd64", Distro: "debian"},
+	}, pkgs)
+}
+
+func TestScanNoPackages(t *testing.T) {
+	pkgs, err := Scan(imageWithFiles(t, map[string]string{"app": "binary"}))
+	require.NoError(t, err)
+	assert.Empty(t, pkgs)
+}
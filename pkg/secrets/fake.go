@@ -0,0 +1,67 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/rancher/wrangler/pkg/data/convert"
+)
+
+// FakeProvider is an in-process Provider for tests. It returns the data that was set for params.path.
+type FakeProvider struct {
+	lock  sync.Mutex
+	data  map[string]map[string]string
+	err   error
+	calls int
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		data: map[string]map[string]string{},
+	}
+}
+
+// Set sets the data that is returned for the path
+func (f *FakeProvider) Set(path string, data map[string]string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.data[path] = data
+}
+
+// SetError makes all calls to Resolve fail with err until it is set to nil
+func (f *FakeProvider) SetError(err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.err = err
+}
+
+// Calls returns how often the provider was asked to resolve a secret
+func (f *FakeProvider) Calls() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.calls
+}
+
+func (f *FakeProvider) Resolve(_ context.Context, _ map[string][]byte, params v1.GenericMap) (map[string][]byte, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.calls++
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	path := convert.ToString(params["path"])
+	data, ok := f.data[path]
+	if !ok {
+		return nil, fmt.Errorf("secret %s not found", path)
+	}
+
+	result := map[string][]byte{}
+	for k, v := range data {
+		result[k] = []byte(v)
+	}
+	return result, nil
+}
@@ -0,0 +1,203 @@
+package secrets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/ref"
+	"github.com/rancher/wrangler/pkg/data/convert"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	DefaultExternalSecretTTL = 5 * time.Minute
+	MinExternalSecretTTL     = 10 * time.Second
+	// MaxExternalSecretBackoff is the longest time between two attempts to resolve a secret while the provider fails
+	MaxExternalSecretBackoff = 10 * time.Minute
+)
+
+// Provider resolves the data of secrets of type external from an external secret manager. Auth is the data of the
+// Acorn secret that holds the credentials of the provider, it is nil if there is no such secret. Params are the params
+// of the secret in the Acornfile.
+type Provider interface {
+	Resolve(ctx context.Context, auth map[string][]byte, params v1.GenericMap) (map[string][]byte, error)
+}
+
+var (
+	providersLock sync.RWMutex
+	providers     = map[string]Provider{
+		"vault": &VaultProvider{},
+	}
+)
+
+// RegisterProvider makes a provider available to secrets of type external with params.provider set to name
+func RegisterProvider(name string, provider Provider) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+	providers[name] = provider
+}
+
+func getProvider(name string) (Provider, error) {
+	providersLock.RLock()
+	defer providersLock.RUnlock()
+	if name == "" {
+		return nil, fmt.Errorf("params.provider is required for secrets of type external")
+	}
+	provider, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown external secret provider [%s]", name)
+	}
+	return provider, nil
+}
+
+// ExternalSecretTTL returns how long the data of an external secret is used before it is resolved again
+func ExternalSecretTTL(secretRef v1.Secret) (time.Duration, error) {
+	ttl := convert.ToString(secretRef.Params["ttl"])
+	if ttl == "" {
+		return DefaultExternalSecretTTL, nil
+	}
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ttl [%s] of external secret: %w", ttl, err)
+	}
+	if d < MinExternalSecretTTL {
+		return MinExternalSecretTTL, nil
+	}
+	return d, nil
+}
+
+// ExternalSecretRefreshAfter returns the time until the external secret must be resolved again. After the provider
+// failed, this is the backoff of the failures instead of the TTL.
+func ExternalSecretRefreshAfter(secret *corev1.Secret, secretRef v1.Secret, now time.Time) time.Duration {
+	if failures := externalSecretFailures(secret); failures > 0 {
+		failedAt, err := time.Parse(time.RFC3339, secret.Annotations[labels.AcornSecretRefreshFailedAt])
+		if err != nil {
+			return 0
+		}
+		if d := failedAt.Add(ExternalSecretBackoff(failures)).Sub(now); d > 0 {
+			return d
+		}
+		return 0
+	}
+
+	ttl, err := ExternalSecretTTL(secretRef)
+	if err != nil {
+		return 0
+	}
+	refreshedAt, err := time.Parse(time.RFC3339, secret.Annotations[labels.AcornSecretRefreshedAt])
+	if err != nil {
+		return 0
+	}
+	if d := refreshedAt.Add(ttl).Sub(now); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// ExternalSecretBackoff returns the time to wait before resolving a secret again after the provider failed the given
+// number of times in a row. It doubles with every failure, up to MaxExternalSecretBackoff.
+func ExternalSecretBackoff(failures int) time.Duration {
+	backoff := MinExternalSecretTTL
+	for i := 1; i < failures && backoff < MaxExternalSecretBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > MaxExternalSecretBackoff {
+		return MaxExternalSecretBackoff
+	}
+	return backoff
+}
+
+func externalSecretFailures(secret *corev1.Secret) int {
+	failures, _ := strconv.Atoi(secret.Annotations[labels.AcornSecretRefreshFailures])
+	return failures
+}
+
+func paramsHash(params v1.GenericMap) string {
+	data, _ := json.Marshal(params)
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// externalSecretAuth returns the data of the secret named by params.auth, or the secret with the name of the provider
+// if params.auth is not set. A missing default secret is not an error, providers may not need credentials.
+func externalSecretAuth(req router.Request, appInstance *v1.AppInstance, providerName string, secretRef v1.Secret) (map[string][]byte, error) {
+	name := convert.ToString(secretRef.Params["auth"])
+	if name == "" {
+		name = providerName
+	}
+
+	auth := &corev1.Secret{}
+	if err := ref.Lookup(req.Ctx, req.Client, auth, appInstance.Namespace, name); apierrors.IsNotFound(err) && secretRef.Params["auth"] == nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return nacl.DecryptNamespacedDataMap(req.Ctx, req.Client, auth.Data, appInstance.Namespace)
+}
+
+func generateExternal(req router.Request, appInstance *v1.AppInstance, secretName string, secretRef v1.Secret, existing *corev1.Secret) (*corev1.Secret, error) {
+	providerName := convert.ToString(secretRef.Params["provider"])
+	provider, err := getProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: secretName + "-",
+			Namespace:    appInstance.Namespace,
+			Labels:       labelsForSecret(secretName, appInstance, secretRef),
+			Annotations:  annotationsForSecret(secretName, appInstance, secretRef),
+		},
+		Type: v1.SecretTypeExternal,
+	}
+
+	hash := paramsHash(secretRef.Params)
+	if existing != nil && existing.Type == v1.SecretTypeExternal && existing.Annotations[labels.AcornSecretParamsHash] == hash &&
+		ExternalSecretRefreshAfter(existing, secretRef, time.Now()) > 0 {
+		secret.Data = existing.Data
+		for _, key := range []string{labels.AcornSecretRefreshedAt, labels.AcornSecretRefreshFailures, labels.AcornSecretRefreshFailedAt} {
+			if value, ok := existing.Annotations[key]; ok {
+				secret.Annotations[key] = value
+			}
+		}
+		secret.Annotations[labels.AcornSecretParamsHash] = hash
+		return updateOrCreate(req, existing, secret)
+	}
+
+	auth, err := externalSecretAuth(req, appInstance, providerName, secretRef)
+	if err == nil {
+		secret.Data, err = provider.Resolve(req.Ctx, auth, secretRef.Params)
+	}
+	if err != nil {
+		err = fmt.Errorf("resolving external secret %s from provider [%s]: %w", secretName, providerName, err)
+		if existing != nil && existing.Type == v1.SecretTypeExternal && existing.Annotations[labels.AcornSecretParamsHash] == hash {
+			// Keep the workloads running with the last known data until the provider is available again. The failures
+			// are counted, so that the provider is asked less often the longer it fails.
+			logrus.Errorf("%v, using the data of %s/%s", err, existing.Namespace, existing.Name)
+			failed := existing.DeepCopy()
+			failed.Annotations[labels.AcornSecretRefreshFailures] = strconv.Itoa(externalSecretFailures(existing) + 1)
+			failed.Annotations[labels.AcornSecretRefreshFailedAt] = time.Now().UTC().Format(time.RFC3339)
+			return updateOrCreate(req, existing, failed)
+		}
+		return nil, err
+	}
+	secret.Annotations[labels.AcornSecretRefreshedAt] = time.Now().UTC().Format(time.RFC3339)
+	secret.Annotations[labels.AcornSecretParamsHash] = hash
+
+	return updateOrCreate(req, existing, secret)
+}
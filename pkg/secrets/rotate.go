@@ -0,0 +1,197 @@
+package secrets
+
+import (
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/rancher/wrangler/pkg/data/convert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// PreviousKey holds the value a single key secret had before it was rotated. Secrets with multiple keys keep the
+	// previous values under PreviousKey-<key>.
+	PreviousKey = "previous"
+
+	DefaultRotationGrace = time.Hour
+)
+
+// RotationGrace returns how long the previous value is kept after the secret is rotated
+func RotationGrace(secretRef v1.Secret) time.Duration {
+	if secretRef.Rotate == nil || secretRef.Rotate.Grace == nil {
+		return DefaultRotationGrace
+	}
+	return secretRef.Rotate.Grace.Duration
+}
+
+func rotatedAt(existing *corev1.Secret) time.Time {
+	if t, err := time.Parse(time.RFC3339, existing.Annotations[labels.AcornSecretRotatedAt]); err == nil {
+		return t
+	}
+	return existing.CreationTimestamp.Time
+}
+
+// rotationDue returns true if the existing value has to be replaced, because it was requested with acorn secret rotate
+// or because the rotation interval passed
+func rotationDue(existing *corev1.Secret, secretRef v1.Secret, now time.Time) bool {
+	if existing == nil || secretRef.HasFixedToken() {
+		return false
+	}
+	if existing.Annotations[labels.AcornSecretRotateRequested] != "" {
+		return true
+	}
+	return secretRef.Rotate != nil && secretRef.Rotate.Every.Duration > 0 &&
+		!now.Before(rotatedAt(existing).Add(secretRef.Rotate.Every.Duration))
+}
+
+// rotationPending returns true if a generated secret is waiting for its job to produce the rotated value
+func rotationPending(existing *corev1.Secret) bool {
+	if existing == nil {
+		return false
+	}
+	rotation := existing.Annotations[labels.AcornSecretRotation]
+	return rotation != "" && rotation != existing.Annotations[labels.AcornSecretRotatedAt]
+}
+
+func isPreviousKey(key string) bool {
+	return key == PreviousKey || strings.HasPrefix(key, PreviousKey+"-")
+}
+
+func previousData(data map[string][]byte) map[string][]byte {
+	current := map[string][]byte{}
+	for k, v := range data {
+		if !isPreviousKey(k) {
+			current[k] = v
+		}
+	}
+
+	result := map[string][]byte{}
+	for k, v := range current {
+		if len(current) == 1 {
+			result[PreviousKey] = v
+		} else {
+			result[PreviousKey+"-"+k] = v
+		}
+	}
+	return result
+}
+
+// keepRotationState copies the rotation annotations and, until the grace period ends, the previous values of the
+// existing secret to the secret
+func keepRotationState(existing, secret *corev1.Secret, now time.Time) {
+	if existing == nil {
+		return
+	}
+
+	for _, key := range []string{labels.AcornSecretRotatedAt, labels.AcornSecretRotation, labels.AcornSecretRotateRequested} {
+		if v := existing.Annotations[key]; v != "" {
+			secret.Annotations = labels.Merge(secret.Annotations, map[string]string{key: v})
+		}
+	}
+
+	expires, err := time.Parse(time.RFC3339, existing.Annotations[labels.AcornSecretPreviousExpires])
+	if err != nil || !now.Before(expires) {
+		return
+	}
+
+	secret.Annotations = labels.Merge(secret.Annotations, map[string]string{
+		labels.AcornSecretPreviousExpires: existing.Annotations[labels.AcornSecretPreviousExpires],
+	})
+	for k, v := range existing.Data {
+		if isPreviousKey(k) {
+			secret.Data[k] = v
+		}
+	}
+}
+
+// setRotated records that the secret got a new value at rotatedAt and keeps the value of the existing secret under
+// the previous key for the grace period
+func setRotated(existing, secret *corev1.Secret, secretRef v1.Secret, rotatedAt string, now time.Time) {
+	for k, v := range previousData(existing.Data) {
+		secret.Data[k] = v
+	}
+	secret.Annotations = labels.Merge(secret.Annotations, map[string]string{
+		labels.AcornSecretRotatedAt:       rotatedAt,
+		labels.AcornSecretPreviousExpires: now.Add(RotationGrace(secretRef)).UTC().Format(time.RFC3339),
+	})
+	delete(secret.Annotations, labels.AcornSecretRotateRequested)
+}
+
+// RotationRetryAfter returns when the secret has to be checked again, either to rotate it or to remove the previous
+// value after the grace period. False is returned if there is nothing to wait for.
+func RotationRetryAfter(secret *corev1.Secret, secretRef v1.Secret, now time.Time) (time.Duration, bool) {
+	var (
+		next  time.Time
+		found bool
+	)
+
+	if secretRef.Rotate != nil && secretRef.Rotate.Every.Duration > 0 && !secretRef.HasFixedToken() && !rotationPending(secret) {
+		next, found = rotatedAt(secret).Add(secretRef.Rotate.Every.Duration), true
+	}
+
+	if expires, err := time.Parse(time.RFC3339, secret.Annotations[labels.AcornSecretPreviousExpires]); err == nil {
+		if !found || expires.Before(next) {
+			next, found = expires, true
+		}
+	}
+
+	if !found {
+		return 0, false
+	}
+	if d := next.Sub(now); d > time.Second {
+		return d, true
+	}
+	return time.Second, true
+}
+
+// JobRotationAnnotations returns the annotations for the job that generates secrets. The annotations change when one
+// of the secrets is rotated, which replaces the job so it runs again and produces a new value.
+func JobRotationAnnotations(req router.Request, appInstance *v1.AppInstance, jobName string) (map[string]string, error) {
+	result := map[string]string{}
+	for secretName, secretRef := range appInstance.Status.AppSpec.Secrets {
+		if secretRef.Type != "generated" || convert.ToString(secretRef.Params["job"]) != jobName {
+			continue
+		}
+		existing, err := getSecret(req, appInstance, secretName)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if rotation := existing.Annotations[labels.AcornSecretRotation]; rotation != "" {
+			result[labels.AcornSecretRotationPrefix+secretName] = rotation
+		}
+	}
+	return result, nil
+}
+
+// ScheduledJobFor returns the name of the job that generates the secret if the job runs on a schedule. Such a secret
+// gets a new value on every run of the job, so it can not be rotated.
+func ScheduledJobFor(appSpec *v1.AppSpec, secretName string) (string, bool) {
+	secretRef, ok := appSpec.Secrets[secretName]
+	if !ok || secretRef.Type != "generated" {
+		return "", false
+	}
+	jobName := convert.ToString(secretRef.Params["job"])
+	if appSpec.Jobs[jobName].Schedule == "" {
+		return "", false
+	}
+	return jobName, true
+}
+
+// jobRotated returns true once the job of a generated secret ran to completion for the rotation
+func jobRotated(req router.Request, appInstance *v1.AppInstance, jobName, secretName, rotation string) (bool, error) {
+	job := &batchv1.Job{}
+	if err := req.Get(job, appInstance.Status.Namespace, jobName); apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return job.Spec.Template.Annotations[labels.AcornSecretRotationPrefix+secretName] == rotation &&
+		job.Status.Succeeded > 0, nil
+}
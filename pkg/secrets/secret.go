@@ -4,10 +4,12 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"io"
 	"math/big"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
@@ -22,7 +24,6 @@ import (
 	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/rancher/wrangler/pkg/data/convert"
 	"github.com/rancher/wrangler/pkg/merr"
-	"github.com/rancher/wrangler/pkg/randomtoken"
 	"golang.org/x/exp/maps"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -93,9 +94,46 @@ func generatedSecret(req router.Request, appInstance *v1.AppInstance, secretName
 	var (
 		newSecret *v1.Secret
 		format    = convert.ToString(secretRef.Params["format"])
+		jobName   = convert.ToString(secretRef.Params["job"])
+		rotation  = ""
+		now       = time.Now()
 		err       error
 	)
 
+	keepRotationState(existing, secret, now)
+
+	// Scheduled jobs produce a new value on every run, so only secrets of jobs that run once are rotated. The API
+	// rejects rotating the others, drop a request that got past it so it isn't pending forever.
+	_, scheduled := ScheduledJobFor(&appInstance.Status.AppSpec, secretName)
+	if scheduled {
+		delete(secret.Annotations, labels.AcornSecretRotateRequested)
+	}
+	pending := rotationPending(existing)
+	if !pending && !scheduled && rotationDue(existing, secretRef, now) {
+		secret.Annotations = labels.Merge(secret.Annotations, map[string]string{
+			labels.AcornSecretRotation: now.UTC().Format(time.RFC3339),
+		})
+		delete(secret.Annotations, labels.AcornSecretRotateRequested)
+		pending = true
+	}
+	if pending {
+		rotation = secret.Annotations[labels.AcornSecretRotation]
+		done, err := jobRotated(req, appInstance, jobName, secretName, rotation)
+		if err != nil {
+			return nil, err
+		}
+		if !done {
+			// Keep the current value until the job ran again
+			for k, v := range existing.Data {
+				if !isPreviousKey(k) {
+					secret.Data[k] = v
+				}
+			}
+			secret.Type = existing.Type
+			return updateOrCreate(req, existing, secret)
+		}
+	}
+
 	switch format {
 	case "":
 		newSecret, err = getJSONSecretData(req.Ctx, req.Client, appInstance, secretRef, secretName)
@@ -126,6 +164,10 @@ func generatedSecret(req router.Request, appInstance *v1.AppInstance, secretName
 		}
 	}
 
+	if rotation != "" {
+		setRotated(existing, secret, secretRef, rotation, now)
+	}
+
 	return updateOrCreate(req, existing, secret)
 }
 
@@ -207,19 +249,34 @@ func generateToken(req router.Request, appInstance *v1.AppInstance, secretName s
 		Type: v1.SecretTypeToken,
 	}
 
+	now := time.Now()
+	rotate := rotationDue(existing, secretRef, now)
+	if rotate {
+		secret.Data["token"] = nil
+	}
+	keepRotationState(existing, secret, now)
+	if secretRef.HasFixedToken() {
+		// A fixed token is never rotated, so a request to rotate it is dropped instead of being kept forever
+		delete(secret.Annotations, labels.AcornSecretRotateRequested)
+	}
+
 	if len(secret.Data["token"]) == 0 {
 		length, err := convert.ToNumber(secretRef.Params["length"])
 		if err != nil {
 			return nil, err
 		}
 		characters := convert.ToString(secretRef.Params["characters"])
-		v, err := generate(characters, int(length))
+		v, err := generate(req.Ctx, secretName, "token", characters, int(length))
 		if err != nil {
 			return nil, err
 		}
 		secret.Data["token"] = []byte(v)
 	}
 
+	if rotate {
+		setRotated(existing, secret, secretRef, now.UTC().Format(time.RFC3339), now)
+	}
+
 	return updateOrCreate(req, existing, secret)
 }
 
@@ -253,8 +310,7 @@ func generateBasic(req router.Request, appInstance *v1.AppInstance, secretName s
 	for i, key := range []string{corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey} {
 		if len(secret.Data[key]) == 0 {
 			// TODO: Improve with more characters (special, upper/lowercase, etc)
-			v, err := randomtoken.Generate()
-			v = v[:(i+1)*8]
+			v, err := generate(req.Ctx, secretName, key, basicCharacters, (i+1)*8)
 			if err != nil {
 				return nil, err
 			}
@@ -367,6 +423,8 @@ func generateSecret(secrets map[string]*corev1.Secret, req router.Request, appIn
 		return generateToken(req, appInstance, secretName, secretRef, existing)
 	case "template":
 		return generateTemplate(secrets, req, appInstance, secretName, secretRef, existing)
+	case "external":
+		return generateExternal(req, appInstance, secretName, secretRef, existing)
 	default:
 		return nil, err
 	}
@@ -440,10 +498,29 @@ func GetOrCreateSecret(secrets map[string]*corev1.Secret, req router.Request, ap
 	return secret, nil
 }
 
-func generate(characters string, tokenLength int) (string, error) {
+// basicCharacters are the characters of the generated usernames and passwords of basic secrets
+const basicCharacters = "bcdfghjklmnpqrstvwxz2456789"
+
+// RandomSource returns the reader the generated value of a key of a secret is read from
+type RandomSource func(secretName, key string) io.Reader
+
+type randomSourceKey struct{}
+
+// WithRandomSource returns a context in which the values of generated token and basic secrets are read from the
+// source instead of crypto/rand, so that they can be reproduced
+func WithRandomSource(ctx context.Context, source RandomSource) context.Context {
+	return context.WithValue(ctx, randomSourceKey{}, source)
+}
+
+func generate(ctx context.Context, secretName, key, characters string, tokenLength int) (string, error) {
+	random := rand.Reader
+	if source, ok := ctx.Value(randomSourceKey{}).(RandomSource); ok {
+		random = source(secretName, key)
+	}
+
 	token := make([]byte, tokenLength)
 	for i := range token {
-		r, err := rand.Int(rand.Reader, big.NewInt(int64(len(characters))))
+		r, err := rand.Int(random, big.NewInt(int64(len(characters))))
 		if err != nil {
 			return "", err
 		}
@@ -0,0 +1,156 @@
+package secrets
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/rancher/wrangler/pkg/data/convert"
+)
+
+// VaultProvider reads secrets from the KV secrets engine of HashiCorp Vault. The auth secret must have the keys
+// address and token, and can have namespace (Vault Enterprise) and caCert. The params of the secret are:
+//
+//	path:      path of the secret in the KV engine (required)
+//	mount:     mount path of the KV engine, defaults to "secret"
+//	kvVersion: version of the KV engine, 1 or 2, defaults to 2
+//
+// Every key of the Vault secret becomes a key of the Acorn secret. Values that are not strings are JSON encoded.
+type VaultProvider struct {
+	// Client is used for requests to Vault, it defaults to a client that trusts the caCert of the auth secret
+	Client *http.Client
+}
+
+func (v *VaultProvider) Resolve(ctx context.Context, auth map[string][]byte, params v1.GenericMap) (map[string][]byte, error) {
+	address := strings.TrimSuffix(string(auth["address"]), "/")
+	if address == "" {
+		return nil, fmt.Errorf("vault address is missing, set the address key of the auth secret")
+	}
+	if len(auth["token"]) == 0 {
+		return nil, fmt.Errorf("vault token is missing, set the token key of the auth secret")
+	}
+
+	path := strings.Trim(convert.ToString(params["path"]), "/")
+	if path == "" {
+		return nil, fmt.Errorf("params.path is required for vault secrets")
+	}
+	mount := strings.Trim(convert.ToString(params["mount"]), "/")
+	if mount == "" {
+		mount = "secret"
+	}
+
+	kvVersion := int64(2)
+	if params["kvVersion"] != nil {
+		var err error
+		kvVersion, err = convert.ToNumber(params["kvVersion"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid params.kvVersion: %w", err)
+		}
+	}
+
+	var u string
+	switch kvVersion {
+	case 1:
+		u = fmt.Sprintf("%s/v1/%s/%s", address, mount, path)
+	case 2:
+		u = fmt.Sprintf("%s/v1/%s/data/%s", address, mount, path)
+	default:
+		return nil, fmt.Errorf("invalid params.kvVersion [%d], must be 1 or 2", kvVersion)
+	}
+
+	client, err := v.client(auth)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", string(auth["token"]))
+	if ns := auth["namespace"]; len(ns) > 0 {
+		req.Header.Set("X-Vault-Namespace", string(ns))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(body, &vaultErr)
+		if len(vaultErr.Errors) > 0 {
+			return nil, fmt.Errorf("reading %s/%s from vault: %s: %s", mount, path, resp.Status, strings.Join(vaultErr.Errors, ", "))
+		}
+		return nil, fmt.Errorf("reading %s/%s from vault: %s", mount, path, resp.Status)
+	}
+
+	var kv struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal(body, &kv); err != nil {
+		return nil, fmt.Errorf("parsing vault response: %w", err)
+	}
+
+	data := kv.Data
+	if kvVersion == 2 {
+		// KV version 2 wraps the data with its metadata
+		nested, _ := data["data"].(map[string]any)
+		data = nested
+	}
+	if data == nil {
+		return nil, fmt.Errorf("secret %s/%s not found in vault", mount, path)
+	}
+
+	result := map[string][]byte{}
+	for k, value := range data {
+		if s, ok := value.(string); ok {
+			result[k] = []byte(s)
+			continue
+		}
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		result[k] = b
+	}
+	return result, nil
+}
+
+func (v *VaultProvider) client(auth map[string][]byte) (*http.Client, error) {
+	if v.Client != nil {
+		return v.Client, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caCert := auth["caCert"]; len(caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("invalid caCert in the auth secret of vault")
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   30 * time.Second,
+	}, nil
+}
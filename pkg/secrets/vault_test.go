@@ -0,0 +1,63 @@
+package secrets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultProvider(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/apps/db":
+			assert.Equal(t, "team", r.Header.Get("X-Vault-Namespace"))
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"s3cret","port":5432},"metadata":{"version":3}}}`))
+		case "/v1/kv/apps/db":
+			_, _ = w.Write([]byte(`{"data":{"password":"v1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer s.Close()
+
+	auth := map[string][]byte{
+		"address":   []byte(s.URL + "/"),
+		"token":     []byte("root"),
+		"namespace": []byte("team"),
+	}
+	provider := &VaultProvider{}
+
+	data, err := provider.Resolve(context.Background(), auth, v1.GenericMap{"path": "/apps/db"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"password": []byte("s3cret"),
+		"port":     []byte("5432"),
+	}, data)
+
+	data, err = provider.Resolve(context.Background(), auth, v1.GenericMap{"path": "apps/db", "mount": "kv", "kvVersion": int64(1)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("v1")}, data)
+
+	_, err = provider.Resolve(context.Background(), auth, v1.GenericMap{"path": "apps/missing"})
+	assert.EqualError(t, err, "reading secret/apps/missing from vault: 404 Not Found")
+
+	_, err = provider.Resolve(context.Background(), map[string][]byte{"address": []byte(s.URL), "token": []byte("wrong")}, v1.GenericMap{"path": "apps/db"})
+	assert.EqualError(t, err, "reading secret/apps/db from vault: 403 Forbidden: permission denied")
+
+	_, err = provider.Resolve(context.Background(), nil, v1.GenericMap{"path": "apps/db"})
+	assert.EqualError(t, err, "vault address is missing, set the address key of the auth secret")
+
+	_, err = provider.Resolve(context.Background(), auth, v1.GenericMap{})
+	assert.EqualError(t, err, "params.path is required for vault secrets")
+}
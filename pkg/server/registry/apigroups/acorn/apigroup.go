@@ -15,6 +15,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/credentials"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/devsessions"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/events"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/eventsubscriptions"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/imageallowrules"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/images"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/info"
@@ -23,6 +24,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/secrets"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes/class"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes/snapshot"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/admin/computeclass"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -43,6 +45,8 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		return nil, err
 	}
 
+	recorder := event.NewRecorder(c)
+
 	buildersStorage := builders.NewStorage(c)
 	buildersPort, err := builders.NewBuilderPort(c, transport)
 	if err != nil {
@@ -50,9 +54,9 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 	}
 
 	buildsStorage := builds.NewStorage(c)
-	imagesStorage := images.NewStorage(c)
+	imagesStorage := images.NewStorage(c, recorder)
 
-	containersStorage := containers.NewStorage(c)
+	containersStorage := containers.NewStorage(c, recorder)
 
 	containerExec, err := containers.NewContainerExec(c, cfg)
 	if err != nil {
@@ -64,14 +68,19 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		return nil, err
 	}
 
-	appsStorage := apps.NewStorage(c, clientFactory, event.NewRecorder(c))
+	appsStorage := apps.NewStorage(c, clientFactory, recorder)
 
 	logsStorage, err := apps.NewLogs(c, cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	volumesStorage := volumes.NewStorage(c)
+	volumesStorage := volumes.NewStorage(c, recorder)
+
+	volumeArchive, err := volumes.NewVolumeArchive(c, cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	stores := map[string]rest.Storage{
 		"acornimagebuilds":              buildsStorage,
@@ -80,6 +89,9 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		"apps/confirmupgrade":           apps.NewConfirmUpgrade(c),
 		"apps/pullimage":                apps.NewPullAppImage(c),
 		"apps/ignorecleanup":            apps.NewIgnoreCleanup(c),
+		"apps/promote":                  apps.NewPromote(c, recorder),
+		"apps/rollback":                 apps.NewRollback(c, clientFactory, recorder),
+		"apps/runjob":                   apps.NewRunJob(c, recorder),
 		"devsessions":                   devsessions.NewStorage(c, clientFactory),
 		"builders":                      buildersStorage,
 		"builders/port":                 buildersPort,
@@ -88,20 +100,25 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		"images/push":                   images.NewImagePush(c, transport),
 		"images/pull":                   images.NewImagePull(c, clientFactory, transport),
 		"images/details":                images.NewImageDetails(c, transport),
-		"projects":                      projects.NewStorage(c),
+		"images/sign":                   images.NewImageSign(c, transport, recorder),
+		"images/verify":                 images.NewImageVerify(c, transport),
+		"projects":                      projects.NewStorage(c, recorder),
 		"volumes":                       volumesStorage,
+		"volumes/archive":               volumeArchive,
+		"volumesnapshots":               snapshot.NewStorage(c, recorder),
 		"volumeclasses":                 class.NewClassStorage(c),
 		"containerreplicas":             containersStorage,
 		"containerreplicas/exec":        containerExec,
 		"containerreplicas/portforward": portForward,
-		"credentials":                   credentials.NewStore(c),
-		"secrets":                       secrets.NewStorage(c),
+		"credentials":                   credentials.NewStore(c, recorder),
+		"secrets":                       secrets.NewStorage(c, recorder),
 		"secrets/reveal":                secrets.NewReveal(c),
 		"infos":                         info.NewStorage(c),
 		"computeclasses":                computeclass.NewAggregateStorage(c),
 		"regions":                       regions.NewStorage(c),
-		"imageallowrules":               imageallowrules.NewStorage(c),
+		"imageallowrules":               imageallowrules.NewStorage(c, recorder),
 		"events":                        events.NewStorage(c),
+		"eventsubscriptions":            eventsubscriptions.NewStorage(c, recorder),
 	}
 
 	return stores, nil
@@ -40,6 +40,7 @@ func (s *ConfirmUpgradeStrategy) Create(ctx context.Context, obj types.Object) (
 	}
 	app.Status.AvailableAppImage = app.Status.ConfirmUpgradeAppImage
 	app.Status.AvailableAppImageRemote = app.Status.ConfirmUpgradeAppImageRemote
+	app.Status.ChangeCause = v1.AppRevisionTriggerAutoUpgrade
 
 	err = s.client.Status().Update(ctx, app)
 	if err != nil {
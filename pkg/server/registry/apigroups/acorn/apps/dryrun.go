@@ -0,0 +1,38 @@
+package apps
+
+import (
+	"context"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/util/dryrun"
+)
+
+type dryRunKey struct{}
+
+// dryRunStore marks the context of dry run requests, so that the validator doesn't record events for apps that are not
+// persisted.
+type dryRunStore struct {
+	*stores.ReadWriteWatchStore
+}
+
+func (s *dryRunStore) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if options != nil && dryrun.IsDryRun(options.DryRun) {
+		ctx = context.WithValue(ctx, dryRunKey{}, true)
+	}
+	return s.ReadWriteWatchStore.Create(ctx, obj, createValidation, options)
+}
+
+func (s *dryRunStore) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	if options != nil && dryrun.IsDryRun(options.DryRun) {
+		ctx = context.WithValue(ctx, dryRunKey{}, true)
+	}
+	return s.ReadWriteWatchStore.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
+
+func isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
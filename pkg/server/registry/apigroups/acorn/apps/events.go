@@ -4,14 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/acorn-io/mink/pkg/strategy"
 	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/apppolicy"
 	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
 	"github.com/sirupsen/logrus"
-	"github.com/wI2L/jsondiff"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -19,8 +21,19 @@ const (
 	AppCreateEventType     = "AppCreate"
 	AppDeleteEventType     = "AppDelete"
 	AppSpecUpdateEventType = "AppSpecUpdate"
+	AppPolicyViolationType = "AppPolicyViolation"
+	AppPromoteEventType    = "AppPromote"
+	AppRollbackEventType   = "AppRollback"
+	AppRunJobEventType     = "AppRunJob"
 )
 
+// redactedAppFields are the fields of the app spec that can have credentials, their values are not recorded in events.
+var redactedAppFields = []string{
+	"/deployArgs/*",
+	"/environment/*/value",
+	"/logSink/http/url",
+}
+
 // AppSpecCreateEventDetails captures additional info about the creation of an App.
 type AppSpecCreateEventDetails struct {
 	// ResourceVersion is the resourceVersion of the App created.
@@ -38,14 +51,110 @@ type AppSpecUpdateEventDetails struct {
 	// ResourceVersion is the resourceVersion of the updated App.
 	ResourceVersion string `json:"resourceVersion"`
 
-	// OldSpec is the spec of the App before the update.
+	// OldSpec is the spec of the App before the update, with the values of sensitive fields redacted.
 	OldSpec v1.AppInstanceSpec `json:"oldSpec"`
 
-	// Patch is a JSON Patch that describes all changes made to OldSpec by the respective update.
+	// Patch is a JSON Patch that describes all changes made to OldSpec by the respective update, with the values of
+	// sensitive fields redacted.
 	// See: https://datatracker.ietf.org/doc/html/rfc6902
 	Patch json.RawMessage `json:"patch"`
 }
 
+// AppPolicyViolationEventDetails captures additional info about an app violating an AppPolicyRule in audit mode.
+type AppPolicyViolationEventDetails struct {
+	// RuleKind is the kind of the violated rule, ProjectAppPolicyRule or ClusterAppPolicyRule.
+	RuleKind string `json:"ruleKind"`
+
+	// RuleName is the name of the violated rule.
+	RuleName string `json:"ruleName"`
+
+	// Field is the path of the field the violation is reported for.
+	// +optional
+	Field string `json:"field,omitempty"`
+
+	// Message describes the violation.
+	Message string `json:"message"`
+}
+
+// AppPromoteEventDetails captures additional info about the promotion of a rollout.
+type AppPromoteEventDetails struct {
+	// ResourceVersion is the resourceVersion of the App promoted.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Full is true if the rollout was promoted to the new revision in one step.
+	// +optional
+	Full bool `json:"full,omitempty"`
+}
+
+// AppRollbackEventDetails captures additional info about the rollback of an App.
+type AppRollbackEventDetails struct {
+	// ResourceVersion is the resourceVersion of the App rolled back.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Revision is the revision the App was rolled back to, or 0 if the rollout in progress was aborted.
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// AppRunJobEventDetails captures additional info about a manual run of a job of an App.
+type AppRunJobEventDetails struct {
+	// JobName is the name of the job in the Acornfile.
+	JobName string `json:"jobName"`
+
+	// RunName is the name of the Kubernetes job created for the run.
+	RunName string `json:"runName"`
+}
+
+// recordAppAction records an event of an action taken on an app through a subresource, like a rollback.
+func recordAppAction(ctx context.Context, recorder event.Recorder, app types.Object, eventType string, details any, description string) {
+	mapped, err := v1.Mapify(details)
+	if err != nil {
+		logrus.Warnf("Failed to generate event details, event recording disabled for request: %s", err.Error())
+		return
+	}
+
+	if err := recorder.Record(ctx, &apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: app.GetNamespace(),
+		},
+		Type:        eventType,
+		Severity:    v1.EventSeverityInfo,
+		Details:     mapped,
+		Description: fmt.Sprintf("App %s/%s %s", app.GetNamespace(), app.GetName(), description),
+		Source:      event.ObjectSource(app),
+		Observed:    v1.MicroTime(metav1.NowMicro()),
+	}); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
+
+func recordPolicyViolation(ctx context.Context, recorder event.Recorder, obj types.Object, violation apppolicy.Violation) {
+	details, err := v1.Mapify(AppPolicyViolationEventDetails{
+		RuleKind: violation.RuleKind,
+		RuleName: violation.RuleName,
+		Field:    violation.Field,
+		Message:  violation.Message,
+	})
+	if err != nil {
+		logrus.Warnf("Failed to generate event details, event recording disabled for request: %s", err.Error())
+		return
+	}
+
+	if err := recorder.Record(ctx, &apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: obj.GetNamespace(),
+		},
+		Type:        AppPolicyViolationType,
+		Severity:    v1.EventSeverityWarn,
+		Details:     details,
+		Description: fmt.Sprintf("App %s/%s %s", obj.GetNamespace(), obj.GetName(), strings.TrimPrefix(violation.Error(), "app ")),
+		Source:      event.ObjectSource(obj),
+		Observed:    v1.MicroTime(metav1.NowMicro()),
+	}); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
+
 type eventRecordingStrategy struct {
 	strategy.CompleteStrategy
 	recorder event.Recorder
@@ -138,57 +247,68 @@ func (s *eventRecordingStrategy) Update(ctx context.Context, obj types.Object) (
 		return updated, err
 	}
 
-	oldSpec, newSpec := old.(*apiv1.App).Spec, updated.(*apiv1.App).Spec
-	patch, err := jsonPatch(oldSpec, newSpec)
+	recordSpecUpdate(ctx, s.recorder, updated, old.(*apiv1.App).Spec, updated.(*apiv1.App).Spec)
+	return updated, nil
+}
+
+// recordSpecUpdate records an AppSpecUpdate event with the changes from the old to the new spec of the app, if the spec
+// changed.
+func recordSpecUpdate(ctx context.Context, recorder event.Recorder, app types.Object, oldSpec, newSpec v1.AppInstanceSpec) {
+	patch, err := audit.Patch(oldSpec, newSpec, redactedAppFields)
 	if err != nil {
 		logrus.Warnf("Failed to generate app spec patch, event recording disabled for request: %s", err)
-		return updated, nil
+		return
 	}
 
 	if len(patch) < 1 {
 		// Update did not change spec, don't record an event
 		logrus.Infof("Update did not change app spec, event recording disabled for request")
-		return updated, nil
+		return
+	}
+
+	oldSpec, err = redactedSpec(oldSpec)
+	if err != nil {
+		logrus.Warnf("Failed to redact old app spec, event recording disabled for request: %s", err)
+		return
 	}
 
 	details, err := v1.Mapify(AppSpecUpdateEventDetails{
-		ResourceVersion: updated.GetResourceVersion(),
+		ResourceVersion: app.GetResourceVersion(),
 		OldSpec:         oldSpec,
 		Patch:           patch,
 	})
 	if err != nil {
 		logrus.Warnf("Failed to generate event details, event recording disabled for request: %s", err)
-		return updated, nil
+		return
 	}
 
-	if err := s.recorder.Record(ctx, &apiv1.Event{
+	if err := recorder.Record(ctx, &apiv1.Event{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: obj.GetNamespace(),
+			Namespace: app.GetNamespace(),
 		},
 		Type:        AppSpecUpdateEventType,
 		Severity:    v1.EventSeverityInfo,
 		Details:     details,
-		Description: fmt.Sprintf("Spec field updated for App %s/%s", obj.GetNamespace(), obj.GetName()),
-		Source:      event.ObjectSource(obj),
+		Description: fmt.Sprintf("Spec field updated for App %s/%s", app.GetNamespace(), app.GetName()),
+		Source:      event.ObjectSource(app),
 		Observed:    v1.MicroTime(metav1.NowMicro()),
 	}); err != nil {
 		logrus.Warnf("Failed to record event: %s", err)
 	}
-
-	return updated, nil
 }
 
-func jsonPatch(from, to any) (json.RawMessage, error) {
-	patch, err := jsondiff.Compare(from, to)
+// redactedSpec returns the spec with the values of the redacted fields replaced.
+func redactedSpec(spec v1.AppInstanceSpec) (v1.AppInstanceSpec, error) {
+	generic, err := audit.Redact(spec, redactedAppFields)
 	if err != nil {
-		return nil, err
+		return spec, err
 	}
 
-	if len(patch) < 1 {
-		// Marshaling an empty patch yields "null", which is harder to reason about.
-		// Return nil instead.
-		return nil, nil
+	data, err := json.Marshal(generic)
+	if err != nil {
+		return spec, err
 	}
 
-	return json.Marshal(patch)
+	var redacted v1.AppInstanceSpec
+	return redacted, json.Unmarshal(data, &redacted)
 }
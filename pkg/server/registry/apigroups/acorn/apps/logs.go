@@ -3,7 +3,9 @@ package apps
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/acorn-io/mink/pkg/strategy"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -13,6 +15,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/log"
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/endpoints/request"
@@ -60,9 +63,22 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 	}
 
 	var (
-		opts = options.(*apiv1.LogOptions)
+		opts  = options.(*apiv1.LogOptions)
+		since *metav1.Time
 	)
 
+	if opts.Tail != nil && *opts.Tail < 0 {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid tail [%d]: must not be negative", *opts.Tail))
+	}
+
+	filter, err := log.NewFilter(opts, time.Now())
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	if t := filter.Since(); t != nil {
+		since = &metav1.Time{Time: *t}
+	}
+
 	output := make(chan log.Message)
 	go func() {
 		defer close(output)
@@ -73,6 +89,8 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 			Follow:           opts.Follow,
 			ContainerReplica: opts.ContainerReplica,
 			Container:        opts.Container,
+			Since:            since,
+			Filter:           filter,
 		})
 		if err != nil {
 			output <- log.Message{
@@ -95,6 +113,7 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 			lm := apiv1.LogMessage{
 				Line:          message.Line,
 				ContainerName: message.ContainerName,
+				Container:     message.ContainerName,
 				Time:          metav1.NewTime(message.Time),
 			}
 
@@ -110,6 +129,10 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 				lm.Error = message.Err.Error()
 			}
 
+			if !filter.Matches(lm) {
+				continue
+			}
+
 			data, err := json.Marshal(lm)
 			if err != nil {
 				panic("failed to marshal update: " + err.Error())
@@ -0,0 +1,72 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	kclient "github.com/acorn-io/runtime/pkg/k8sclient"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewPromote(c client.WithWatch, recorder event.Recorder) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.AppPromote{}).
+		WithCreate(&promoteStrategy{
+			client:   c,
+			recorder: recorder,
+		}).Build()
+}
+
+type promoteStrategy struct {
+	client   client.WithWatch
+	recorder event.Recorder
+}
+
+func (s *promoteStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	ri, ok := request.RequestInfoFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("missing request info")
+	}
+
+	if ri.Name == "" || ri.Namespace == "" {
+		return obj, nil
+	}
+
+	// Use app instance here because in Hub this request is forwarded to the workload cluster.
+	// The app validation logic should not run there.
+	app := &v1.AppInstance{}
+	err := s.client.Get(ctx, kclient.ObjectKey{Namespace: ri.Namespace, Name: ri.Name}, app)
+	if err != nil {
+		return nil, err
+	}
+
+	if !app.Status.Rollout.InProgress(app.Status.AppImage) {
+		return nil, fmt.Errorf("app %s has no rollout in progress", app.Name)
+	}
+
+	// The controller moves the rollout to the next step, or finishes it, and clears these fields
+	app.Status.Rollout.Promote = true
+	app.Status.Rollout.PromoteFull = app.Status.Rollout.PromoteFull || obj.(*apiv1.AppPromote).Full
+
+	if err = s.client.Status().Update(ctx, app); err != nil {
+		return nil, err
+	}
+
+	recordAppAction(ctx, s.recorder, app, AppPromoteEventType, AppPromoteEventDetails{
+		ResourceVersion: app.ResourceVersion,
+		Full:            app.Status.Rollout.PromoteFull,
+	}, "rollout promoted")
+
+	return obj, nil
+}
+
+func (s *promoteStrategy) New() types.Object {
+	return &apiv1.AppPromote{}
+}
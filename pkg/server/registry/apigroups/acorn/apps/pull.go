@@ -50,6 +50,8 @@ func (s *PullAppImageStrategy) Create(ctx context.Context, obj types.Object) (ty
 	} else {
 		app.Status.AvailableAppImage = app.Spec.Image
 	}
+	// The pull is requested by a user, not an auto-upgrade
+	app.Status.ChangeCause = ""
 
 	err = s.client.Status().Update(ctx, app)
 	return p, err
@@ -0,0 +1,179 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	api "github.com/acorn-io/runtime/pkg/apis/api.acorn.io"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/autoupgrade"
+	acornclient "github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/event"
+	kclient "github.com/acorn-io/runtime/pkg/k8sclient"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewRollback(c client.WithWatch, clientFactory *acornclient.Factory, recorder event.Recorder) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.AppRollback{}).
+		WithCreate(&rollbackStrategy{
+			client:    c,
+			recorder:  recorder,
+			validator: NewValidator(c, clientFactory, nil, recorder),
+		}).Build()
+}
+
+type rollbackStrategy struct {
+	client    client.WithWatch
+	recorder  event.Recorder
+	validator updateValidator
+}
+
+type updateValidator interface {
+	ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList
+}
+
+func (s *rollbackStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	ri, ok := request.RequestInfoFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("missing request info")
+	}
+
+	if ri.Name == "" || ri.Namespace == "" {
+		return obj, nil
+	}
+
+	// Use app instance here because in Hub this request is forwarded to the workload cluster. The app is validated
+	// with the spec of the rollback target before it is updated.
+	app := &v1.AppInstance{}
+	err := s.client.Get(ctx, kclient.ObjectKey{Namespace: ri.Namespace, Name: ri.Name}, app)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		oldSpec     = *app.Spec.DeepCopy()
+		revision    = obj.(*apiv1.AppRollback).Revision
+		description = "rollout aborted"
+	)
+	if revision != 0 {
+		err = s.rollbackToRevision(ctx, app, revision)
+		description = fmt.Sprintf("rolled back to revision %d", revision)
+	} else {
+		err = s.abortRollout(ctx, app)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	recordSpecUpdate(ctx, s.recorder, app, oldSpec, app.Spec)
+	recordAppAction(ctx, s.recorder, app, AppRollbackEventType, AppRollbackEventDetails{
+		ResourceVersion: app.ResourceVersion,
+		Revision:        revision,
+	}, description)
+
+	return obj, nil
+}
+
+// abortRollout deploys the stable revision of the rollout in progress again
+func (s *rollbackStrategy) abortRollout(ctx context.Context, app *v1.AppInstance) error {
+	if !app.Status.Rollout.InProgress(app.Status.AppImage) {
+		return fmt.Errorf("app %s has no rollout in progress", app.Name)
+	}
+
+	var (
+		stable     = app.Status.Rollout.Stable.AppImage
+		rolledBack = app.Status.AppImage
+	)
+
+	// Go back to the image reference of the stable revision without pinning it, so that later updates of the tag and
+	// auto-upgrades still apply. Auto-upgrade patterns are kept as they are. The stable app image is deployed again as it
+	// is instead of being pulled, so the rollback gets the same image even if its tag has moved since. Once the stable
+	// image is the app image again the rollout is over and the containers of the new revision are removed.
+	return s.update(ctx, app, func(app *v1.AppInstance) {
+		if _, isPattern := autoupgrade.AutoUpgradePattern(app.Spec.Image); !isPattern && stable.Name != "" {
+			app.Spec.Image = stable.Name
+		}
+	}, func(app *v1.AppInstance) {
+		if app.Status.Rollout == nil {
+			app.Status.Rollout = &v1.RolloutStatus{}
+		}
+		app.Status.AppImage = stable
+		app.Status.AvailableAppImage = ""
+		app.Status.ConfirmUpgradeAppImage = ""
+		app.Status.RollbackRevision = nil
+		app.Status.ChangeCause = v1.AppRevisionTriggerRollback
+		app.Status.Rollout.RolledBackImage = rolledBack.Name
+		app.Status.Rollout.RolledBackDigest = rolledBack.Digest
+	})
+}
+
+// rollbackToRevision deploys the image, deploy args and profiles of a revision of the app history again
+func (s *rollbackStrategy) rollbackToRevision(ctx context.Context, app *v1.AppInstance, number int64) error {
+	revision, ok := app.Status.GetRevision(number)
+	if !ok {
+		return fmt.Errorf("revision %d of app %s does not exist", number, app.Name)
+	}
+
+	// Like for a rollout, the image reference of the revision is not pinned so that later updates of the tag and
+	// auto-upgrades still apply. Auto-upgrade patterns are kept as they are. The image of the revision is pulled by the
+	// digest it had, so the rollback gets the same image even if its tag has moved since.
+	return s.update(ctx, app, func(app *v1.AppInstance) {
+		if _, isPattern := autoupgrade.AutoUpgradePattern(app.Spec.Image); !isPattern && revision.Image != "" {
+			app.Spec.Image = revision.Image
+		}
+		app.Spec.DeployArgs = revision.DeployArgs.DeepCopy()
+		app.Spec.Profiles = revision.Profiles
+	}, func(app *v1.AppInstance) {
+		app.Status.AvailableAppImage = ""
+		app.Status.ConfirmUpgradeAppImage = ""
+		app.Status.RollbackRevision = &revision
+		app.Status.ChangeCause = v1.AppRevisionTriggerRollback
+	})
+}
+
+// update validates the app with the spec it is rolled back to, like an update through the apps storage, and updates it.
+// The image allow rules, app policy rules and permissions may have changed since the revision was deployed. The status
+// is updated before the spec, so that the controller deploys the image of the rollback once the generation of the app
+// changes, instead of pulling the image reference of the spec whose tag may have moved.
+func (s *rollbackStrategy) update(ctx context.Context, app *v1.AppInstance, setSpec, setStatus func(*v1.AppInstance)) error {
+	old := app.DeepCopy()
+	setSpec(app)
+
+	translator := &Translator{}
+	if errs := s.validator.ValidateUpdate(ctx, translator.ToPublic(app), translator.ToPublic(old)); len(errs) > 0 {
+		return apierrors.NewInvalid(schema.GroupKind{Group: api.Group, Kind: "App"}, app.Name, errs)
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := s.client.Get(ctx, client.ObjectKeyFromObject(app), app); err != nil {
+			return err
+		}
+		setStatus(app)
+		return s.client.Status().Update(ctx, app)
+	}); err != nil {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := s.client.Get(ctx, client.ObjectKeyFromObject(app), app); err != nil {
+			return err
+		}
+		setSpec(app)
+		setUpdatedBy(ctx, app)
+		return s.client.Update(ctx, app)
+	})
+}
+
+func (s *rollbackStrategy) New() types.Object {
+	return &apiv1.AppRollback{}
+}
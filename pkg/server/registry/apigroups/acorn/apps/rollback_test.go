@@ -0,0 +1,132 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestRollbackRequiresRequestInfo(t *testing.T) {
+	_, err := (&rollbackStrategy{}).Create(context.Background(), &apiv1.AppRollback{})
+	assert.True(t, apierrors.IsBadRequest(err), "expected a bad request, got %v", err)
+
+	_, err = (&runJobStrategy{}).Create(context.Background(), &apiv1.AppRunJob{})
+	assert.True(t, apierrors.IsBadRequest(err), "expected a bad request, got %v", err)
+
+	_, err = (&promoteStrategy{}).Create(context.Background(), &apiv1.AppPromote{})
+	assert.True(t, apierrors.IsBadRequest(err), "expected a bad request, got %v", err)
+}
+
+func TestRollbackIsValidated(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-app",
+			Namespace: "my-project",
+		},
+		Spec: v1.AppInstanceSpec{
+			Image: "nginx:2",
+		},
+		Status: v1.AppInstanceStatus{
+			// An app in a dev session can't be updated, so the validation fails before any image is resolved
+			DevSession: &v1.DevSessionInstanceSpec{},
+			Revisions: []v1.AppRevision{
+				{Revision: 1, Image: "nginx:1"},
+				{Revision: 2, Image: "nginx:2"},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(app).Build()
+	ctx := request.WithRequestInfo(context.Background(), &request.RequestInfo{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+	})
+
+	_, err := (&rollbackStrategy{
+		client:    c,
+		validator: NewValidator(c, nil, nil, nil),
+	}).Create(ctx, &apiv1.AppRollback{Revision: 1})
+	assert.True(t, apierrors.IsInvalid(err), "expected an invalid error, got %v", err)
+
+	updated := &v1.AppInstance{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(app), updated))
+	assert.Equal(t, "nginx:2", updated.Spec.Image)
+}
+
+type allowUpdates struct{}
+
+func (allowUpdates) ValidateUpdate(context.Context, runtime.Object, runtime.Object) field.ErrorList {
+	return nil
+}
+
+func TestRollbackSetsStatusBeforeSpec(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-app",
+			Namespace: "my-project",
+		},
+		Spec: v1.AppInstanceSpec{
+			Image: "nginx:2",
+		},
+		Status: v1.AppInstanceStatus{
+			Revisions: []v1.AppRevision{
+				{Revision: 1, Image: "nginx:1", Digest: "sha256:1"},
+				{Revision: 2, Image: "nginx:2", Digest: "sha256:2"},
+			},
+		},
+	}
+
+	// The controller must never see the image of the rollback in the spec without the pinned revision in the status
+	var updates []string
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(app).
+		WithStatusSubresource(app).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				stored := &v1.AppInstance{}
+				require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(obj), stored))
+				require.NotNil(t, stored.Status.RollbackRevision, "spec updated before the status")
+				updates = append(updates, "spec")
+				return c.Update(ctx, obj, opts...)
+			},
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				updates = append(updates, subResourceName)
+				return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	ctx := request.WithRequestInfo(context.Background(), &request.RequestInfo{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+	})
+
+	_, err := (&rollbackStrategy{
+		client:    c,
+		recorder:  event.RecorderFunc(func(context.Context, *apiv1.Event) error { return nil }),
+		validator: allowUpdates{},
+	}).Create(ctx, &apiv1.AppRollback{Revision: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"status", "spec"}, updates)
+
+	updated := &v1.AppInstance{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(app), updated))
+	assert.Equal(t, "nginx:1", updated.Spec.Image)
+	if assert.NotNil(t, updated.Status.RollbackRevision) {
+		assert.Equal(t, "sha256:1", updated.Status.RollbackRevision.Digest)
+	}
+}
@@ -0,0 +1,71 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/jobs"
+	kclient "github.com/acorn-io/runtime/pkg/k8sclient"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewRunJob(c client.WithWatch, recorder event.Recorder) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.AppRunJob{}).
+		WithCreate(&runJobStrategy{
+			client:   c,
+			recorder: recorder,
+		}).Build()
+}
+
+type runJobStrategy struct {
+	client   client.WithWatch
+	recorder event.Recorder
+}
+
+func (s *runJobStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	ri, ok := request.RequestInfoFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("missing request info")
+	}
+
+	if ri.Name == "" || ri.Namespace == "" {
+		return obj, nil
+	}
+
+	// Use app instance here because in Hub this request is forwarded to the workload cluster.
+	// The app validation logic should not run there.
+	app := &v1.AppInstance{}
+	err := s.client.Get(ctx, kclient.ObjectKey{Namespace: ri.Namespace, Name: ri.Name}, app)
+	if err != nil {
+		return nil, err
+	}
+
+	runJob := obj.(*apiv1.AppRunJob)
+	runJob.RunName, err = jobs.Run(ctx, s.client, app, runJob.JobName, time.Now())
+	if _, ok := err.(apierrors.APIStatus); ok {
+		return nil, err
+	} else if err != nil {
+		// The job can't be run, like an unknown or unscheduled job
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	recordAppAction(ctx, s.recorder, app, AppRunJobEventType, AppRunJobEventDetails{
+		JobName: runJob.JobName,
+		RunName: runJob.RunName,
+	}, fmt.Sprintf("job %s run as %s", runJob.JobName, runJob.RunName))
+
+	return runJob, nil
+}
+
+func (s *runJobStrategy) New() types.Object {
+	return &apiv1.AppRunJob{}
+}
@@ -20,14 +20,17 @@ func NewStorage(c kclient.WithWatch, clientFactory *client.Factory, recorder eve
 	strategy := translation.NewSimpleTranslationStrategy(&Translator{}, remoteResource)
 	strategy = publicname.NewStrategy(strategy)
 	strategy = newEventRecordingStrategy(strategy, recorder)
+	strategy = newUpdatedByStrategy(strategy)
 	strategy = middleware.ForCompleteStrategy(strategy, middlewares...)
 
-	validator := NewValidator(c, clientFactory, strategy)
+	validator := NewValidator(c, clientFactory, strategy, recorder)
 
-	return stores.NewBuilder(c.Scheme(), &apiv1.App{}).
-		WithCompleteCRUD(strategy).
-		WithValidateUpdate(validator).
-		WithValidateCreate(validator).
-		WithTableConverter(tables.AppConverter).
-		Build()
+	return &dryRunStore{
+		ReadWriteWatchStore: stores.NewBuilder(c.Scheme(), &apiv1.App{}).
+			WithCompleteCRUD(strategy).
+			WithValidateUpdate(validator).
+			WithValidateCreate(validator).
+			WithTableConverter(tables.AppConverter).
+			Build().(*stores.ReadWriteWatchStore),
+	}
 }
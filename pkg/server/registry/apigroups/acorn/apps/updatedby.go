@@ -0,0 +1,46 @@
+package apps
+
+import (
+	"context"
+
+	"github.com/acorn-io/mink/pkg/strategy"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// updatedByStrategy records the user that last created or updated an app, so that the controller can add the user to
+// the revision history of the app.
+type updatedByStrategy struct {
+	strategy.CompleteStrategy
+}
+
+func newUpdatedByStrategy(s strategy.CompleteStrategy) *updatedByStrategy {
+	return &updatedByStrategy{
+		CompleteStrategy: s,
+	}
+}
+
+func (s *updatedByStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	setUpdatedBy(ctx, obj)
+	return s.CompleteStrategy.Create(ctx, obj)
+}
+
+func (s *updatedByStrategy) Update(ctx context.Context, obj types.Object) (types.Object, error) {
+	setUpdatedBy(ctx, obj)
+	return s.CompleteStrategy.Update(ctx, obj)
+}
+
+func setUpdatedBy(ctx context.Context, obj types.Object) {
+	user, ok := request.UserFrom(ctx)
+	if !ok || user.GetName() == "" {
+		return
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[labels.AcornAppUpdatedBy] = user.GetName()
+	obj.SetAnnotations(annotations)
+}
@@ -13,10 +13,12 @@ import (
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/appdefinition"
+	"github.com/acorn-io/runtime/pkg/apppolicy"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/computeclasses"
 	apiv1config "github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/imageallowrules"
 	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/labels"
@@ -40,13 +42,15 @@ type Validator struct {
 	client        kclient.Client
 	clientFactory *client.Factory
 	deleter       strategy.Deleter
+	recorder      event.Recorder
 }
 
-func NewValidator(client kclient.Client, clientFactory *client.Factory, deleter strategy.Deleter) *Validator {
+func NewValidator(client kclient.Client, clientFactory *client.Factory, deleter strategy.Deleter, recorder event.Recorder) *Validator {
 	return &Validator{
 		client:        client,
 		clientFactory: clientFactory,
 		deleter:       deleter,
+		recorder:      recorder,
 	}
 }
 
@@ -78,7 +82,19 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 		return
 	}
 
-	if _, isPattern := autoupgrade.AutoUpgradePattern(params.Spec.Image); !isPattern {
+	// app was stopped, so we don't need to check image allow rules and app policy rules (this could prevent stopping an app if the rules changed)
+	stopped := params.Spec.Stop != nil && *params.Spec.Stop
+
+	if _, isPattern := autoupgrade.AutoUpgradePattern(params.Spec.Image); isPattern && !stopped {
+		// The image the pattern resolves to is checked against the app policy rules. If no image matches the pattern
+		// yet, the image is checked when it is pulled.
+		if imageDetails, err := s.getImageDetails(ctx, params.Namespace, params.Spec.Profiles, params.Spec.DeployArgs, params.Spec.Image, ""); err == nil {
+			if errs := s.checkAppPolicyRules(ctx, params, imageDetails.AppSpec); len(errs) != 0 {
+				result = append(result, errs...)
+				return
+			}
+		}
+	} else if !isPattern {
 		image, local, err := s.resolveLocalImage(ctx, params.Namespace, params.Spec.Image)
 		if err != nil {
 			result = append(result, field.Invalid(field.NewPath("spec", "image"), params.Spec.Image, err.Error()))
@@ -98,13 +114,7 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 			return
 		}
 
-		disableCheckImageAllowRules := false
-		if params.Spec.Stop != nil && *params.Spec.Stop {
-			// app was stopped, so we don't need to check image allow rules (this could prevent stopping an app if the image allow rules changed)
-			disableCheckImageAllowRules = true
-		}
-
-		if !disableCheckImageAllowRules {
+		if !stopped {
 			if err := s.checkImageAllowed(ctx, params.Namespace, params.Spec.Image); err != nil {
 				result = append(result, field.Invalid(field.NewPath("spec", "image"), params.Spec.Image, err.Error()))
 				return
@@ -123,7 +133,7 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 			return
 		}
 
-		errs := s.checkScheduling(ctx, params, project, workloadsFromImage, apiv1cfg.WorkloadMemoryDefault, apiv1cfg.WorkloadMemoryMaximum)
+		errs := s.checkScheduling(ctx, params, project, workloadsFromImage, apiv1cfg.WorkloadMemoryDefault, apiv1cfg.WorkloadMemoryMaximum, apiv1cfg.WorkloadCPUDefault, apiv1cfg.WorkloadCPUMaximum)
 		if len(errs) != 0 {
 			result = append(result, errs...)
 			return
@@ -134,6 +144,23 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 			return
 		}
 
+		if err := validateRoutes(params.Spec.Image, imageDetails.AppSpec); err != nil {
+			result = append(result, err)
+			return
+		}
+
+		if err := validateRolloutNames(params.Spec.Image, imageDetails.AppSpec); err != nil {
+			result = append(result, err)
+			return
+		}
+
+		if !stopped {
+			if errs := s.checkAppPolicyRules(ctx, params, imageDetails.AppSpec); len(errs) != 0 {
+				result = append(result, errs...)
+				return
+			}
+		}
+
 		permsFromImage, err := s.getPermissions(ctx, "", params.Namespace, image, imageDetails)
 		if err != nil {
 			result = append(result, field.Invalid(field.NewPath("spec", "permissions"), params.Spec.Permissions, err.Error()))
@@ -175,6 +202,24 @@ func (s *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object)
 	return s.Validate(ctx, newParams)
 }
 
+// checkAppPolicyRules evaluates the AppPolicyRules of the project and the cluster against the app. Violations of rules
+// in audit mode are recorded as events instead of rejecting the app, unless the request is a dry run.
+func (s *Validator) checkAppPolicyRules(ctx context.Context, app *apiv1.App, appSpec *v1.AppSpec) (result field.ErrorList) {
+	violations, err := apppolicy.Check(ctx, s.client, app.Namespace, app.Name, app.Spec, appSpec)
+	if err != nil {
+		return append(result, field.InternalError(field.NewPath("spec"), err))
+	}
+
+	for _, violation := range violations {
+		if violation.Enforced() {
+			result = append(result, violation.FieldError())
+		} else if s.recorder != nil && !isDryRun(ctx) {
+			recordPolicyViolation(ctx, s.recorder, app, violation)
+		}
+	}
+	return result
+}
+
 func (s *Validator) validateName(app *apiv1.App) error {
 	if app.Name == "" {
 		return fmt.Errorf("name is required")
@@ -387,9 +432,10 @@ func (s *Validator) checkPermissionsForPrivilegeEscalation(ctx context.Context,
 }
 
 // checkScheduling must use apiv1.ComputeCLass to validate the scheduling instead of the Instance counterparts.
-func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, project *apiv1.Project, workloads map[string]v1.Container, specMemDefault, specMemMaximum *int64) []*field.Error {
+func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, project *apiv1.Project, workloads map[string]v1.Container, specMemDefault, specMemMaximum, specCPUDefault, specCPUMaximum *int64) []*field.Error {
 	var (
 		memory        = params.Spec.Memory
+		cpu           = params.Spec.CPU
 		computeClass  = params.Spec.ComputeClasses
 		defaultRegion = project.GetRegion()
 	)
@@ -405,7 +451,14 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, proj
 		validationErrors = append(validationErrors, err...)
 	}
 
+	err = validateCPURunFlags(cpu, workloads)
+	if err != nil {
+		validationErrors = append(validationErrors, err...)
+	}
+
 	for workload, container := range workloads {
+		// The ComputeClass, if any, replaces the cpu default and maximum from the config
+		cpuDefault, cpuMaximum := specCPUDefault, specCPUMaximum
 		cc, err := getClassForWorkload(computeClasses, computeClass, container, workload)
 		if err != nil {
 			validationErrors = append(validationErrors, field.NotFound(field.NewPath("computeclass"), err.Error()))
@@ -426,6 +479,16 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, proj
 
 			memDefault := wcMemory.Def.Value()
 			specMemDefault = &memDefault
+
+			// Parse the cpu
+			wcCPU, err := computeclasses.ParseComputeClassCPU(cc.CPU)
+			if err != nil {
+				validationErrors = append(validationErrors, field.Invalid(field.NewPath("spec", "cpu"), cc.CPU, err.Error()))
+				continue
+			}
+
+			cpuDefault = &[]int64{wcCPU.Def.MilliValue()}[0]
+			cpuMaximum = &[]int64{wcCPU.Max.MilliValue()}[0]
 		}
 
 		// Validate memory
@@ -444,6 +507,20 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, proj
 			validationErrors = append(validationErrors, field.Invalid(path, memQuantity.String(), err.Error()))
 		}
 
+		// Validate cpu
+		cpuQuantity, err := v1.ValidateCPU(cpu, workload, container, cpuDefault, cpuMaximum)
+		if err != nil {
+			path := field.NewPath("unknown")
+			if errors.Is(err, v1.ErrInvalidAcornCPU) {
+				path = field.NewPath("spec", "image")
+			} else if errors.Is(err, v1.ErrInvalidSetCPU) {
+				path = field.NewPath("spec", "cpu", workload)
+			} else if errors.Is(err, v1.ErrInvalidDefaultCPU) {
+				path = field.NewPath("config", "workloadCPUDefault")
+			}
+			validationErrors = append(validationErrors, field.Invalid(path, cpuQuantity.String(), err.Error()))
+		}
+
 		// Need a ComputeClass to validate it
 		if cc == nil {
 			continue
@@ -459,6 +536,20 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, proj
 				validationErrors = append(validationErrors, field.Invalid(field.NewPath("unknown"), "", err.Error()))
 			}
 		}
+
+		// A cpu of 0 means that the cpu is derived from the memory by the ComputeClass.
+		if cpuQuantity.IsZero() {
+			continue
+		}
+		if err := computeclasses.ValidateCPU(*cc, cpuQuantity, cpuDefault); err != nil {
+			if errors.Is(err, computeclasses.ErrInvalidClass) {
+				validationErrors = append(validationErrors, field.Invalid(field.NewPath("computeclass"), cc.Name, err.Error()))
+			} else if errors.Is(err, computeclasses.ErrInvalidCPUForClass) {
+				validationErrors = append(validationErrors, field.Invalid(field.NewPath("cpu"), cpuQuantity.String(), err.Error()))
+			} else {
+				validationErrors = append(validationErrors, field.Invalid(field.NewPath("unknown"), "", err.Error()))
+			}
+		}
 	}
 	return validationErrors
 }
@@ -494,6 +585,20 @@ func validateMemoryRunFlags(memory v1.MemoryMap, workloads map[string]v1.Contain
 	return validationErrors
 }
 
+func validateCPURunFlags(cpu v1.CPUMap, workloads map[string]v1.Container) []*field.Error {
+	var validationErrors []*field.Error
+	for key := range cpu {
+		if key == "" {
+			continue
+		}
+		if _, ok := workloads[key]; !ok {
+			path := field.NewPath("spec", "cpu")
+			validationErrors = append(validationErrors, field.Invalid(path, key, v1.ErrInvalidWorkload.Error()))
+		}
+	}
+	return validationErrors
+}
+
 func validateVolumeClasses(ctx context.Context, c kclient.Client, namespace string, appInstanceSpec v1.AppInstanceSpec, appSpec *v1.AppSpec, project *apiv1.Project) *field.Error {
 	if len(appInstanceSpec.Volumes) == 0 && len(appSpec.Volumes) == 0 {
 		return nil
@@ -521,6 +626,18 @@ func validateVolumeClasses(ctx context.Context, c kclient.Client, namespace stri
 		if volClass, ok := volumeClasses[vol.Class]; vol.Class != "" && (!ok || volClass.Inactive || (volClass.SupportedRegions != nil && !slices.Contains(volClass.SupportedRegions, defaultRegion) && !slices.Contains(volClass.SupportedRegions, appInstanceSpec.Region))) {
 			return field.Invalid(field.NewPath("spec", "volumes").Index(i), vol.Class, "not a valid volume class")
 		}
+		if vol.From != "" {
+			if vol.Volume != "" {
+				return field.Invalid(field.NewPath("spec", "volumes").Index(i), vol.From, "can not restore from a snapshot when binding an existing volume")
+			}
+			snapshotName, ok := v1.ParseSnapshotFrom(vol.From)
+			if !ok {
+				return field.Invalid(field.NewPath("spec", "volumes").Index(i), vol.From, fmt.Sprintf("must be in the format %sNAME", v1.SnapshotFromPrefix))
+			}
+			if err := c.Get(ctx, kclient.ObjectKey{Namespace: namespace, Name: snapshotName}, new(apiv1.VolumeSnapshot)); err != nil {
+				return field.Invalid(field.NewPath("spec", "volumes").Index(i), vol.From, fmt.Sprintf("error checking volume snapshot: %v", err))
+			}
+		}
 		volumeBindings[vol.Target] = vol
 	}
 
@@ -559,6 +676,32 @@ func validateVolumeClasses(ctx context.Context, c kclient.Client, namespace stri
 	return nil
 }
 
+// validateRoutes checks the routes of the routers of the app, so invalid routes are rejected instead of failing when
+// the router is deployed
+func validateRoutes(image string, appSpec *v1.AppSpec) *field.Error {
+	for _, routerName := range typed.SortedKeys(appSpec.Routers) {
+		for _, route := range appSpec.Routers[routerName].Routes {
+			if route.Empty() {
+				continue
+			}
+			if err := route.Validate(); err != nil {
+				return field.Invalid(field.NewPath("spec", "image"), image, fmt.Sprintf("invalid route [%s] of router [%s]: %v", route.Path, routerName, err))
+			}
+		}
+	}
+	return nil
+}
+
+// validateRolloutNames rejects apps with a container, job, router, service or nested acorn that has the name of an
+// object of the rollout of a container, which would be overwritten by the rollout
+func validateRolloutNames(image string, appSpec *v1.AppSpec) *field.Error {
+	if objectName, containerName, ok := appSpec.RolloutNameConflict(); ok {
+		return field.Invalid(field.NewPath("spec", "image"), image,
+			fmt.Sprintf("name [%s] is reserved for the rollouts of container [%s]", objectName, containerName))
+	}
+	return nil
+}
+
 func (s *Validator) getPermissions(ctx context.Context, servicePrefix, namespace, image string, details *client.ImageDetails) (result []v1.Permissions, _ error) {
 	result = append(result, buildPermissionsFrom(servicePrefix, details.AppSpec.Containers)...)
 	result = append(result, buildPermissionsFrom(servicePrefix, details.AppSpec.Jobs)...)
@@ -144,3 +144,52 @@ func TestCannotUpdateNestedAcorn(t *testing.T) {
 		assert.True(t, strings.Contains(err[0].Error(), "update the parent Acorn"))
 	}
 }
+
+func TestValidateRoutes(t *testing.T) {
+	appSpec := &internalv1.AppSpec{
+		Routers: map[string]internalv1.Router{
+			"router": {
+				Routes: internalv1.Routes{
+					{Path: "/", TargetServiceName: "web"},
+					{Path: "/api", Targets: []internalv1.RouteTarget{{TargetServiceName: "api"}}},
+					// Routes without a target are ignored
+					{Path: "no slash"},
+				},
+			},
+		},
+	}
+	assert.Nil(t, validateRoutes("image", appSpec))
+
+	appSpec.Routers["router"].Routes[0].Rewrite = "api"
+	err := validateRoutes("image", appSpec)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "spec.image", err.Field)
+		assert.Equal(t, "invalid route [/] of router [router]: rewrite [api] must start with / and must not contain whitespace or any of \"\\$", err.Detail)
+	}
+}
+
+func TestValidateRolloutNames(t *testing.T) {
+	appSpec := &internalv1.AppSpec{
+		Containers: map[string]internalv1.Container{
+			"web": {},
+			"api": {},
+		},
+		Services: map[string]internalv1.Service{
+			"db": {},
+		},
+	}
+	assert.Nil(t, validateRolloutNames("image", appSpec))
+
+	for _, name := range []string{"web-canary", "web-preview", "web-stable", "web-rollout"} {
+		appSpec.Jobs = map[string]internalv1.Container{name: {}}
+		err := validateRolloutNames("image", appSpec)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "spec.image", err.Field)
+			assert.Equal(t, "name ["+name+"] is reserved for the rollouts of container [web]", err.Detail)
+		}
+	}
+
+	appSpec.Jobs = nil
+	appSpec.Routers = map[string]internalv1.Router{"api-canary": {}}
+	assert.NotNil(t, validateRolloutNames("image", appSpec))
+}
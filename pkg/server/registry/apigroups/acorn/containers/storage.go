@@ -4,17 +4,27 @@ import (
 	"github.com/acorn-io/mink/pkg/stores"
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
 	"github.com/acorn-io/runtime/pkg/tables"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewStorage(c client.WithWatch) rest.Storage {
-	strategy := translation.NewTranslationStrategy(&Translator{
+var auditedContainerReplica = audit.Resource{
+	Kind: "ContainerReplica",
+	Spec: func(obj types.Object) any {
+		return obj.(*apiv1.ContainerReplica).Spec
+	},
+}
+
+func NewStorage(c client.WithWatch, recorder event.Recorder) rest.Storage {
+	strategy := audit.NewStrategy(translation.NewTranslationStrategy(&Translator{
 		client: c,
-	}, remote.NewRemote(&corev1.Pod{}, c))
+	}, remote.NewRemote(&corev1.Pod{}, c)), recorder, auditedContainerReplica)
 
 	return stores.NewBuilder(c.Scheme(), &apiv1.ContainerReplica{}).
 		WithGet(strategy).
@@ -4,18 +4,34 @@ import (
 	"github.com/acorn-io/mink/pkg/stores"
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
 	"github.com/acorn-io/mink/pkg/validator"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
 	"github.com/acorn-io/runtime/pkg/tables"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiserver/pkg/registry/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewStore(c kclient.WithWatch) rest.Storage {
-	remoteResource := translation.NewTranslationStrategy(&Translator{
+var auditedCredential = audit.Resource{
+	Kind: "Credential",
+	Spec: func(obj types.Object) any {
+		cred := obj.(*apiv1.Credential)
+		return map[string]any{
+			"serverAddress": cred.ServerAddress,
+			"username":      cred.Username,
+			"password":      cred.GetPassword(),
+		}
+	},
+	Redacted: []string{"/password"},
+}
+
+func NewStore(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewTranslationStrategy(&Translator{
 		client: c,
-	}, remote.NewRemote(&corev1.Secret{}, c))
+	}, remote.NewRemote(&corev1.Secret{}, c)), recorder, auditedCredential)
 
 	strategy := &Strategy{}
 	return stores.NewBuilder(c.Scheme(), &apiv1.Credential{}).
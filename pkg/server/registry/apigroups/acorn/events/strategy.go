@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/acorn-io/mink/pkg/strategy"
 	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/channels"
+	"github.com/acorn-io/runtime/pkg/log"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/apiserver/pkg/storage"
@@ -67,6 +70,18 @@ type query struct {
 
 	// tail when > 0, determines the number of latest events to return.
 	tail int64
+
+	// eventType, severity, sourceKind, sourceName and actor when not empty, are matched exactly.
+	eventType, severity, sourceKind, sourceName, actor string
+
+	// since and until when not zero, limit the events to the ones observed in the time window.
+	since, until time.Time
+
+	// collapse determines if repeated events are returned as the latest of them with a count.
+	collapse bool
+
+	// counts holds the number of repeated events seen by a watch when collapsing.
+	counts map[string]int
 }
 
 // filterChannel applies the query to every event recieved from unfiltered and forwards the result to filtered, if any.
@@ -104,17 +119,46 @@ func (q query) filterEvent(e watch.Event) *watch.Event {
 
 	// Attempt to filter
 	obj := e.Object.(*apiv1.Event)
-	filtered := q.filter(*obj)
-	if len(filtered) < 1 {
+	if !q.matches(*obj) {
 		// Drop the event, it's been filtered out
 		return nil
 	}
 
-	e.Object = filtered[0].DeepCopy()
+	filtered := q.strip(*obj)
+	if q.collapse && e.Type == watch.Added {
+		key := (*v1.EventInstance)(&filtered).RepeatKey()
+		q.counts[key]++
+		filtered.Count = q.counts[key]
+	}
+
+	e.Object = filtered.DeepCopy()
 
 	return &e
 }
 
+// matches returns true if the event meets the query criteria.
+func (q query) matches(e apiv1.Event) bool {
+	switch {
+	case q.eventType != "" && e.Type != q.eventType,
+		q.severity != "" && string(e.Severity) != q.severity,
+		q.sourceKind != "" && e.Source.Kind != q.sourceKind,
+		q.sourceName != "" && e.Source.Name != q.sourceName,
+		q.actor != "" && e.Actor != q.actor,
+		!q.since.IsZero() && e.Observed.Before(q.since),
+		!q.until.IsZero() && e.Observed.After(q.until):
+		return false
+	}
+	return true
+}
+
+// strip removes the details from the event, unless they were requested.
+func (q query) strip(e apiv1.Event) apiv1.Event {
+	if !q.details {
+		e.Details = nil
+	}
+	return e
+}
+
 // filter returns the result of applying the query to a slice of events.
 func (q query) filter(events ...apiv1.Event) []apiv1.Event {
 	// Sort into chronological order (by observed)
@@ -122,6 +166,18 @@ func (q query) filter(events ...apiv1.Event) []apiv1.Event {
 		return events[i].Observed.Before(events[j].Observed.Time)
 	})
 
+	matched := events[:0]
+	for _, event := range events {
+		if q.matches(event) {
+			matched = append(matched, event)
+		}
+	}
+	events = matched
+
+	if q.collapse {
+		events = collapse(events)
+	}
+
 	tail := len(events)
 	if q.tail > 0 && q.tail < int64(tail) {
 		tail = int(q.tail)
@@ -129,31 +185,73 @@ func (q query) filter(events ...apiv1.Event) []apiv1.Event {
 
 	events = events[len(events)-tail:]
 
-	if q.details {
-		return events
+	for i, event := range events {
+		events[i] = q.strip(event)
 	}
 
+	return events
+}
+
+// collapse replaces repeated events with the latest of them and sets the number of repeats as its count.
+// The events must be in chronological order.
+func collapse(events []apiv1.Event) []apiv1.Event {
+	var (
+		totals = make(map[string]int, len(events))
+		seen   = make(map[string]int, len(events))
+		keys   = make([]string, len(events))
+	)
+	for i := range events {
+		keys[i] = (*v1.EventInstance)(&events[i]).RepeatKey()
+		totals[keys[i]]++
+	}
+
+	collapsed := make([]apiv1.Event, 0, len(totals))
 	for i, event := range events {
-		event.Details = nil
-		events[i] = event
+		seen[keys[i]]++
+		if seen[keys[i]] < totals[keys[i]] {
+			// Only keep the latest repeat of the event
+			continue
+		}
+		event.Count = totals[keys[i]]
+		collapsed = append(collapsed, event)
 	}
 
-	return events
+	return collapsed
 }
 
 // stripQuery extracts the query from the given options, returning the query
 // and new options sans the query.
 func stripQuery(opts storage.ListOptions) (q query, stripped storage.ListOptions, err error) {
 	stripped = opts
+	now := time.Now()
 
 	stripped.Predicate.Field, err = stripped.Predicate.Field.Transform(func(f, v string) (string, string, error) {
 		var err error
 		switch f {
 		case "details":
 			q.details, err = strconv.ParseBool(v)
+		case "type":
+			q.eventType = v
+		case "severity":
+			q.severity = v
+		case "source.kind":
+			q.sourceKind = v
+		case "source.name":
+			q.sourceName = v
+		case "actor":
+			q.actor = v
+		case "since":
+			q.since, err = log.ParseTime(v, now)
+		case "until":
+			q.until, err = log.ParseTime(v, now)
+		case "collapse":
+			q.collapse, err = strconv.ParseBool(v)
 		default:
 			return f, v, nil
 		}
+		if err != nil {
+			err = fmt.Errorf("invalid %s [%s]: %w", f, v, err)
+		}
 
 		return "", "", err
 	})
@@ -162,6 +260,7 @@ func stripQuery(opts storage.ListOptions) (q query, stripped storage.ListOptions
 	}
 
 	q.tail, stripped.Predicate.Limit = stripped.Predicate.Limit, 0
+	q.counts = map[string]int{}
 
 	return
 }
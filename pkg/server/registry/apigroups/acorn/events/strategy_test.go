@@ -0,0 +1,164 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+var start = time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func newEvent(name, eventType string, severity v1.EventSeverity, app string, observed time.Duration) apiv1.Event {
+	return apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Type:        eventType,
+		Severity:    severity,
+		Actor:       "acorn-system",
+		Source:      v1.EventSource{Kind: "app", Name: app},
+		Description: eventType + " " + app,
+		Observed:    v1.MicroTime(metav1.NewMicroTime(start.Add(observed))),
+		Details:     v1.GenericMap{"app": app},
+	}
+}
+
+func names(events []apiv1.Event) []string {
+	result := make([]string, 0, len(events))
+	for _, e := range events {
+		result = append(result, e.Name)
+	}
+	return result
+}
+
+func testEvents() []apiv1.Event {
+	return []apiv1.Event{
+		newEvent("c", "JobFailed", v1.EventSeverityWarn, "foo", 2*time.Minute),
+		newEvent("a", "JobFailed", v1.EventSeverityWarn, "foo", 0),
+		newEvent("b", "AppCreated", v1.EventSeverityInfo, "bar", time.Minute),
+		newEvent("d", "JobFailed", v1.EventSeverityWarn, "bar", 3*time.Minute),
+		newEvent("e", "JobFailed", v1.EventSeverityWarn, "foo", 4*time.Minute),
+	}
+}
+
+func TestQueryFilter(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		q        query
+		expected []string
+	}{
+		{
+			name:     "all in chronological order",
+			expected: []string{"a", "b", "c", "d", "e"},
+		},
+		{
+			name:     "type",
+			q:        query{eventType: "AppCreated"},
+			expected: []string{"b"},
+		},
+		{
+			name:     "severity",
+			q:        query{severity: string(v1.EventSeverityWarn)},
+			expected: []string{"a", "c", "d", "e"},
+		},
+		{
+			name:     "source",
+			q:        query{sourceKind: "app", sourceName: "bar"},
+			expected: []string{"b", "d"},
+		},
+		{
+			name:     "actor",
+			q:        query{actor: "someone"},
+			expected: []string{},
+		},
+		{
+			name:     "time window",
+			q:        query{since: start.Add(time.Minute), until: start.Add(3 * time.Minute)},
+			expected: []string{"b", "c", "d"},
+		},
+		{
+			name:     "tail after matching",
+			q:        query{sourceName: "foo", tail: 2},
+			expected: []string{"c", "e"},
+		},
+		{
+			name:     "collapse keeps the latest repeat",
+			q:        query{collapse: true},
+			expected: []string{"b", "d", "e"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, names(tt.q.filter(testEvents()...)))
+		})
+	}
+}
+
+func TestQueryFilterCollapseCount(t *testing.T) {
+	events := query{collapse: true, details: true}.filter(testEvents()...)
+	require.Len(t, events, 3)
+	assert.Equal(t, 1, events[0].Count)
+	assert.Equal(t, 1, events[1].Count)
+	assert.Equal(t, 3, events[2].Count)
+	assert.NotNil(t, events[2].Details)
+
+	events = query{}.filter(testEvents()...)
+	for _, e := range events {
+		assert.Zero(t, e.Count)
+		assert.Nil(t, e.Details)
+	}
+}
+
+func TestQueryFilterEventCollapse(t *testing.T) {
+	q := query{collapse: true, counts: map[string]int{}}
+	for i, expected := range []int{1, 2, 3} {
+		e := newEvent("e", "JobFailed", v1.EventSeverityWarn, "foo", time.Duration(i)*time.Minute)
+		filtered := q.filterEvent(watch.Event{Type: watch.Added, Object: &e})
+		require.NotNil(t, filtered)
+		assert.Equal(t, expected, filtered.Object.(*apiv1.Event).Count)
+	}
+
+	e := newEvent("e", "AppCreated", v1.EventSeverityInfo, "foo", 0)
+	assert.Nil(t, query{eventType: "JobFailed"}.filterEvent(watch.Event{Type: watch.Added, Object: &e}))
+}
+
+func TestStripQuery(t *testing.T) {
+	selector, err := fields.ParseSelector("type=JobFailed,severity=warn,source.kind=app,source.name=foo,actor=acorn-system,since=2023-05-01T12:00:00Z,collapse=true,metadata.name=abc")
+	require.NoError(t, err)
+
+	q, stripped, err := stripQuery(storage.ListOptions{
+		Predicate: storage.SelectionPredicate{
+			Field: selector,
+			Limit: 5,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "JobFailed", q.eventType)
+	assert.Equal(t, "warn", q.severity)
+	assert.Equal(t, "app", q.sourceKind)
+	assert.Equal(t, "foo", q.sourceName)
+	assert.Equal(t, "acorn-system", q.actor)
+	assert.True(t, start.Equal(q.since))
+	assert.True(t, q.until.IsZero())
+	assert.True(t, q.collapse)
+	assert.Equal(t, int64(5), q.tail)
+	assert.Zero(t, stripped.Predicate.Limit)
+	assert.Equal(t, "metadata.name=abc", stripped.Predicate.Field.String())
+
+	selector, err = fields.ParseSelector("since=yesterday")
+	require.NoError(t, err)
+	_, _, err = stripQuery(storage.ListOptions{
+		Predicate: storage.SelectionPredicate{
+			Field: selector,
+		},
+	})
+	assert.ErrorContains(t, err, "invalid since [yesterday]")
+}
@@ -0,0 +1,36 @@
+package eventsubscriptions
+
+import (
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/strategy/remote"
+	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var auditedEventSubscription = audit.Resource{
+	Kind: "EventSubscription",
+	Spec: func(obj types.Object) any {
+		return obj.(*apiv1.EventSubscription).Spec
+	},
+	// The URL of a webhook can have credentials
+	Redacted: []string{"/webhook/url"},
+}
+
+func NewStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&Translator{},
+		remote.NewRemote(&v1.EventSubscriptionInstance{}, c)), recorder, auditedEventSubscription)
+
+	return stores.NewBuilder(c.Scheme(), &apiv1.EventSubscription{}).
+		WithValidateCreate(&Validator{}).
+		WithValidateUpdate(&Validator{}).
+		WithCompleteCRUD(remoteResource).
+		WithTableConverter(tables.EventSubscriptionConverter).
+		Build()
+}
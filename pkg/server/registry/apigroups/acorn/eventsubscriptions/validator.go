@@ -0,0 +1,41 @@
+package eventsubscriptions
+
+import (
+	"context"
+	"net/url"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type Validator struct{}
+
+func (s *Validator) Validate(_ context.Context, obj runtime.Object) (result field.ErrorList) {
+	subscription := obj.(*apiv1.EventSubscription)
+
+	urlPath := field.NewPath("spec", "webhook", "url")
+	if subscription.Spec.Webhook.URL == "" {
+		result = append(result, field.Required(urlPath, "webhook url must be specified"))
+	} else if u, err := url.Parse(subscription.Spec.Webhook.URL); err != nil {
+		result = append(result, field.Invalid(urlPath, subscription.Spec.Webhook.URL, err.Error()))
+	} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		result = append(result, field.Invalid(urlPath, subscription.Spec.Webhook.URL, "must be an http or https url"))
+	} else if event.IsInternalHost(u.Hostname()) {
+		result = append(result, field.Invalid(urlPath, subscription.Spec.Webhook.URL, "must not be a loopback, link-local or private address"))
+	}
+
+	for i, severity := range subscription.Spec.Severities {
+		if severity != v1.EventSeverityInfo && severity != v1.EventSeverityWarn {
+			result = append(result, field.NotSupported(field.NewPath("spec", "severities").Index(i), severity,
+				[]string{string(v1.EventSeverityInfo), string(v1.EventSeverityWarn)}))
+		}
+	}
+	return
+}
+
+func (s *Validator) ValidateUpdate(ctx context.Context, obj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, obj)
+}
@@ -4,16 +4,31 @@ import (
 	"github.com/acorn-io/mink/pkg/stores"
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
 	"github.com/acorn-io/runtime/pkg/tables"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewStorage(c client.WithWatch) rest.Storage {
-	remoteResource := translation.NewSimpleTranslationStrategy(&Translator{},
-		remote.NewRemote(&v1.ImageAllowRuleInstance{}, c))
+var auditedImageAllowRule = audit.Resource{
+	Kind: "ImageAllowRule",
+	Spec: func(obj types.Object) any {
+		rule := obj.(*apiv1.ImageAllowRule)
+		return map[string]any{
+			"images":     rule.Images,
+			"signatures": rule.Signatures,
+			"sbom":       rule.SBOM,
+		}
+	},
+}
+
+func NewStorage(c client.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&Translator{},
+		remote.NewRemote(&v1.ImageAllowRuleInstance{}, c)), recorder, auditedImageAllowRule)
 
 	return stores.NewBuilder(c.Scheme(), &apiv1.ImageAllowRule{}).
 		WithValidateCreate(&Validator{}).
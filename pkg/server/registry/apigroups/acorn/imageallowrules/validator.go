@@ -5,6 +5,8 @@ import (
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/sbom"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -17,6 +19,11 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 		return append(result, field.Invalid(field.NewPath(""), aiar, "at least one of scope or signatures must be specified"))
 	}
 	result = append(result, validateSignatureRules(ctx, aiar.Signatures)...)
+	for i, format := range aiar.SBOM.Formats {
+		if _, err := sbom.MediaType(format); err != nil {
+			result = append(result, field.Invalid(field.NewPath("sbom", "formats").Index(i), format, err.Error()))
+		}
+	}
 	return
 }
 
@@ -26,8 +33,13 @@ func (s *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object)
 
 func validateSignatureRules(ctx context.Context, sigRules internalv1.ImageAllowRuleSignatures) (result field.ErrorList) {
 	for i, rule := range sigRules.Rules {
-		if len(rule.SignedBy.AnyOf) == 0 && len(rule.SignedBy.AllOf) == 0 {
-			result = append(result, field.Invalid(field.NewPath("signatures").Index(i).Child("signedBy"), rule.SignedBy, "must not be empty (at least one of anyOf or allOf must be specified)"))
+		if len(rule.SignedBy.AnyOf) == 0 && len(rule.SignedBy.AllOf) == 0 && rule.SignedBy.Keyless == nil {
+			result = append(result, field.Invalid(field.NewPath("signatures").Index(i).Child("signedBy"), rule.SignedBy, "must not be empty (at least one of anyOf, allOf or keyless must be specified)"))
+		}
+		if rule.SignedBy.Keyless != nil {
+			if err := cosign.ValidateKeylessSigner(*rule.SignedBy.Keyless); err != nil {
+				result = append(result, field.Invalid(field.NewPath("signatures").Index(i).Child("signedBy", "keyless"), rule.SignedBy.Keyless, err.Error()))
+			}
 		}
 	}
 
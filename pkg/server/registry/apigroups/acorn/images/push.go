@@ -12,9 +12,11 @@ import (
 	"github.com/acorn-io/mink/pkg/strategy"
 	api "github.com/acorn-io/runtime/pkg/apis/api.acorn.io"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cosign"
 	"github.com/acorn-io/runtime/pkg/images"
 	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/k8schannel"
+	"github.com/acorn-io/runtime/pkg/sbom"
 	"github.com/google/go-containerregistry/pkg/name"
 	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
@@ -161,15 +163,44 @@ func (i *ImagePush) ImagePush(ctx context.Context, image *apiv1.Image, tagName s
 		return nil, nil, err
 	}
 
+	writeProgress := make(chan ggcrv1.Update)
+	go func() {
+		err := remote.WriteIndex(pushTag, remoteImage, append(opts, remote.WithProgress(writeProgress))...)
+		handleWriteIndexError(err, writeProgress)
+	}()
+
 	progress := make(chan ggcrv1.Update)
-	opts = append(opts, remote.WithProgress(progress))
 	go func() {
-		err := remote.WriteIndex(pushTag, remoteImage, opts...)
-		handleWriteIndexError(err, progress)
+		defer close(progress)
+
+		var failed bool
+		for update := range writeProgress {
+			failed = failed || update.Error != nil
+			progress <- update
+		}
+		if failed {
+			return
+		}
+
+		// Signatures made with acorn image sign and SBOMs are stored next to the image, so they are pushed once the
+		// image is, so that a signature never refers to an image that is missing from the registry
+		if err := copyReferrers(repo.Digest(image.Digest), pushTag, opts...); err != nil {
+			progress <- ggcrv1.Update{
+				Error: err,
+			}
+		}
 	}()
 	return image, typed.Every(500*time.Millisecond, progress), nil
 }
 
+func copyReferrers(src name.Digest, dest name.Reference, opts ...remote.Option) error {
+	if _, err := cosign.CopySignatures(src, dest.Context(), opts...); err != nil {
+		return err
+	}
+	_, err := sbom.Copy(src, dest.Context(), opts...)
+	return err
+}
+
 func handleWriteIndexError(err error, progress chan ggcrv1.Update) {
 	if err == nil {
 		return
@@ -0,0 +1,104 @@
+package images
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/imagesystem"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var auditedImageSign = audit.Resource{
+	Kind: "ImageSign",
+	Spec: func(obj types.Object) any {
+		return map[string]any{
+			"signatureDigest": obj.(*apiv1.ImageSign).SignatureDigest,
+		}
+	},
+}
+
+func NewImageSign(c client.WithWatch, transport http.RoundTripper, recorder event.Recorder) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImageSign{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(audit.NewCreateStrategy(&ImageSignStrategy{
+			client:       c,
+			transportOpt: remote.WithTransport(transport),
+		}, recorder, auditedImageSign)).Build()
+}
+
+type ImageSignStrategy struct {
+	client       client.WithWatch
+	transportOpt remote.Option
+}
+
+func (s *ImageSignStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	sign := obj.(*apiv1.ImageSign)
+	if sign.Name == "" {
+		ri, ok := request.RequestInfoFrom(ctx)
+		if ok {
+			sign.Name = ri.Name
+		}
+	}
+	ns, _ := request.NamespaceFrom(ctx)
+
+	if sign.PublicKey != "" && !isInlineKey(sign.PublicKey) {
+		return nil, apierrors.NewBadRequest("publicKey must be a PEM encoded public key")
+	}
+
+	// Images that are referenced with a registry are verified against the signatures in that registry, so signing
+	// them here would have no effect.
+	imageName := strings.ReplaceAll(sign.Name, "+", "/")
+	if ref, err := name.ParseReference(imageName, name.WithDefaultRegistry(""), name.WithDefaultTag("")); err == nil && ref.Context().RegistryStr() != "" {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("image %s is stored in registry %s, it has to be signed there", imageName, ref.Context().RegistryStr()))
+	}
+
+	image := &apiv1.Image{}
+	if err := s.client.Get(ctx, router.Key(ns, sign.Name), image); err != nil {
+		return nil, err
+	}
+	if image.Remote {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("image %s is not stored in this project", imageName))
+	}
+
+	ref, err := imagesystem.GetInternalRepoForNamespaceAndID(ctx, s.client, ns, strings.TrimPrefix(image.Digest, "sha256:"))
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := images.GetAuthenticationRemoteOptions(ctx, s.client, ns, s.transportOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	sigDigest, err := cosign.AttachSignature(ctx, ref.Context().Digest(image.Digest), sign.Payload, sign.Signature, sign.PublicKey, opts...)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("failed to sign image %s: %v", imageName, err))
+	}
+
+	sign.Name = image.Name
+	sign.Namespace = image.Namespace
+	sign.Payload = nil
+	sign.Signature = ""
+	sign.SignatureDigest = sigDigest
+	return sign, nil
+}
+
+func (s *ImageSignStrategy) New() types.Object {
+	return &apiv1.ImageSign{}
+}
@@ -0,0 +1,29 @@
+package images
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+func TestImageSignRejectsKeyRefs(t *testing.T) {
+	s := &ImageSignStrategy{}
+	ctx := request.WithNamespace(context.Background(), "acorn")
+
+	for _, key := range []string{"k8s://acorn-system/cosign-key", "/etc/cosign/cosign.pub", "pkcs11:token=acorn"} {
+		_, err := s.Create(ctx, &apiv1.ImageSign{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "1234567890ab",
+			},
+			Payload:   []byte("payload"),
+			Signature: "signature",
+			PublicKey: key,
+		})
+		assert.Truef(t, apierrors.IsBadRequest(err), "expected a bad request for key %s, got %v", key, err)
+	}
+}
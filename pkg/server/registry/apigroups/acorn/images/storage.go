@@ -4,16 +4,30 @@ import (
 	"github.com/acorn-io/mink/pkg/stores"
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
 	"github.com/acorn-io/runtime/pkg/tables"
 	"k8s.io/apiserver/pkg/registry/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewStorage(c kclient.WithWatch) rest.Storage {
-	remoteResource := translation.NewSimpleTranslationStrategy(&Translator{},
-		remote.NewRemote(&v1.ImageInstance{}, c))
+var auditedImage = audit.Resource{
+	Kind: "Image",
+	Spec: func(obj types.Object) any {
+		img := obj.(*apiv1.Image)
+		return map[string]any{
+			"digest": img.Digest,
+			"tags":   img.Tags,
+		}
+	},
+}
+
+func NewStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&Translator{},
+		remote.NewRemote(&v1.ImageInstance{}, c)), recorder, auditedImage)
 
 	strategy := NewStrategy(remoteResource, c)
 	return stores.NewBuilder(c.Scheme(), &apiv1.Image{}).
@@ -24,14 +24,16 @@ import (
 )
 
 type Strategy struct {
-	client kclient.Client
-	getter strategy.Getter
+	client  kclient.Client
+	getter  strategy.Getter
+	deleter strategy.Deleter
 }
 
-func NewStrategy(getter strategy.Getter, c kclient.WithWatch) *Strategy {
+func NewStrategy(remote strategy.CompleteStrategy, c kclient.WithWatch) *Strategy {
 	return &Strategy{
-		client: c,
-		getter: getter,
+		client:  c,
+		getter:  remote,
+		deleter: remote,
 	}
 }
 
@@ -160,7 +162,10 @@ func (s *Strategy) Delete(ctx context.Context, obj types.Object) (types.Object,
 	if err != nil {
 		return nil, err
 	}
-	return image, s.client.Delete(ctx, imageToDelete)
+	if _, err := s.deleter.Delete(ctx, (*apiv1.Image)(imageToDelete)); err != nil {
+		return nil, err
+	}
+	return image, nil
 }
 
 func (s *Strategy) ImageGet(ctx context.Context, namespace, name string) (*apiv1.Image, error) {
@@ -0,0 +1,128 @@
+package images
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/mink/pkg/validator"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/imageallowrules"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ocosign "github.com/sigstore/cosign/v2/pkg/cosign"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewImageVerify(c client.WithWatch, transport http.RoundTripper) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ImageVerify{}).
+		WithValidateName(validator.NoValidation).
+		WithCreate(&ImageVerifyStrategy{
+			client:       c,
+			transportOpt: remote.WithTransport(transport),
+		}).Build()
+}
+
+type ImageVerifyStrategy struct {
+	client       client.WithWatch
+	transportOpt remote.Option
+}
+
+func (s *ImageVerifyStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	verify := obj.(*apiv1.ImageVerify)
+	if verify.Name == "" {
+		ri, ok := request.RequestInfoFrom(ctx)
+		if ok {
+			verify.Name = ri.Name
+		}
+	}
+	ns, _ := request.NamespaceFrom(ctx)
+	imageName := strings.ReplaceAll(verify.Name, "+", "/")
+
+	opts := []remote.Option{s.transportOpt}
+	if verify.Auth != nil {
+		ref, err := name.ParseReference(imageName)
+		if err == nil {
+			opts = append(opts, remote.WithAuthFromKeychain(images.NewSimpleKeychain(ref.Context(), *verify.Auth, nil)))
+		}
+	}
+
+	if verify.Key != "" && !isInlineKey(verify.Key) {
+		return nil, apierrors.NewBadRequest("key must be a PEM encoded public key")
+	}
+
+	digest, err := s.resolveDigest(ctx, ns, verify.Name, opts...)
+	if err != nil {
+		return nil, err
+	}
+	verify.Digest = digest
+	verify.Auth = nil
+
+	if verify.Key != "" {
+		err := imageallowrules.VerifyImageSignature(ctx, s.client, ns, imageName, digest, verify.Key, verify.Annotations, opts...)
+		var verr *ocosign.VerificationError
+		if errors.As(err, &verr) {
+			verify.Message = err.Error()
+		} else if err != nil {
+			return nil, err
+		} else {
+			verify.Verified = true
+			verify.Message = "signature verified with the given key"
+		}
+		return verify, nil
+	}
+
+	results, err := imageallowrules.ExplainImageAllowed(ctx, s.client, ns, imageName, digest, verify.Rules, opts...)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	for _, result := range results {
+		verify.Results = append(verify.Results, apiv1.ImageAllowRuleResult{
+			Rule:    result.Name,
+			Allowed: result.Allowed,
+			Reason:  result.Reason,
+		})
+		if result.Allowed && !verify.Verified {
+			verify.Verified = true
+			verify.Message = fmt.Sprintf("image is allowed by ImageAllowRule %s", result.Name)
+		}
+	}
+	if len(results) == 0 {
+		verify.Message = "no ImageAllowRules in this project"
+	} else if !verify.Verified {
+		verify.Message = (&imageallowrules.ErrImageNotAllowed{Image: imageName}).Error()
+	}
+
+	return verify, nil
+}
+
+// resolveDigest returns the digest of a local image or, if there is none, the digest of the image in its registry
+func (s *ImageVerifyStrategy) resolveDigest(ctx context.Context, namespace, imageName string, opts ...remote.Option) (string, error) {
+	image := &apiv1.Image{}
+	if err := s.client.Get(ctx, router.Key(namespace, imageName), image); err == nil {
+		return image.Digest, nil
+	} else if !apierrors.IsNotFound(err) {
+		return "", err
+	}
+	return images.ImageDigest(ctx, s.client, namespace, strings.ReplaceAll(imageName, "+", "/"), opts...)
+}
+
+func (s *ImageVerifyStrategy) New() types.Object {
+	return &apiv1.ImageVerify{}
+}
+
+// isInlineKey returns true if the key is a PEM encoded public key. Only inline keys are accepted, a path or URI would
+// make the server read a local file or a Kubernetes secret.
+func isInlineKey(key string) bool {
+	return strings.HasPrefix(strings.TrimSpace(key), "-----BEGIN PUBLIC KEY-----")
+}
@@ -4,16 +4,28 @@ import (
 	"github.com/acorn-io/mink/pkg/stores"
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
 	"github.com/acorn-io/runtime/pkg/tables"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiserver/pkg/registry/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewStorage(c kclient.WithWatch) rest.Storage {
-	remoteResource := translation.NewTranslationStrategy(&Translator{apiv1.LocalRegion},
-		remote.NewRemote(&corev1.Namespace{}, c))
+var auditedProject = audit.Resource{
+	Kind: "Project",
+	Spec: func(obj types.Object) any {
+		return obj.(*apiv1.Project).Spec
+	},
+	// The URL of a HTTP log sink can have credentials
+	Redacted: []string{"/logSink/http/url"},
+}
+
+func NewStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewTranslationStrategy(&Translator{apiv1.LocalRegion},
+		remote.NewRemote(&corev1.Namespace{}, c)), recorder, auditedProject)
 	strategy := &Strategy{
 		c:       c,
 		lister:  remoteResource,
@@ -2,11 +2,14 @@ package projects
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 
 	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -61,17 +64,28 @@ func (t *Translator) ToPublic(_ context.Context, obj ...runtime.Object) (result
 			calculatedSupportedRegions = []string{calculatedDefaultRegion}
 		}
 
+		var logSink *v1.LogSink
+		if data := ns.Annotations[labels.AcornProjectLogSink]; data != "" {
+			logSink = &v1.LogSink{}
+			if err := json.Unmarshal([]byte(data), logSink); err != nil {
+				logrus.Warnf("Ignoring invalid log sink of project %s: %v", ns.Name, err)
+				logSink = nil
+			}
+		}
+
 		delete(ns.Labels, labels.AcornProject)
 		delete(ns.Annotations, labels.AcornProjectDefaultRegion)
 		delete(ns.Annotations, labels.AcornProjectSupportedRegions)
 		delete(ns.Annotations, labels.AcornCalculatedProjectDefaultRegion)
 		delete(ns.Annotations, labels.AcornCalculatedProjectSupportedRegions)
+		delete(ns.Annotations, labels.AcornProjectLogSink)
 
 		result = append(result, &apiv1.Project{
 			ObjectMeta: ns.ObjectMeta,
 			Spec: apiv1.ProjectSpec{
 				DefaultRegion:    defaultRegion,
 				SupportedRegions: supportedRegions,
+				LogSink:          logSink,
 			},
 			Status: apiv1.ProjectStatus{
 				Namespace:        ns.Name,
@@ -102,6 +116,15 @@ func (t *Translator) FromPublic(_ context.Context, obj runtime.Object) (types.Ob
 	ns.Annotations[labels.AcornProjectSupportedRegions] = strings.Join(prj.Spec.SupportedRegions, ",")
 	ns.Annotations[labels.AcornCalculatedProjectDefaultRegion] = prj.Status.DefaultRegion
 	ns.Annotations[labels.AcornCalculatedProjectSupportedRegions] = strings.Join(prj.Status.SupportedRegions, ",")
+	if prj.Spec.LogSink != nil {
+		data, err := json.Marshal(prj.Spec.LogSink)
+		if err != nil {
+			return nil, err
+		}
+		ns.Annotations[labels.AcornProjectLogSink] = string(data)
+	} else {
+		delete(ns.Annotations, labels.AcornProjectLogSink)
+	}
 
 	return ns, nil
 }
@@ -32,6 +32,10 @@ func (v *Validator) Validate(_ context.Context, obj runtime.Object) field.ErrorL
 		return append(result, field.Invalid(field.NewPath("spec", "defaultRegion"), project.Spec.DefaultRegion, "default region is not in the supported regions list"))
 	}
 
+	if project.Spec.LogSink != nil && project.Spec.LogSink.File != nil {
+		return append(result, field.Invalid(field.NewPath("spec", "logSink", "file"), project.Spec.LogSink.File.Volume, "file log sinks can only be set on apps"))
+	}
+
 	return nil
 }
 
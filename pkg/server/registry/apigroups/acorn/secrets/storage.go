@@ -1,11 +1,17 @@
 package secrets
 
 import (
+	"strings"
+
 	"github.com/acorn-io/mink/pkg/stores"
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/publicname"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
 	"github.com/acorn-io/runtime/pkg/server/registry/middleware"
 	"github.com/acorn-io/runtime/pkg/tables"
 	corev1 "k8s.io/api/core/v1"
@@ -13,14 +19,30 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewStorage(c kclient.WithWatch, middlewares ...middleware.CompleteStrategy) rest.Storage {
+// auditedSecret is audited below the translation to the public type, because only the internal secret has the data
+// that is compared to find the changed keys.
+var auditedSecret = audit.Resource{
+	Kind: "Secret",
+	Spec: func(obj types.Object) any {
+		secret := obj.(*corev1.Secret)
+		return map[string]any{
+			"type": strings.TrimPrefix(string(secret.Type), v1.SecretTypePrefix),
+			"data": secret.Data,
+		}
+	},
+	Redacted: []string{"/data/*"},
+}
+
+func NewStorage(c kclient.WithWatch, recorder event.Recorder, middlewares ...middleware.CompleteStrategy) rest.Storage {
 	translated := translation.NewTranslationStrategy(&Translator{
 		c: c,
-	}, remote.NewRemote(&corev1.Secret{}, c))
+	}, audit.NewStrategy(remote.NewRemote(&corev1.Secret{}, c), recorder, auditedSecret))
 	remoteResource := publicname.NewStrategy(translated)
 	remoteResource = middleware.ForCompleteStrategy(remoteResource, middlewares...)
 
-	validator := &Validator{}
+	validator := &Validator{
+		client: c,
+	}
 
 	return stores.NewBuilder(c.Scheme(), &apiv1.Secret{}).
 		WithCreate(remoteResource).
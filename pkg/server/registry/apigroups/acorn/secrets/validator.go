@@ -2,15 +2,21 @@ package secrets
 
 import (
 	"context"
+	"fmt"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/secrets"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Validator struct {
+	client kclient.Client
 }
 
 func (v *Validator) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
@@ -24,5 +30,39 @@ func (v *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 }
 
 func (v *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	return v.Validate(ctx, obj)
+	result := v.Validate(ctx, obj)
+	sec, oldSec := obj.(*apiv1.Secret), old.(*apiv1.Secret)
+	if requested := sec.Annotations[labels.AcornSecretRotateRequested]; requested != "" && requested != oldSec.Annotations[labels.AcornSecretRotateRequested] {
+		if err := v.validateRotate(ctx, sec); err != nil {
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+// validateRotate rejects rotating a secret that is generated by a scheduled job, because the job produces a new value on
+// every run and there is nothing to rotate, and a token secret with a fixed value.
+func (v *Validator) validateRotate(ctx context.Context, sec *apiv1.Secret) *field.Error {
+	appName, secretName := sec.Labels[labels.AcornAppName], sec.Labels[labels.AcornSecretName]
+	if appName == "" || secretName == "" {
+		return nil
+	}
+
+	app := &v1.AppInstance{}
+	if err := v.client.Get(ctx, kclient.ObjectKey{Namespace: sec.Namespace, Name: appName}, app); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return field.InternalError(field.NewPath("metadata", "annotations"), err)
+	}
+
+	if app.Status.AppSpec.Secrets[secretName].HasFixedToken() {
+		return field.Forbidden(field.NewPath("metadata", "annotations").Key(labels.AcornSecretRotateRequested),
+			fmt.Sprintf("secret %s has a fixed token in the Acornfile and can not be rotated", sec.Name))
+	}
+
+	if jobName, ok := secrets.ScheduledJobFor(&app.Status.AppSpec, secretName); ok {
+		return field.Forbidden(field.NewPath("metadata", "annotations").Key(labels.AcornSecretRotateRequested),
+			fmt.Sprintf("secret %s is generated by job %s on schedule, it gets a new value on every run of the job and can not be rotated", sec.Name, jobName))
+	}
+	return nil
 }
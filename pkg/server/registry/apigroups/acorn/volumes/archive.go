@@ -0,0 +1,198 @@
+package volumes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"path"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/restconfig"
+	"github.com/acorn-io/baaah/pkg/watcher"
+	"github.com/acorn-io/mink/pkg/strategy"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/volume"
+	"github.com/rancher/wrangler/pkg/name"
+	"github.com/rancher/wrangler/pkg/randomtoken"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	registryrest "k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const archiveMount = "/data"
+
+// VolumeArchive streams the contents of a volume as a tar archive. A helper pod mounting the volume read-only is
+// started for each request and the archive is read by exec'ing tar in that pod.
+type VolumeArchive struct {
+	*strategy.DestroyAdapter
+	client     kclient.WithWatch
+	proxy      httputil.ReverseProxy
+	RESTClient rest.Interface
+	k8s        kubernetes.Interface
+}
+
+func NewVolumeArchive(client kclient.WithWatch, cfg *rest.Config) (*VolumeArchive, error) {
+	cfg = rest.CopyConfig(cfg)
+	restconfig.SetScheme(cfg, scheme.Scheme)
+
+	k8s, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := rest.TransportFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VolumeArchive{
+		k8s:    k8s,
+		client: client,
+		proxy: httputil.ReverseProxy{
+			FlushInterval: 200 * time.Millisecond,
+			Transport:     transport,
+			Director:      func(request *http.Request) {},
+		},
+		RESTClient: k8s.CoreV1().RESTClient(),
+	}, nil
+}
+
+func (v *VolumeArchive) New() runtime.Object {
+	return &apiv1.VolumeArchiveOptions{}
+}
+
+func (v *VolumeArchive) Connect(ctx context.Context, id string, options runtime.Object, r registryrest.Responder) (http.Handler, error) {
+	archiveOpts := options.(*apiv1.VolumeArchiveOptions)
+
+	ns, _ := request.NamespaceFrom(ctx)
+	vol := &apiv1.Volume{}
+	if err := v.client.Get(ctx, kclient.ObjectKey{Namespace: ns, Name: id}, vol); err != nil {
+		return nil, err
+	}
+
+	pv := &corev1.PersistentVolume{}
+	if err := v.client.Get(ctx, kclient.ObjectKey{Name: vol.Name}, pv); err != nil {
+		return nil, err
+	}
+	if pv.Spec.ClaimRef == nil || pv.Spec.ClaimRef.Name == "" {
+		return nil, fmt.Errorf("volume %s is not bound to an app and can not be archived", id)
+	}
+
+	pod, err := v.startHelper(ctx, pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := path.Join(archiveMount, path.Clean("/"+archiveOpts.Path))
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		defer v.deleteHelper(pod)
+
+		req := v.RESTClient.Get().
+			Namespace(pod.Namespace).
+			Resource("pods").
+			Name(pod.Name).
+			SubResource("exec").
+			VersionedParams(&corev1.PodExecOptions{
+				Stdout:    true,
+				Stderr:    true,
+				Container: "archive",
+				Command:   []string{"tar", "-C", dir, "-cf", "-", "."},
+			}, scheme.ParameterCodec)
+		request.URL = req.URL()
+		v.proxy.ServeHTTP(writer, request)
+	}), nil
+}
+
+func (v *VolumeArchive) NewConnectOptions() (runtime.Object, bool, string) {
+	return &apiv1.VolumeArchiveOptions{}, false, ""
+}
+
+func (v *VolumeArchive) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+func (v *VolumeArchive) startHelper(ctx context.Context, namespace, claimName string) (*corev1.Pod, error) {
+	nodeName, err := volume.NodeForClaim(ctx, v.client, namespace, claimName)
+	if err != nil {
+		return nil, err
+	}
+
+	unique, err := randomtoken.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := v.k8s.CoreV1().Pods(namespace).Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.SafeConcatName(claimName, "archive", unique[:8]),
+			Namespace: namespace,
+		},
+		Spec: corev1.PodSpec{
+			NodeName:                      nodeName,
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds:         &[]int64{3600}[0],
+			TerminationGracePeriodSeconds: &[]int64{0}[0],
+			Containers: []corev1.Container{
+				{
+					Name:    "archive",
+					Image:   system.DefaultImage(),
+					Command: []string{"sleep", "3600"},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      "data",
+							MountPath: archiveMount,
+							ReadOnly:  true,
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: claimName,
+							ReadOnly:  true,
+						},
+					},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	pod, err := watcher.New[*corev1.Pod](v.client).ByObject(waitCtx, created, func(pod *corev1.Pod) (bool, error) {
+		switch pod.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodFailed, corev1.PodSucceeded:
+			return false, fmt.Errorf("volume archive pod %s/%s exited: %s", pod.Namespace, pod.Name, pod.Status.Message)
+		}
+		return false, nil
+	})
+	if err != nil {
+		v.deleteHelper(created)
+		return nil, err
+	}
+	return pod, nil
+}
+
+func (v *VolumeArchive) deleteHelper(pod *corev1.Pod) {
+	if err := v.k8s.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); err != nil {
+		logrus.Errorf("failed to delete volume archive pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+}
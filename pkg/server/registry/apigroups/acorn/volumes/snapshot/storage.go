@@ -0,0 +1,37 @@
+package snapshot
+
+import (
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/strategy/remote"
+	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var auditedVolumeSnapshot = audit.Resource{
+	Kind: "VolumeSnapshot",
+	Spec: func(obj types.Object) any {
+		return obj.(*apiv1.VolumeSnapshot).Spec
+	},
+}
+
+func NewStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&Translator{},
+		remote.NewRemote(&v1.VolumeSnapshotInstance{}, c)), recorder, auditedVolumeSnapshot)
+
+	return stores.NewBuilder(c.Scheme(), &apiv1.VolumeSnapshot{}).
+		WithValidateCreate(&Validator{client: c}).
+		WithCreate(remoteResource).
+		WithGet(remoteResource).
+		WithList(remoteResource).
+		WithDelete(remoteResource).
+		WithWatch(remoteResource).
+		WithTableConverter(tables.VolumeSnapshotConverter).
+		Build()
+}
@@ -0,0 +1,31 @@
+package snapshot
+
+import (
+	"context"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/volume"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Validator struct {
+	client kclient.Client
+}
+
+func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
+	snapshot := obj.(*apiv1.VolumeSnapshot)
+	if snapshot.Spec.VolumeName == "" {
+		return append(result, field.Required(field.NewPath("spec", "volumeName"), "volume to snapshot must be specified"))
+	}
+
+	pv, err := volume.FindPersistentVolume(ctx, s.client, snapshot.Namespace, snapshot.Spec.VolumeName)
+	if err != nil {
+		return append(result, field.Invalid(field.NewPath("spec", "volumeName"), snapshot.Spec.VolumeName, err.Error()))
+	}
+	if pv.Spec.ClaimRef == nil || pv.Spec.ClaimRef.Name == "" {
+		return append(result, field.Invalid(field.NewPath("spec", "volumeName"), snapshot.Spec.VolumeName, "volume is not bound to an app"))
+	}
+	return
+}
@@ -4,17 +4,27 @@ import (
 	"github.com/acorn-io/mink/pkg/stores"
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
 	"github.com/acorn-io/runtime/pkg/tables"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiserver/pkg/registry/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewStorage(c kclient.WithWatch) rest.Storage {
-	translated := translation.NewTranslationStrategy(&Translator{
+var auditedVolume = audit.Resource{
+	Kind: "Volume",
+	Spec: func(obj types.Object) any {
+		return obj.(*apiv1.Volume).Spec
+	},
+}
+
+func NewStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	translated := audit.NewStrategy(translation.NewTranslationStrategy(&Translator{
 		c: c,
-	}, remote.NewRemote(&corev1.PersistentVolume{}, c))
+	}, remote.NewRemote(&corev1.PersistentVolume{}, c)), recorder, auditedVolume)
 
 	return stores.NewBuilder(c.Scheme(), &apiv1.Volume{}).
 		WithGet(translated).
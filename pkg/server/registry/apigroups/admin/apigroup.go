@@ -4,7 +4,9 @@ import (
 	"github.com/acorn-io/mink/pkg/serializer"
 	adminapi "github.com/acorn-io/runtime/pkg/apis/admin.acorn.io"
 	v1 "github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/admin/apppolicyrule"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/admin/computeclass"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/admin/volumeclass"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,11 +18,15 @@ import (
 )
 
 func Stores(c kclient.WithWatch) (map[string]rest.Storage, error) {
+	recorder := event.NewRecorder(c)
+
 	return map[string]rest.Storage{
-		"clustercomputeclasses": computeclass.NewClusterStorage(c),
-		"projectcomputeclasses": computeclass.NewProjectStorage(c),
-		"clustervolumeclasses":  volumeclass.NewClusterStorage(c),
-		"projectvolumeclasses":  volumeclass.NewProjectStorage(c),
+		"clustercomputeclasses": computeclass.NewClusterStorage(c, recorder),
+		"projectcomputeclasses": computeclass.NewProjectStorage(c, recorder),
+		"clustervolumeclasses":  volumeclass.NewClusterStorage(c, recorder),
+		"projectvolumeclasses":  volumeclass.NewProjectStorage(c, recorder),
+		"clusterapppolicyrules": apppolicyrule.NewClusterStorage(c, recorder),
+		"projectapppolicyrules": apppolicyrule.NewProjectStorage(c, recorder),
 	}, nil
 }
 
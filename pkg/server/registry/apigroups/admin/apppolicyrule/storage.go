@@ -0,0 +1,53 @@
+package apppolicyrule
+
+import (
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/strategy/remote"
+	"github.com/acorn-io/mink/pkg/strategy/translation"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1"
+	internaladminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	auditedClusterAppPolicyRule = audit.Resource{
+		Kind:      "ClusterAppPolicyRule",
+		Spec:      audit.Fields,
+		Namespace: system.Namespace,
+	}
+	auditedProjectAppPolicyRule = audit.Resource{
+		Kind: "ProjectAppPolicyRule",
+		Spec: audit.Fields,
+	}
+)
+
+func NewClusterStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&ClusterTranslator{},
+		remote.NewRemote(&internaladminv1.ClusterAppPolicyRuleInstance{}, c)), recorder, auditedClusterAppPolicyRule)
+	validator := &ClusterValidator{}
+
+	return stores.NewBuilder(c.Scheme(), &adminv1.ClusterAppPolicyRule{}).
+		WithCompleteCRUD(remoteResource).
+		WithValidateUpdate(validator).
+		WithValidateCreate(validator).
+		WithTableConverter(tables.AppPolicyRuleConverter).
+		Build()
+}
+
+func NewProjectStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&ProjectTranslator{},
+		remote.NewRemote(&internaladminv1.ProjectAppPolicyRuleInstance{}, c)), recorder, auditedProjectAppPolicyRule)
+	validator := &ProjectValidator{}
+
+	return stores.NewBuilder(c.Scheme(), &adminv1.ProjectAppPolicyRule{}).
+		WithCompleteCRUD(remoteResource).
+		WithValidateUpdate(validator).
+		WithValidateCreate(validator).
+		WithTableConverter(tables.AppPolicyRuleConverter).
+		Build()
+}
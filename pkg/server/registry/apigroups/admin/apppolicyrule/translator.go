@@ -0,0 +1,25 @@
+package apppolicyrule
+
+import (
+	mtypes "github.com/acorn-io/mink/pkg/types"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1"
+	admininternalv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+)
+
+type ClusterTranslator struct{}
+
+func (s *ClusterTranslator) FromPublic(obj mtypes.Object) mtypes.Object {
+	return (*admininternalv1.ClusterAppPolicyRuleInstance)(obj.(*adminv1.ClusterAppPolicyRule))
+}
+func (s *ClusterTranslator) ToPublic(obj mtypes.Object) mtypes.Object {
+	return (*adminv1.ClusterAppPolicyRule)(obj.(*admininternalv1.ClusterAppPolicyRuleInstance))
+}
+
+type ProjectTranslator struct{}
+
+func (s *ProjectTranslator) FromPublic(obj mtypes.Object) mtypes.Object {
+	return (*admininternalv1.ProjectAppPolicyRuleInstance)(obj.(*adminv1.ProjectAppPolicyRule))
+}
+func (s *ProjectTranslator) ToPublic(obj mtypes.Object) mtypes.Object {
+	return (*adminv1.ProjectAppPolicyRule)(obj.(*admininternalv1.ProjectAppPolicyRuleInstance))
+}
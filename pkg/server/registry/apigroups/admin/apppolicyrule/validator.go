@@ -0,0 +1,31 @@
+package apppolicyrule
+
+import (
+	"context"
+
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1"
+	admininternalv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/apppolicy"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type ProjectValidator struct{}
+
+func (s *ProjectValidator) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	return apppolicy.Validate((*admininternalv1.ProjectAppPolicyRuleInstance)(obj.(*adminv1.ProjectAppPolicyRule)))
+}
+
+func (s *ProjectValidator) ValidateUpdate(ctx context.Context, newObj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, newObj)
+}
+
+type ClusterValidator struct{}
+
+func (s *ClusterValidator) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	return apppolicy.Validate((*admininternalv1.ProjectAppPolicyRuleInstance)(obj.(*adminv1.ClusterAppPolicyRule)))
+}
+
+func (s *ClusterValidator) ValidateUpdate(ctx context.Context, newObj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, newObj)
+}
@@ -7,14 +7,29 @@ import (
 	adminv1 "github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	internaladminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
+	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/acorn-io/runtime/pkg/tables"
 	"k8s.io/apiserver/pkg/registry/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewClusterStorage(c kclient.WithWatch) rest.Storage {
-	remoteResource := translation.NewSimpleTranslationStrategy(&ClusterTranslator{},
-		remote.NewRemote(&internaladminv1.ClusterComputeClassInstance{}, c))
+var (
+	auditedClusterComputeClass = audit.Resource{
+		Kind:      "ClusterComputeClass",
+		Spec:      audit.Fields,
+		Namespace: system.Namespace,
+	}
+	auditedProjectComputeClass = audit.Resource{
+		Kind: "ProjectComputeClass",
+		Spec: audit.Fields,
+	}
+)
+
+func NewClusterStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&ClusterTranslator{},
+		remote.NewRemote(&internaladminv1.ClusterComputeClassInstance{}, c)), recorder, auditedClusterComputeClass)
 	validator := NewClusterValidator(c)
 
 	return stores.NewBuilder(c.Scheme(), &adminv1.ClusterComputeClass{}).
@@ -25,9 +40,9 @@ func NewClusterStorage(c kclient.WithWatch) rest.Storage {
 		Build()
 }
 
-func NewProjectStorage(c kclient.WithWatch) rest.Storage {
-	remoteResource := translation.NewSimpleTranslationStrategy(&ProjectTranslator{},
-		remote.NewRemote(&internaladminv1.ProjectComputeClassInstance{}, c))
+func NewProjectStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&ProjectTranslator{},
+		remote.NewRemote(&internaladminv1.ProjectComputeClassInstance{}, c)), recorder, auditedProjectComputeClass)
 	validator := NewProjectValidator(c)
 
 	return stores.NewBuilder(c.Scheme(), &adminv1.ProjectComputeClass{}).
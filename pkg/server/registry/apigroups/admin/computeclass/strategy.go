@@ -56,6 +56,7 @@ func (s *Strategy) List(ctx context.Context, namespace string, options storage.L
 		computeClasses.Items = append(computeClasses.Items, apiv1.ComputeClass{
 			ObjectMeta:       v1.ObjectMeta{Name: pcc.Name, Namespace: pcc.Namespace, CreationTimestamp: pcc.CreationTimestamp},
 			Memory:           pcc.Memory,
+			CPU:              pcc.CPU,
 			Default:          pcc.Default,
 			Description:      pcc.Description,
 			SupportedRegions: pcc.SupportedRegions,
@@ -74,6 +75,7 @@ func (s *Strategy) List(ctx context.Context, namespace string, options storage.L
 		computeClasses.Items = append(computeClasses.Items, apiv1.ComputeClass{
 			ObjectMeta:       v1.ObjectMeta{Name: ccc.Name},
 			Memory:           ccc.Memory,
+			CPU:              ccc.CPU,
 			Default:          ccc.Default,
 			Description:      ccc.Description,
 			SupportedRegions: ccc.SupportedRegions,
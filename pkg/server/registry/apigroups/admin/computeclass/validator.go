@@ -43,7 +43,12 @@ func (s *ProjectValidator) Validate(ctx context.Context, obj runtime.Object) (re
 		return append(result, field.Invalid(field.NewPath("spec", "memory"), cc.Memory, err.Error()))
 	}
 
-	return append(result, validateMemorySpec(cc.Memory)...)
+	if _, err := computeclasses.ParseComputeClassCPU(cc.CPU); err != nil {
+		return append(result, field.Invalid(field.NewPath("spec", "cpu"), cc.CPU, err.Error()))
+	}
+
+	result = append(result, validateMemorySpec(cc.Memory)...)
+	return append(result, validateCPUSpec(cc.CPU)...)
 }
 
 func (s *ProjectValidator) ValidateUpdate(ctx context.Context, newObj, oldObj runtime.Object) field.ErrorList {
@@ -80,7 +85,12 @@ func (s *ClusterValidator) Validate(ctx context.Context, obj runtime.Object) (re
 		return append(result, field.Invalid(field.NewPath("spec.memory"), cc.Memory, err.Error()))
 	}
 
-	return append(result, validateMemorySpec(cc.Memory)...)
+	if _, err := computeclasses.ParseComputeClassCPU(cc.CPU); err != nil {
+		return append(result, field.Invalid(field.NewPath("spec.cpu"), cc.CPU, err.Error()))
+	}
+
+	result = append(result, validateMemorySpec(cc.Memory)...)
+	return append(result, validateCPUSpec(cc.CPU)...)
 }
 
 func validateMemorySpec(memory admininternalv1.ComputeClassMemory) field.ErrorList {
@@ -129,6 +139,46 @@ func validateMemorySpec(memory admininternalv1.ComputeClassMemory) field.ErrorLi
 	return errors
 }
 
+func validateCPUSpec(cpu admininternalv1.ComputeClassCPU) field.ErrorList {
+	errors := field.ErrorList{}
+	if len(cpu.Values) != 0 {
+		if cpu.Max != "" {
+			errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "max"), cpu.Max, "cannot set maximum cpu with values specified"))
+		}
+		if cpu.Min != "" {
+			errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "min"), cpu.Min, "cannot set minimum cpu with values specified"))
+		}
+	}
+
+	min, max, def := v1.Quantity(cpu.Min), v1.Quantity(cpu.Max), v1.Quantity(cpu.Default)
+	// Ensure the min, max, and default make sense.
+	if compareQuantities(min, max) > 0 && (min != "0" || max != "0") {
+		errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "min"), min, "minimum cpu should be at most the maximum cpu"))
+	}
+	if compareQuantities(min, def) > 0 {
+		errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "default"), def, "default cpu should be at least the minimum cpu"))
+	}
+	if compareQuantities(def, max) > 0 && max != "0" {
+		errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "default"), def, "default cpu should be at most the maximum cpu"))
+	}
+
+	if len(cpu.Values) == 0 || def == "" {
+		return errors
+	}
+
+	for _, value := range cpu.Values {
+		if compareQuantities(v1.Quantity(value), def) == 0 {
+			return errors
+		}
+	}
+
+	return append(errors,
+		field.Invalid(
+			field.NewPath("spec", "cpu", "default"), def,
+			fmt.Sprintf("default cpu is not included in values. current values: %v", cpu.Values)),
+	)
+}
+
 func (s *ClusterValidator) ValidateUpdate(ctx context.Context, newObj, _ runtime.Object) field.ErrorList {
 	return s.Validate(ctx, newObj)
 }
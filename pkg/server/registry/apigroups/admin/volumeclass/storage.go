@@ -6,14 +6,29 @@ import (
 	"github.com/acorn-io/mink/pkg/strategy/translation"
 	adminv1 "github.com/acorn-io/runtime/pkg/apis/admin.acorn.io/v1"
 	internaladminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/server/registry/audit"
+	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/acorn-io/runtime/pkg/tables"
 	"k8s.io/apiserver/pkg/registry/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewClusterStorage(c kclient.WithWatch) rest.Storage {
-	remoteResource := translation.NewSimpleTranslationStrategy(&ClusterTranslator{},
-		remote.NewRemote(&internaladminv1.ClusterVolumeClassInstance{}, c))
+var (
+	auditedClusterVolumeClass = audit.Resource{
+		Kind:      "ClusterVolumeClass",
+		Spec:      audit.Fields,
+		Namespace: system.Namespace,
+	}
+	auditedProjectVolumeClass = audit.Resource{
+		Kind: "ProjectVolumeClass",
+		Spec: audit.Fields,
+	}
+)
+
+func NewClusterStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&ClusterTranslator{},
+		remote.NewRemote(&internaladminv1.ClusterVolumeClassInstance{}, c)), recorder, auditedClusterVolumeClass)
 	validator := NewClusterValidator(c)
 
 	return stores.NewBuilder(c.Scheme(), &adminv1.ClusterVolumeClass{}).
@@ -24,9 +39,9 @@ func NewClusterStorage(c kclient.WithWatch) rest.Storage {
 		Build()
 }
 
-func NewProjectStorage(c kclient.WithWatch) rest.Storage {
-	remoteResource := translation.NewSimpleTranslationStrategy(&ProjectTranslator{},
-		remote.NewRemote(&internaladminv1.ProjectVolumeClassInstance{}, c))
+func NewProjectStorage(c kclient.WithWatch, recorder event.Recorder) rest.Storage {
+	remoteResource := audit.NewStrategy(translation.NewSimpleTranslationStrategy(&ProjectTranslator{},
+		remote.NewRemote(&internaladminv1.ProjectVolumeClassInstance{}, c)), recorder, auditedProjectVolumeClass)
 	validator := NewProjectValidator(c)
 
 	return stores.NewBuilder(c.Scheme(), &adminv1.ProjectVolumeClass{}).
@@ -0,0 +1,125 @@
+package audit
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/wI2L/jsondiff"
+)
+
+// Redacted replaces the values of redacted fields
+const Redacted = "[redacted]"
+
+// Patch returns a JSON Patch of the changes from one document to another. The values of the fields at the redacted
+// paths are replaced with Redacted, the paths of the changed fields are kept. Nil is returned if nothing changed.
+func Patch(from, to any, redacted []string) (json.RawMessage, error) {
+	patch, err := jsondiff.Compare(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(patch) < 1 {
+		// Marshaling an empty patch yields "null", which is harder to reason about.
+		// Return nil instead.
+		return nil, nil
+	}
+
+	for i, op := range patch {
+		if op.Value != nil {
+			patch[i].Value = redact(split(string(op.Path)), op.Value, redacted)
+		}
+	}
+
+	return json.Marshal(patch)
+}
+
+// Redact returns the document as generic JSON with the values of the fields at the redacted paths replaced with
+// Redacted.
+func Redact(doc any, redacted []string) (any, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+
+	return redact(nil, generic, redacted), nil
+}
+
+// Paths returns the paths of the fields changed by a JSON Patch
+func Paths(patch json.RawMessage) []string {
+	var ops []struct {
+		Path string `json:"path"`
+	}
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil
+	}
+
+	paths := make([]string, 0, len(ops))
+	for _, op := range ops {
+		paths = append(paths, op.Path)
+	}
+	return paths
+}
+
+func redact(path []string, value any, redacted []string) any {
+	if isRedacted(path, redacted) {
+		return Redacted
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, child := range v {
+			result[key] = redact(append(path[:len(path):len(path)], key), child, redacted)
+		}
+		return result
+	case []any:
+		result := make([]any, 0, len(v))
+		for i, child := range v {
+			result = append(result, redact(append(path[:len(path):len(path)], strconv.Itoa(i)), child, redacted))
+		}
+		return result
+	}
+	return value
+}
+
+// isRedacted returns true if a redacted path is the path or one of its parents. A "*" in a redacted path matches any
+// key or index.
+func isRedacted(path []string, redacted []string) bool {
+	for _, r := range redacted {
+		pattern := split(r)
+		if len(pattern) == 0 || len(pattern) > len(path) {
+			continue
+		}
+
+		matched := true
+		for i, segment := range pattern {
+			if segment != "*" && segment != path[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// split returns the unescaped segments of a JSON pointer
+func split(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+	}
+	return segments
+}
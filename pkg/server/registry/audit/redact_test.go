@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatch(t *testing.T) {
+	redacted := []string{"/data/*", "/env/*/value"}
+
+	for _, tt := range []struct {
+		name     string
+		from, to any
+		expected string
+	}{
+		{
+			name:     "unchanged",
+			from:     map[string]any{"type": "basic"},
+			to:       map[string]any{"type": "basic"},
+			expected: "",
+		},
+		{
+			name:     "changed value of a redacted field",
+			from:     map[string]any{"data": map[string]any{"password": "a"}},
+			to:       map[string]any{"data": map[string]any{"password": "b"}},
+			expected: `[{"op":"replace","path":"/data/password","value":"[redacted]"}]`,
+		},
+		{
+			name:     "removed redacted field",
+			from:     map[string]any{"data": map[string]any{"password": "a", "username": "b"}},
+			to:       map[string]any{"data": map[string]any{"username": "b"}},
+			expected: `[{"op":"remove","path":"/data/password"}]`,
+		},
+		{
+			name:     "added parent of redacted fields",
+			from:     map[string]any{},
+			to:       map[string]any{"type": "basic", "data": map[string]any{"password": "a"}},
+			expected: `[{"op":"add","path":"/data","value":{"password":"[redacted]"}},{"op":"add","path":"/type","value":"basic"}]`,
+		},
+		{
+			name:     "wildcard index",
+			from:     map[string]any{"env": []any{map[string]any{"name": "a", "value": "1"}}},
+			to:       map[string]any{"env": []any{map[string]any{"name": "a", "value": "1"}, map[string]any{"name": "b", "value": "2"}}},
+			expected: `[{"op":"add","path":"/env/-","value":{"name":"b","value":"[redacted]"}}]`,
+		},
+		{
+			name:     "not redacted",
+			from:     map[string]any{"publish": []any{"80"}},
+			to:       map[string]any{"publish": []any{"443"}},
+			expected: `[{"op":"replace","path":"/publish/0","value":"443"}]`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := Patch(tt.from, tt.to, redacted)
+			require.NoError(t, err)
+			if tt.expected == "" {
+				assert.Nil(t, patch)
+				return
+			}
+			assert.JSONEq(t, tt.expected, string(patch))
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	redacted, err := Redact(map[string]any{
+		"deployArgs": map[string]any{"password": "secret", "replicas": 2},
+		"image":      "nginx",
+	}, []string{"/deployArgs/*"})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(redacted)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"deployArgs":{"password":"[redacted]","replicas":"[redacted]"},"image":"nginx"}`, string(data))
+}
+
+func TestPaths(t *testing.T) {
+	assert.Equal(t, []string{"/data/password", "/type"}, Paths(json.RawMessage(`[{"op":"replace","path":"/data/password","value":"[redacted]"},{"op":"remove","path":"/type"}]`)))
+	assert.Empty(t, Paths(nil))
+}
@@ -0,0 +1,237 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/acorn-io/mink/pkg/strategy"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/publicname"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// Resource describes how the writes to a kind of object are audited.
+type Resource struct {
+	// Kind is the public kind of the objects, like "Secret". Events are recorded with the types KindCreate,
+	// KindUpdate and KindDelete and with the lowercase kind as source kind.
+	Kind string
+
+	// Spec returns the part of an object that is compared to find the changed fields.
+	Spec func(types.Object) any
+
+	// Redacted are the paths of the fields in the spec whose values are never recorded, as JSON pointers.
+	// A "*" matches any key or index, like "/data/*".
+	Redacted []string
+
+	// Namespace is the namespace the events of cluster scoped objects are recorded in. If it is empty they are
+	// recorded in the namespace of the same name as the object, like for projects.
+	Namespace string
+}
+
+// Fields returns the fields of an object without its type and object meta, for objects that have no spec field.
+func Fields(obj types.Object) any {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+	delete(fields, "apiVersion")
+	delete(fields, "kind")
+	delete(fields, "metadata")
+	return fields
+}
+
+// EventDetails captures additional info about an audited write.
+type EventDetails struct {
+	// Operation is create, update or delete.
+	Operation string `json:"operation"`
+
+	// ResourceVersion is the resourceVersion of the object after the write.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Patch is a JSON Patch that describes the changes made to the spec, with the values of sensitive fields redacted.
+	// It is empty for deletes.
+	// See: https://datatracker.ietf.org/doc/html/rfc6902
+	// +optional
+	Patch json.RawMessage `json:"patch,omitempty"`
+}
+
+// NewStrategy returns a strategy that records an event with the user, the operation and the changed fields for
+// every create, update and delete.
+func NewStrategy(s strategy.CompleteStrategy, recorder event.Recorder, resource Resource) strategy.CompleteStrategy {
+	return &auditStrategy{
+		CompleteStrategy: s,
+		recorder:         recorder,
+		resource:         resource,
+	}
+}
+
+// NewCreateStrategy returns a strategy that records an event with the user and the fields of the object for every
+// create, for resources that can only be created, like subresources that perform an action.
+func NewCreateStrategy(s strategy.Creater, recorder event.Recorder, resource Resource) strategy.Creater {
+	return &auditCreateStrategy{
+		Creater:  s,
+		recorder: recorder,
+		resource: resource,
+	}
+}
+
+type auditCreateStrategy struct {
+	strategy.Creater
+	recorder event.Recorder
+	resource Resource
+}
+
+func (s *auditCreateStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	created, err := s.Creater.Create(ctx, obj)
+	if err != nil {
+		// Return created because Creater.Create is a black box; i.e. we can't assume
+		// created is nil when err is non-nil.
+		return created, err
+	}
+
+	patch, err := Patch(map[string]any{}, s.resource.Spec(created), s.resource.Redacted)
+	if err != nil {
+		logrus.Warnf("Failed to generate %s patch, event recording disabled for request: %s", s.resource.Kind, err)
+		return created, nil
+	}
+
+	if err := s.recorder.Record(ctx, newEvent(s.resource, created, OperationCreate, patch)); err != nil {
+		logrus.Warnf("Failed to record event: %s", err)
+	}
+	return created, nil
+}
+
+type auditStrategy struct {
+	strategy.CompleteStrategy
+	recorder event.Recorder
+	resource Resource
+}
+
+func (s *auditStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	created, err := s.CompleteStrategy.Create(ctx, obj)
+	if err != nil {
+		// Return created because CompleteStrategy.Create is a black box; i.e. we can't assume
+		// created is nil when err is non-nil.
+		return created, err
+	}
+
+	patch, err := Patch(map[string]any{}, s.resource.Spec(created), s.resource.Redacted)
+	if err != nil {
+		logrus.Warnf("Failed to generate %s patch, event recording disabled for request: %s", s.resource.Kind, err)
+		return created, nil
+	}
+
+	s.record(ctx, created, OperationCreate, patch)
+	return created, nil
+}
+
+func (s *auditStrategy) Update(ctx context.Context, obj types.Object) (types.Object, error) {
+	old, err := s.Get(ctx, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		logrus.Warnf("Failed to get old object, event recording disabled for request: %s", err)
+		return s.CompleteStrategy.Update(ctx, obj)
+	}
+
+	updated, err := s.CompleteStrategy.Update(ctx, obj)
+	if err != nil {
+		// Return updated because CompleteStrategy.Update is a black box; i.e. we can't assume
+		// updated is nil when err is non-nil.
+		return updated, err
+	}
+
+	patch, err := Patch(s.resource.Spec(old), s.resource.Spec(updated), s.resource.Redacted)
+	if err != nil {
+		logrus.Warnf("Failed to generate %s patch, event recording disabled for request: %s", s.resource.Kind, err)
+		return updated, nil
+	}
+
+	if len(patch) < 1 {
+		// Update did not change the spec, don't record an event
+		return updated, nil
+	}
+
+	s.record(ctx, updated, OperationUpdate, patch)
+	return updated, nil
+}
+
+func (s *auditStrategy) Delete(ctx context.Context, obj types.Object) (types.Object, error) {
+	deleted, err := s.CompleteStrategy.Delete(ctx, obj)
+	if err != nil {
+		// Return deleted because CompleteStrategy.Delete is a black box; i.e. we can't assume
+		// deleted is nil when err is non-nil.
+		return deleted, err
+	}
+
+	s.record(ctx, deleted, OperationDelete, nil)
+	return deleted, nil
+}
+
+func (s *auditStrategy) record(ctx context.Context, obj types.Object, operation string, patch json.RawMessage) {
+	if err := s.recorder.Record(ctx, newEvent(s.resource, obj, operation, patch)); err != nil {
+		logrus.Warnf("Failed to record event: %s", err)
+	}
+}
+
+func newEvent(resource Resource, obj types.Object, operation string, patch json.RawMessage) *apiv1.Event {
+	var (
+		kind      = resource.Kind
+		name      = publicname.Get(obj)
+		namespace = obj.GetNamespace()
+	)
+	if namespace == "" {
+		// Events are namespaced, so the events of cluster scoped objects are recorded in the namespace of the
+		// resource, or for projects in the namespace of the same name.
+		namespace = resource.Namespace
+	}
+	if namespace == "" {
+		namespace = obj.GetName()
+	}
+
+	description := fmt.Sprintf("%s %s/%s %sd", kind, namespace, name, operation)
+	if paths := Paths(patch); operation == OperationUpdate && len(paths) > 0 {
+		description += ": " + strings.Join(paths, ", ")
+	}
+
+	details, err := v1.Mapify(EventDetails{
+		Operation:       operation,
+		ResourceVersion: obj.GetResourceVersion(),
+		Patch:           patch,
+	})
+	if err != nil {
+		// Still record who did what, the details are only additional information
+		logrus.Warnf("Failed to generate event details: %s", err)
+	}
+
+	return &apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+		},
+		Type:        kind + strings.ToUpper(operation[:1]) + operation[1:],
+		Severity:    v1.EventSeverityInfo,
+		Details:     details,
+		Description: description,
+		Source: v1.EventSource{
+			Kind: strings.ToLower(kind),
+			Name: name,
+			UID:  obj.GetUID(),
+		},
+		Observed: v1.MicroTime(metav1.NowMicro()),
+	}
+}
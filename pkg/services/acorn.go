@@ -143,6 +143,11 @@ func forRouters(appInstance *v1.AppInstance) (result []kclient.Object, err error
 			if router.TargetServiceName != "" && !serviceNames.Has(router.TargetServiceName) {
 				return nil, fmt.Errorf("router [%s] references unknown service [%s]", routerName, router.TargetServiceName)
 			}
+			for _, target := range router.Targets {
+				if target.TargetServiceName != "" && !serviceNames.Has(target.TargetServiceName) {
+					return nil, fmt.Errorf("router [%s] references unknown service [%s]", routerName, target.TargetServiceName)
+				}
+			}
 		}
 
 		result = append(result, &v1.ServiceInstance{
@@ -236,7 +241,7 @@ func forContainers(appInstance *v1.AppInstance) (result []kclient.Object) {
 			continue
 		}
 
-		result = append(result, &v1.ServiceInstance{
+		service := &v1.ServiceInstance{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      containerName,
 				Namespace: appInstance.Status.Namespace,
@@ -260,7 +265,13 @@ func forContainers(appInstance *v1.AppInstance) (result []kclient.Object) {
 				Ports:     ports,
 				Container: containerName,
 			},
-		})
+		}
+		if appInstance.Status.RolloutProxied(containerName) {
+			// During a canary rollout the rollout proxy splits the traffic between the stable and the canary revision
+			service.Spec.Container = ""
+			service.Spec.ContainerLabels = labels.Managed(appInstance, labels.AcornRolloutProxy, containerName)
+		}
+		result = append(result, service)
 	}
 
 	return
@@ -15,6 +15,10 @@ const (
 	CustomCABundleCertName = "ca-certificates.crt"
 
 	AcornPriorityClass = "system-cluster-critical"
+
+	// BuildCacheDir is the directory of the builder that stores the local build caches, it is only mounted if the
+	// builder has a cache volume
+	BuildCacheDir = "/var/lib/acorn/build-cache"
 )
 
 var (
@@ -12,12 +12,24 @@ var (
 		{"Image", "{{ trunc .Status.AppImage.Name }}"},
 		{"Healthy", "Status.Columns.Healthy"},
 		{"Up-To-Date", "Status.Columns.UpToDate"},
+		{"Desired", "Status.Columns.Desired"},
+		{"Current", "Status.Columns.Current"},
 		{"Created", "{{ago .CreationTimestamp}}"},
 		{"Endpoints", "Status.Columns.Endpoints"},
 		{"Message", "{{ appGeneration . .Status.Columns.Message }}"},
 	}
 	AppConverter = MustConverter(App)
 
+	AppRevision = [][]string{
+		{"Revision", "Revision"},
+		{"Image", "{{ trunc .Image }}"},
+		{"Image-ID", "{{ trunc .ImageID }}"},
+		{"Profiles", "{{ arrayNoSpace .Profiles }}"},
+		{"Trigger", "Trigger"},
+		{"Updated-By", "UpdatedBy"},
+		{"Created", "{{ago .Created}}"},
+	}
+
 	Volume = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"App-Name", "Status.AppPublicName"},
@@ -30,6 +42,17 @@ var (
 	}
 	VolumeConverter = MustConverter(Volume)
 
+	VolumeSnapshot = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Volume", "Spec.VolumeName"},
+		{"App-Name", "Status.AppName"},
+		{"Method", "Status.Method"},
+		{"Ready", "{{ boolToStar .Status.ReadyToUse }}"},
+		{"Size", "Status.RestoreSize"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	VolumeSnapshotConverter = MustConverter(VolumeSnapshot)
+
 	VolumeClass = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"Default", "{{ boolToStar .Default }}"},
@@ -43,6 +66,15 @@ var (
 	}
 	VolumeClassConverter = MustConverter(VolumeClass)
 
+	AppPolicyRule = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Mode", "{{ if .Mode }}{{ .Mode }}{{ else }}enforce{{ end }}"},
+		{"Rules", "{{ len .Rules }}"},
+		{"Description", "{{ .Description }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	AppPolicyRuleConverter = MustConverter(AppPolicyRule)
+
 	Service = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"Created", "{{ago .CreationTimestamp}}"},
@@ -83,6 +115,17 @@ var (
 	}
 	ContainerConverter = MustConverter(Container)
 
+	JobRun = [][]string{
+		{"Name", "Name"},
+		{"App", "App"},
+		{"Job", "Job"},
+		{"State", "State"},
+		{"Manual", "{{ boolToStar .Manual }}"},
+		{"Started", "{{ if .StartTime }}{{ ago .StartTime }}{{ end }}"},
+		{"Duration", "Duration"},
+		{"Message", "Message"},
+	}
+
 	CredentialClient = [][]string{
 		{"Server", "ServerAddress"},
 		{"Username", "Username"},
@@ -173,8 +216,17 @@ var (
 		{"Type", "Type"},
 		{"Actor", "Actor"},
 		{"Observed", "Observed"},
+		{"Count", "{{ if .Count }}{{ .Count }}{{ end }}"},
 		{"Description", "Description"},
 	}
 
 	EventConverter = MustConverter(Event)
+
+	EventSubscription = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"URL", "Spec.Webhook.URL"},
+		{"Last-Error", "Status.LastError"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	EventSubscriptionConverter = MustConverter(EventSubscription)
 )
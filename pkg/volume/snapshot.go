@@ -0,0 +1,106 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/acorn-io/runtime/pkg/labels"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	VolumeSnapshotGVK      = schema.GroupVersionKind{Group: "snapshot.storage.k8s.io", Version: "v1", Kind: "VolumeSnapshot"}
+	VolumeSnapshotClassGVK = schema.GroupVersionKind{Group: "snapshot.storage.k8s.io", Version: "v1", Kind: "VolumeSnapshotClass"}
+)
+
+const defaultSnapshotClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+
+// FindPersistentVolume looks up the Acorn-managed PersistentVolume for the given volume name in a project. The name can be
+// either the name of the PersistentVolume or the public name of the volume in the form <app>.<volume>.
+func FindPersistentVolume(ctx context.Context, c client.Client, namespace, name string) (*corev1.PersistentVolume, error) {
+	pv := new(corev1.PersistentVolume)
+	if err := c.Get(ctx, client.ObjectKey{Name: name}, pv); err == nil {
+		if pv.Labels[labels.AcornManaged] == "true" && pv.Labels[labels.AcornAppNamespace] == namespace {
+			return pv, nil
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	notFound := fmt.Errorf("no Acorn-managed volume found with name %q in project %q", name, namespace)
+
+	i := strings.LastIndex(name, ".")
+	if i == -1 || i+1 >= len(name) {
+		return nil, notFound
+	}
+
+	var pvs corev1.PersistentVolumeList
+	if err := c.List(ctx, &pvs, &client.ListOptions{
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged:      "true",
+			labels.AcornAppNamespace: namespace,
+			labels.AcornAppName:      name[:i],
+			labels.AcornVolumeName:   name[i+1:],
+		}),
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(pvs.Items) != 1 {
+		return nil, notFound
+	}
+	return &pvs.Items[0], nil
+}
+
+// GetVolumeSnapshotClass returns the name of the CSI VolumeSnapshotClass to use for the given CSI driver. An empty string is
+// returned if the snapshot CRDs are not installed or no class exists for the driver.
+func GetVolumeSnapshotClass(ctx context.Context, c client.Reader, driver string) (string, error) {
+	classes := new(unstructured.UnstructuredList)
+	classes.SetGroupVersionKind(VolumeSnapshotClassGVK.GroupVersion().WithKind(VolumeSnapshotClassGVK.Kind + "List"))
+	if err := c.List(ctx, classes); meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	var result string
+	for _, class := range classes.Items {
+		if d, _, _ := unstructured.NestedString(class.Object, "driver"); d != driver {
+			continue
+		}
+		if class.GetAnnotations()[defaultSnapshotClassAnnotation] == "true" {
+			return class.GetName(), nil
+		}
+		if result == "" {
+			result = class.GetName()
+		}
+	}
+	return result, nil
+}
+
+// NodeForClaim returns the node of a running pod that has the claim mounted, or "" if there is none. ReadWriteOnce
+// volumes can only be attached to one node, so helper pods that mount the claim must run on the same node.
+func NodeForClaim(ctx context.Context, c client.Reader, namespace, claimName string) (string, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(namespace)); err != nil {
+		return "", err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Spec.NodeName == "" {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claimName {
+				return pod.Spec.NodeName, nil
+			}
+		}
+	}
+	return "", nil
+}
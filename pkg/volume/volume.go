@@ -171,5 +171,9 @@ func CopyVolumeDefaults(volumeRequest v1.VolumeRequest, volumeBinding v1.VolumeB
 		volumeRequest.AccessModes = volumeDefaults.AccessModes
 	}
 
+	if volumeBinding.From != "" {
+		volumeRequest.From = volumeBinding.From
+	}
+
 	return volumeRequest
 }
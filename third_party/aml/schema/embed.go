@@ -0,0 +1,6 @@
+package schema
+
+import "embed"
+
+//go:embed v1
+var Files embed.FS